@@ -21,17 +21,12 @@ import (
 	"github.com/spf13/viper"
 )
 
-// These variables are set by the build process using ldflags.
-var version = "version"
-var commit = "commit"
-var date = "date"
-
 var (
 	rootCmd = &cobra.Command{
 		Use:     "server",
 		Short:   "GitHub MCP Server",
 		Long:    `A GitHub MCP server that handles various tools and resources.`,
-		Version: fmt.Sprintf("Version: %s\nCommit: %s\nBuild Date: %s", version, commit, date),
+		Version: fmt.Sprintf("Version: %s\nCommit: %s\nBuild Date: %s", buildinfo.Version, buildinfo.Commit, buildinfo.Date),
 	}
 
 	stdioCmd = &cobra.Command{
@@ -110,21 +105,33 @@ var (
 
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			stdioServerConfig := ghmcp.StdioServerConfig{
-				Version:              version,
-				Host:                 viper.GetString("host"),
-				Token:                token,
-				EnabledToolsets:      enabledToolsets,
-				EnabledTools:         enabledTools,
-				EnabledFeatures:      enabledFeatures,
-				ReadOnly:             viper.GetBool("read-only"),
-				ExportTranslations:   viper.GetBool("export-translations"),
-				EnableCommandLogging: viper.GetBool("enable-command-logging"),
-				LogFilePath:          viper.GetString("log-file"),
-				ContentWindowSize:    viper.GetInt("content-window-size"),
-				LockdownMode:         viper.GetBool("lockdown-mode"),
-				InsidersMode:         viper.GetBool("insiders"),
-				ExcludeTools:         excludeTools,
-				RepoAccessCacheTTL:   &ttl,
+				Version:                  buildinfo.Version,
+				Host:                     viper.GetString("host"),
+				Token:                    token,
+				EnabledToolsets:          enabledToolsets,
+				EnabledTools:             enabledTools,
+				EnabledFeatures:          enabledFeatures,
+				ReadOnly:                 viper.GetBool("read-only"),
+				ExportTranslations:       viper.GetBool("export-translations"),
+				EnableCommandLogging:     viper.GetBool("enable-command-logging"),
+				LogFilePath:              viper.GetString("log-file"),
+				ContentWindowSize:        viper.GetInt("content-window-size"),
+				DefaultReturnContent:     viper.GetBool("default-return-content"),
+				DefaultPerPage:           github.ClampPerPage(viper.GetInt("default-per-page")),
+				PrettyJSON:               viper.GetBool("pretty-json"),
+				LockdownMode:             viper.GetBool("lockdown-mode"),
+				InsidersMode:             viper.GetBool("insiders"),
+				ExcludeTools:             excludeTools,
+				RepoAccessCacheTTL:       &ttl,
+				EnableGraphQLPassthrough: viper.GetBool("enable-graphql-passthrough"),
+				EnableRESTPassthrough:    viper.GetBool("enable-rest-passthrough"),
+				ToolNamePrefix:           viper.GetString("tool-name-prefix"),
+			}
+
+			if viper.IsSet("graphql-passthrough-operations") {
+				if err := viper.UnmarshalKey("graphql-passthrough-operations", &stdioServerConfig.GraphQLPassthroughOperations); err != nil {
+					return fmt.Errorf("failed to unmarshal graphql-passthrough-operations: %w", err)
+				}
 			}
 
 			// When no static token is provided, log in via OAuth using the given
@@ -197,7 +204,7 @@ var (
 
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			httpConfig := ghhttp.ServerConfig{
-				Version:              version,
+				Version:              buildinfo.Version,
 				Host:                 viper.GetString("host"),
 				Port:                 viper.GetInt("port"),
 				ListenHost:           viper.GetString("listen-host"),
@@ -207,6 +214,9 @@ var (
 				EnableCommandLogging: viper.GetBool("enable-command-logging"),
 				LogFilePath:          viper.GetString("log-file"),
 				ContentWindowSize:    viper.GetInt("content-window-size"),
+				DefaultReturnContent: viper.GetBool("default-return-content"),
+				DefaultPerPage:       github.ClampPerPage(viper.GetInt("default-per-page")),
+				PrettyJSON:           viper.GetBool("pretty-json"),
 				LockdownMode:         viper.GetBool("lockdown-mode"),
 				RepoAccessCacheTTL:   &ttl,
 				ScopeChallenge:       viper.GetBool("scope-challenge"),
@@ -241,6 +251,9 @@ func init() {
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
+	rootCmd.PersistentFlags().Bool("default-return-content", false, "Make tools that can return either content or a download URL, such as get_job_logs, return content unless a call asks otherwise")
+	rootCmd.PersistentFlags().Int("default-per-page", github.DefaultPerPage, "Default page size for paginated tools when a call does not pass perPage (1-100)")
+	rootCmd.PersistentFlags().Bool("pretty-json", false, "Pretty-print JSON tool results. Compact output, the default, uses fewer tokens")
 	rootCmd.PersistentFlags().Bool("lockdown-mode", false, "Enable lockdown mode")
 	rootCmd.PersistentFlags().Bool("insiders", false, "Enable insiders features")
 	rootCmd.PersistentFlags().Duration("repo-access-cache-ttl", 5*time.Minute, "Override the repo access cache TTL (e.g. 1m, 0s to disable)")
@@ -258,6 +271,16 @@ func init() {
 	stdioCmd.Flags().String("app-installation-id", "", "GitHub App installation ID to mint installation access tokens for")
 	stdioCmd.Flags().String("app-private-key-path", "", "Path to the GitHub App private key (PEM). Preferred over GITHUB_APP_PRIVATE_KEY: keeps the key off the command line and out of the environment")
 
+	// The passthrough tools are escape hatches for power users running their
+	// own server, so they are only offered over stdio.
+	stdioCmd.Flags().Bool("enable-graphql-passthrough", false, "Offer the graphql_query tool, which runs GraphQL operations named in --graphql-passthrough-operations")
+	stdioCmd.Flags().StringSlice("graphql-passthrough-operations", nil, "Comma-separated GraphQL operation names that graphql_query may run")
+	stdioCmd.Flags().Bool("enable-rest-passthrough", false, "Offer the github_api_get tool, which sends GET requests to any REST API path on the configured host")
+
+	// A prefix keeps tool names unique when a client aggregates several
+	// local servers.
+	stdioCmd.Flags().String("tool-name-prefix", "", "Prefix prepended to every tool name, such as gh_ for gh_list_issues, to avoid collisions with other MCP servers")
+
 	// HTTP-specific flags
 	httpCmd.Flags().Int("port", 8082, "HTTP server port")
 	httpCmd.Flags().String("listen-host", "", "Host the HTTP server binds to (e.g. 127.0.0.1). Empty binds to all interfaces.")
@@ -277,6 +300,9 @@ func init() {
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
 	_ = viper.BindPFlag("content-window-size", rootCmd.PersistentFlags().Lookup("content-window-size"))
+	_ = viper.BindPFlag("default-return-content", rootCmd.PersistentFlags().Lookup("default-return-content"))
+	_ = viper.BindPFlag("default-per-page", rootCmd.PersistentFlags().Lookup("default-per-page"))
+	_ = viper.BindPFlag("pretty-json", rootCmd.PersistentFlags().Lookup("pretty-json"))
 	_ = viper.BindPFlag("lockdown-mode", rootCmd.PersistentFlags().Lookup("lockdown-mode"))
 	_ = viper.BindPFlag("insiders", rootCmd.PersistentFlags().Lookup("insiders"))
 	_ = viper.BindPFlag("repo-access-cache-ttl", rootCmd.PersistentFlags().Lookup("repo-access-cache-ttl"))
@@ -287,6 +313,10 @@ func init() {
 	_ = viper.BindPFlag("app-id", stdioCmd.Flags().Lookup("app-id"))
 	_ = viper.BindPFlag("app-installation-id", stdioCmd.Flags().Lookup("app-installation-id"))
 	_ = viper.BindPFlag("app-private-key-path", stdioCmd.Flags().Lookup("app-private-key-path"))
+	_ = viper.BindPFlag("enable-graphql-passthrough", stdioCmd.Flags().Lookup("enable-graphql-passthrough"))
+	_ = viper.BindPFlag("graphql-passthrough-operations", stdioCmd.Flags().Lookup("graphql-passthrough-operations"))
+	_ = viper.BindPFlag("enable-rest-passthrough", stdioCmd.Flags().Lookup("enable-rest-passthrough"))
+	_ = viper.BindPFlag("tool-name-prefix", stdioCmd.Flags().Lookup("tool-name-prefix"))
 	_ = viper.BindPFlag("port", httpCmd.Flags().Lookup("port"))
 	_ = viper.BindPFlag("listen-host", httpCmd.Flags().Lookup("listen-host"))
 	_ = viper.BindPFlag("base-url", httpCmd.Flags().Lookup("base-url"))
@@ -1,14 +1,22 @@
 // Package buildinfo contains variables that are set at build time via ldflags.
-// These allow official releases to ship default OAuth credentials so users can
-// log in without configuring their own OAuth app. The values are public in
-// practice (security relies on PKCE, not on the client secret), but are kept out
-// of source and injected at build time.
+// They identify the build and allow official releases to ship default OAuth
+// credentials so users can log in without configuring their own OAuth app.
+// The values are public in practice (security relies on PKCE, not on the
+// client secret), but are kept out of source and injected at build time.
 //
 // Example:
 //
 //	go build -ldflags="-X github.com/github/github-mcp-server/internal/buildinfo.OAuthClientID=xxx"
 package buildinfo
 
+// Version, Commit and Date identify the build. Local/dev builds keep these
+// placeholder values.
+var (
+	Version = "version"
+	Commit  = "commit"
+	Date    = "date"
+)
+
 // OAuthClientID is the default OAuth client ID, set at build time. Empty in
 // local/dev builds.
 var OAuthClientID string
@@ -0,0 +1,59 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	gogithub "github.com/google/go-github/v89/github"
+)
+
+// ghesVersionHeader carries the installed version on every GHES API response.
+const ghesVersionHeader = "X-GitHub-Enterprise-Version"
+
+// ghesDetectTimeout bounds the startup request that detects the GHES version.
+const ghesDetectTimeout = 10 * time.Second
+
+// detectGHESVersion returns the installed version of the GHES instance client
+// targets. It reads installed_version from /meta and falls back to the
+// version header when the body omits it.
+func detectGHESVersion(ctx context.Context, client *gogithub.Client) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, ghesDetectTimeout)
+	defer cancel()
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "meta", nil)
+	if err != nil {
+		return "", err
+	}
+	var meta struct {
+		InstalledVersion string `json:"installed_version"`
+	}
+	resp, err := client.Do(req, &meta)
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+
+	if meta.InstalledVersion != "" {
+		return meta.InstalledVersion, nil
+	}
+	if version := resp.Header.Get(ghesVersionHeader); version != "" {
+		return version, nil
+	}
+	return "", fmt.Errorf("response has neither installed_version nor a %s header", ghesVersionHeader)
+}
+
+// ghesToolsetWarnings returns a warning for each enabled toolset that does
+// not work on the given GHES version.
+func ghesToolsetWarnings(version string, enabled []inventory.ToolsetMetadata) []string {
+	var warnings []string
+	for _, toolset := range enabled {
+		if reason := github.GHESToolsetUnsupportedReason(toolset.ID, version); reason != "" {
+			warnings = append(warnings, fmt.Sprintf("toolset %q is unsupported: %s", toolset.ID, reason))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,103 @@
+package ghmcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	gogithub "github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGHESTestClient(t *testing.T, handler http.HandlerFunc) *gogithub.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiURL := server.URL + "/api/v3/"
+	client, err := gogithub.NewClient(gogithub.WithEnterpriseURLs(apiURL, apiURL))
+	require.NoError(t, err)
+	return client
+}
+
+func TestDetectGHESVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		handler         http.HandlerFunc
+		expectedVersion string
+		expectError     bool
+	}{
+		{
+			name: "installed_version from meta",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/meta", r.URL.Path)
+				w.Header().Set(ghesVersionHeader, "enterprise-server@3.13.0")
+				_, _ = w.Write([]byte(`{"installed_version": "3.14.2"}`))
+			},
+			expectedVersion: "3.14.2",
+		},
+		{
+			name: "falls back to version header",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set(ghesVersionHeader, "3.12.1")
+				_, _ = w.Write([]byte(`{"verifiable_password_authentication": true}`))
+			},
+			expectedVersion: "3.12.1",
+		},
+		{
+			name: "no version in response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{}`))
+			},
+			expectError: true,
+		},
+		{
+			name: "request fails",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := detectGHESVersion(context.Background(), newGHESTestClient(t, tc.handler))
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVersion, version)
+		})
+	}
+}
+
+func TestGHESToolsetWarnings(t *testing.T) {
+	enabled := []inventory.ToolsetMetadata{
+		{ID: "repos"},
+		{ID: "discussions"},
+		{ID: "copilot"},
+	}
+
+	t.Run("old version flags version-gated and unsupported toolsets", func(t *testing.T) {
+		assert.Equal(t, []string{
+			`toolset "discussions" is unsupported: requires GitHub Enterprise Server 3.6 or later (3.5.4 is installed)`,
+			`toolset "copilot" is unsupported: not available on GitHub Enterprise Server`,
+		}, ghesToolsetWarnings("3.5.4", enabled))
+	})
+
+	t.Run("recent version only flags unsupported toolsets", func(t *testing.T) {
+		warnings := ghesToolsetWarnings("3.14.0", enabled)
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], `"copilot"`)
+	})
+
+	t.Run("supported toolsets produce no warnings", func(t *testing.T) {
+		assert.Empty(t, ghesToolsetWarnings("3.14.0", []inventory.ToolsetMetadata{{ID: "repos"}, {ID: "issues"}}))
+	})
+}
@@ -92,11 +92,21 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 
 	// Construct GraphQL client
 	// We use NewEnterpriseClient unconditionally since we already parsed the API host
+	var gqlTransport http.RoundTripper = &transport.GraphQLFeaturesTransport{
+		Transport: http.DefaultTransport,
+	}
+	if utils.IsGHESHost(cfg.Host) {
+		// GitHub Enterprise Server can have its GraphQL API disabled or
+		// unreachable; the circuit breaker stops a session from waiting on
+		// every GraphQL call when it is.
+		gqlTransport = &transport.GraphQLCircuitBreakerTransport{
+			Transport: gqlTransport,
+			Logger:    cfg.Logger,
+		}
+	}
 	gqlHTTPClient := &http.Client{
 		Transport: &transport.BearerAuthTransport{
-			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
-			},
+			Transport:     gqlTransport,
 			Token:         cfg.Token,
 			TokenProvider: cfg.TokenProvider,
 		},
@@ -143,6 +153,19 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		return nil, fmt.Errorf("failed to create GitHub clients: %w", err)
 	}
 
+	// GHES lacks some APIs the toolsets use, depending on its version. Detect
+	// it up front so unsupported toolsets can be flagged once the inventory is
+	// built rather than failing on first use.
+	var ghesVersion string
+	if utils.IsGHESHost(cfg.Host) {
+		ghesVersion, err = detectGHESVersion(ctx, clients.rest)
+		if err != nil {
+			cfg.Logger.Warn("failed to detect GitHub Enterprise Server version", "error", err)
+		} else {
+			cfg.Logger.Info("detected GitHub Enterprise Server", "version", ghesVersion)
+		}
+	}
+
 	// Create feature checker — resolves explicit features + insiders expansion
 	featureChecker := createFeatureChecker(cfg.EnabledFeatures, cfg.InsidersMode)
 
@@ -161,6 +184,8 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 			LockdownMode: cfg.LockdownMode,
 		},
 		cfg.ContentWindowSize,
+		cfg.DefaultReturnContent,
+		cfg.DefaultPerPage,
 		featureChecker,
 		obs,
 	)
@@ -172,17 +197,73 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		WithTools(github.CleanTools(cfg.EnabledTools)).
 		WithExcludeTools(cfg.ExcludeTools).
 		WithServerInstructions().
-		WithFeatureChecker(featureChecker)
+		WithFeatureChecker(featureChecker).
+		WithToolNamePrefix(cfg.ToolNamePrefix)
+
+	// Passthrough tools are not part of AllTools. When the server opts in,
+	// they are always registered, whichever toolsets are enabled.
+	var passthroughTools []inventory.ServerTool
+	if cfg.EnableGraphQLPassthrough {
+		passthroughTools = append(passthroughTools, github.GraphQLQuery(cfg.Translator, cfg.GraphQLPassthroughOperations))
+	}
+	if cfg.EnableRESTPassthrough {
+		passthroughTools = append(passthroughTools, github.GitHubAPIGet(cfg.Translator))
+	}
+	ghesToolFiltering := ghesVersion != "" && github.ResolveFeatureFlags(cfg.EnabledFeatures, cfg.InsidersMode)[github.FeatureFlagGHESToolFiltering]
+
+	// server_health, describe_toolset and list_tools report the server's own
+	// configuration, so they are built here rather than in AllTools. They
+	// belong to the context toolset and are enabled with it.
+	healthInfo := &github.ServerHealthInfo{
+		Host:           cfg.Host,
+		AuthConfigured: cfg.Token != "" || cfg.TokenProvider != nil,
+		GHESVersion:    ghesVersion,
+	}
+	// The catalog's inventory is filled in once it is built, below.
+	toolsetCatalog := &github.ToolsetCatalog{
+		GHESVersion:       ghesVersion,
+		GHESToolFiltering: ghesToolFiltering,
+	}
+	tools := append(github.AllTools(cfg.Translator),
+		github.ServerHealthTool(cfg.Translator, healthInfo),
+		github.DescribeToolsetTool(cfg.Translator, toolsetCatalog),
+		github.ListToolsTool(cfg.Translator, toolsetCatalog),
+	)
+	if len(passthroughTools) > 0 {
+		additionalTools := github.CleanTools(cfg.EnabledTools)
+		for _, tool := range passthroughTools {
+			additionalTools = append(additionalTools, tool.Tool.Name)
+		}
+		tools = append(tools, passthroughTools...)
+		inventoryBuilder = inventoryBuilder.WithTools(additionalTools)
+	}
+	inventoryBuilder = inventoryBuilder.SetTools(tools)
 
 	// Apply token scope filtering if scopes are known (for PAT filtering)
 	if cfg.TokenScopes != nil {
 		inventoryBuilder = inventoryBuilder.WithFilter(github.CreateToolScopeFilter(cfg.TokenScopes))
 	}
+	if ghesToolFiltering {
+		inventoryBuilder = inventoryBuilder.WithFilter(github.CreateGHESToolFilter(ghesVersion))
+	}
 
 	inventory, err := inventoryBuilder.Build()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build inventory: %w", err)
 	}
+	for _, toolset := range inventory.EnabledToolsets() {
+		healthInfo.EnabledToolsets = append(healthInfo.EnabledToolsets, string(toolset.ID))
+	}
+	toolsetCatalog.Inventory = inventory
+	if ghesVersion != "" {
+		consequence := "its tools will fail; enable the " + github.FeatureFlagGHESToolFiltering + " feature to hide them"
+		if ghesToolFiltering {
+			consequence = "its tools are hidden"
+		}
+		for _, warning := range ghesToolsetWarnings(ghesVersion, inventory.EnabledToolsets()) {
+			cfg.Logger.Warn("Warning: " + warning + "; " + consequence)
+		}
+	}
 
 	ghServer, err := github.NewMCPServer(ctx, &cfg, deps, inventory)
 	if err != nil {
@@ -232,6 +313,18 @@ type StdioServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultReturnContent makes content-capable tools return content rather
+	// than download URLs unless a call asks otherwise
+	DefaultReturnContent bool
+
+	// DefaultPerPage is the page size paginated tools use when a call does
+	// not pass perPage
+	DefaultPerPage int
+
+	// PrettyJSON pretty-prints JSON tool results instead of emitting them
+	// compactly
+	PrettyJSON bool
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
@@ -246,6 +339,22 @@ type StdioServerConfig struct {
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
 
+	// EnableGraphQLPassthrough offers the graphql_query tool, which runs the
+	// GraphQL operations named in GraphQLPassthroughOperations.
+	EnableGraphQLPassthrough bool
+
+	// GraphQLPassthroughOperations is the allow-list of operation names that
+	// graphql_query may run.
+	GraphQLPassthroughOperations []string
+
+	// EnableRESTPassthrough offers the github_api_get tool, which sends GET
+	// requests to arbitrary REST API paths on the configured host.
+	EnableRESTPassthrough bool
+
+	// ToolNamePrefix is prepended to every registered tool name, so the
+	// server's tools can sit alongside other servers' without collisions.
+	ToolNamePrefix string
+
 	// OAuthManager, when non-nil, enables OAuth 2.1 login for stdio mode. The
 	// server starts without a token and runs the authorization flow on the
 	// first tool call (see createOAuthMiddleware). It is mutually exclusive with
@@ -325,23 +434,30 @@ func RunStdioServer(cfg StdioServerConfig) error {
 	}
 
 	ghServer, err := NewStdioMCPServer(ctx, github.MCPServerConfig{
-		Version:               cfg.Version,
-		Host:                  cfg.Host,
-		Token:                 cfg.Token,
-		EnabledToolsets:       cfg.EnabledToolsets,
-		EnabledTools:          cfg.EnabledTools,
-		EnabledFeatures:       cfg.EnabledFeatures,
-		ReadOnly:              cfg.ReadOnly,
-		Translator:            t,
-		ContentWindowSize:     cfg.ContentWindowSize,
-		LockdownMode:          cfg.LockdownMode,
-		InsidersMode:          cfg.InsidersMode,
-		ExcludeTools:          cfg.ExcludeTools,
-		Logger:                logger,
-		RepoAccessTTL:         cfg.RepoAccessCacheTTL,
-		TokenScopes:           tokenScopes,
-		TokenProvider:         tokenProvider,
-		ToolHandlerMiddleware: toolHandlerMiddleware,
+		Version:                      cfg.Version,
+		Host:                         cfg.Host,
+		Token:                        cfg.Token,
+		EnabledToolsets:              cfg.EnabledToolsets,
+		EnabledTools:                 cfg.EnabledTools,
+		EnabledFeatures:              cfg.EnabledFeatures,
+		ReadOnly:                     cfg.ReadOnly,
+		Translator:                   t,
+		ContentWindowSize:            cfg.ContentWindowSize,
+		DefaultReturnContent:         cfg.DefaultReturnContent,
+		DefaultPerPage:               cfg.DefaultPerPage,
+		PrettyJSON:                   cfg.PrettyJSON,
+		LockdownMode:                 cfg.LockdownMode,
+		InsidersMode:                 cfg.InsidersMode,
+		ExcludeTools:                 cfg.ExcludeTools,
+		EnableGraphQLPassthrough:     cfg.EnableGraphQLPassthrough,
+		GraphQLPassthroughOperations: cfg.GraphQLPassthroughOperations,
+		EnableRESTPassthrough:        cfg.EnableRESTPassthrough,
+		ToolNamePrefix:               cfg.ToolNamePrefix,
+		Logger:                       logger,
+		RepoAccessTTL:                cfg.RepoAccessCacheTTL,
+		TokenScopes:                  tokenScopes,
+		TokenProvider:                tokenProvider,
+		ToolHandlerMiddleware:        toolHandlerMiddleware,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create MCP server: %w", err)
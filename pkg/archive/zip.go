@@ -0,0 +1,430 @@
+// Package archive reads ZIP archives from a stream without buffering them,
+// enforcing limits on how much data they may decompress to.
+package archive
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	localFileHeaderSignature = 0x04034b50
+	centralDirSignature      = 0x02014b50
+	endOfCentralDirSignature = 0x06054b50
+	dataDescriptorSignature  = 0x08074b50
+
+	flagEncrypted      = 0x1
+	flagDataDescriptor = 0x8
+
+	methodStore   = 0
+	methodDeflate = 8
+
+	zip64ExtraID = 0x0001
+	uint32Max    = 0xffffffff
+)
+
+var (
+	// ErrEntryTooLarge is returned by Read when the current entry
+	// decompresses to more than Limits.MaxEntryBytes. The archive can still
+	// be advanced to the next entry.
+	ErrEntryTooLarge = errors.New("archive: entry exceeds the decompressed size limit")
+	// ErrArchiveTooLarge is returned when the entries read or skipped so far
+	// decompress to more than Limits.MaxTotalBytes.
+	ErrArchiveTooLarge = errors.New("archive: archive exceeds the total decompressed size limit")
+	// ErrTooManyEntries is returned by Next when the archive has more than
+	// Limits.MaxEntries entries.
+	ErrTooManyEntries = errors.New("archive: archive has too many entries")
+	// ErrFormat is returned when the stream is not a valid ZIP archive,
+	// including when an entry decompresses to more than its header declares.
+	ErrFormat = errors.New("archive: not a valid zip stream")
+	// ErrChecksum is returned when an entry's content does not match its CRC-32.
+	ErrChecksum = errors.New("archive: checksum error")
+	// ErrUnsupported is returned for entries that cannot be read from a
+	// stream: encrypted entries, compression methods other than store and
+	// deflate, and stored entries whose size is only known after their data.
+	ErrUnsupported = errors.New("archive: unsupported zip feature")
+)
+
+// Limits bounds what a ZipReader decompresses. Zero values mean no limit.
+type Limits struct {
+	// MaxEntryBytes bounds the decompressed size of a single entry.
+	MaxEntryBytes int64
+	// MaxTotalBytes bounds the decompressed size of all entries together,
+	// including entries that are skipped without being read.
+	MaxTotalBytes int64
+	// MaxEntries bounds the number of entries.
+	MaxEntries int
+}
+
+// Entry describes a file or directory in a ZIP archive.
+type Entry struct {
+	Name     string
+	Modified time.Time
+	// Size is the decompressed size declared by the entry's header, or -1
+	// when the archive only records it after the entry's data.
+	Size  int64
+	IsDir bool
+}
+
+// ZipReader reads the entries of a ZIP archive in order from a stream. It
+// keeps only the current entry's decompressor in memory, so memory use does
+// not grow with the size of the archive.
+//
+// Like archive/tar, Next advances to the next entry and Read reads the
+// content of the current one.
+type ZipReader struct {
+	r      *bufio.Reader
+	limits Limits
+
+	entries int
+	total   int64
+	cur     *entryReader
+	err     error
+}
+
+// entryReader is the state of the entry being read.
+type entryReader struct {
+	entry      Entry
+	flags      uint16
+	crc32      uint32
+	zip64      bool
+	compressed *io.LimitedReader // nil when the compressed size is unknown
+	content    io.Reader
+	hash       hash.Hash32
+	read       int64
+	done       bool
+}
+
+// NewZipReader returns a ZipReader reading from r within limits.
+func NewZipReader(r io.Reader, limits Limits) *ZipReader {
+	return &ZipReader{r: bufio.NewReader(r), limits: limits}
+}
+
+// Next advances to the next entry, skipping what is left of the current one.
+// It returns io.EOF after the last entry.
+func (z *ZipReader) Next() (*Entry, error) {
+	if z.err != nil {
+		return nil, z.err
+	}
+	if z.cur != nil && !z.cur.done {
+		if err := z.skip(); err != nil {
+			return nil, z.fail(err)
+		}
+	}
+	z.cur = nil
+
+	var sig uint32
+	if err := binary.Read(z.r, binary.LittleEndian, &sig); err != nil {
+		return nil, z.fail(formatError(err))
+	}
+	switch sig {
+	case localFileHeaderSignature:
+	case centralDirSignature, endOfCentralDirSignature:
+		// The central directory repeats what the local headers said.
+		return nil, z.fail(io.EOF)
+	default:
+		return nil, z.fail(fmt.Errorf("%w: unexpected signature %#x", ErrFormat, sig))
+	}
+
+	var hdr [26]byte
+	if _, err := io.ReadFull(z.r, hdr[:]); err != nil {
+		return nil, z.fail(formatError(err))
+	}
+	flags := binary.LittleEndian.Uint16(hdr[2:4])
+	method := binary.LittleEndian.Uint16(hdr[4:6])
+	modTime := binary.LittleEndian.Uint16(hdr[6:8])
+	modDate := binary.LittleEndian.Uint16(hdr[8:10])
+	crc := binary.LittleEndian.Uint32(hdr[10:14])
+	compressedSize := uint64(binary.LittleEndian.Uint32(hdr[14:18]))
+	size := uint64(binary.LittleEndian.Uint32(hdr[18:22]))
+	nameLen := binary.LittleEndian.Uint16(hdr[22:24])
+	extraLen := binary.LittleEndian.Uint16(hdr[24:26])
+
+	nameAndExtra := make([]byte, int(nameLen)+int(extraLen))
+	if _, err := io.ReadFull(z.r, nameAndExtra); err != nil {
+		return nil, z.fail(formatError(err))
+	}
+	name := string(nameAndExtra[:nameLen])
+
+	z.entries++
+	if z.limits.MaxEntries > 0 && z.entries > z.limits.MaxEntries {
+		return nil, z.fail(ErrTooManyEntries)
+	}
+
+	zip64 := false
+	if size == uint32Max || compressedSize == uint32Max {
+		zip64 = true
+		var err error
+		size, compressedSize, err = parseZip64Extra(nameAndExtra[nameLen:], size, compressedSize)
+		if err != nil {
+			return nil, z.fail(err)
+		}
+	}
+
+	if flags&flagEncrypted != 0 {
+		return nil, z.fail(fmt.Errorf("%w: %q is encrypted", ErrUnsupported, name))
+	}
+	deferredSizes := flags&flagDataDescriptor != 0
+
+	cur := &entryReader{
+		entry: Entry{
+			Name:     name,
+			Modified: msDosTimeToTime(modDate, modTime),
+			Size:     int64(size),
+			IsDir:    strings.HasSuffix(name, "/"),
+		},
+		flags: flags,
+		crc32: crc,
+		zip64: zip64,
+		hash:  crc32.NewIEEE(),
+	}
+	if deferredSizes {
+		cur.entry.Size = -1
+	} else {
+		cur.compressed = &io.LimitedReader{R: z.r, N: int64(compressedSize)}
+	}
+
+	switch method {
+	case methodStore:
+		if deferredSizes {
+			return nil, z.fail(fmt.Errorf("%w: stored entry %q has no size in its header", ErrUnsupported, name))
+		}
+		cur.content = cur.compressed
+	case methodDeflate:
+		if deferredSizes {
+			// The deflate stream marks its own end. z.r is an io.ByteReader,
+			// so the decompressor does not read past it.
+			cur.content = flate.NewReader(z.r)
+		} else {
+			cur.content = flate.NewReader(cur.compressed)
+		}
+	default:
+		return nil, z.fail(fmt.Errorf("%w: %q uses compression method %d", ErrUnsupported, name, method))
+	}
+
+	z.cur = cur
+	entry := cur.entry
+	return &entry, nil
+}
+
+// Read reads the content of the current entry.
+func (z *ZipReader) Read(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	cur := z.cur
+	if cur == nil || cur.done {
+		return 0, io.EOF
+	}
+	if z.limits.MaxEntryBytes > 0 && cur.entry.Size > z.limits.MaxEntryBytes {
+		return 0, ErrEntryTooLarge
+	}
+
+	n, err := cur.content.Read(p)
+	n, limitErr := z.account(n)
+	_, _ = cur.hash.Write(p[:n])
+	if limitErr != nil {
+		return n, limitErr
+	}
+	if cur.entry.Size >= 0 && cur.read > cur.entry.Size {
+		return n, z.fail(fmt.Errorf("%w: %q is larger than its declared size", ErrFormat, cur.entry.Name))
+	}
+	if err == io.EOF {
+		if err := z.finishEntry(); err != nil {
+			return n, z.fail(err)
+		}
+		return n, io.EOF
+	}
+	if err != nil {
+		return n, z.fail(formatError(err))
+	}
+	return n, nil
+}
+
+// account adds n decompressed bytes to the entry and archive totals. It
+// returns how many of them are within the limits and the limit exceeded, if
+// any. Exceeding the archive limit is fatal; exceeding the entry limit is not.
+func (z *ZipReader) account(n int) (int, error) {
+	cur := z.cur
+	cur.read += int64(n)
+	z.total += int64(n)
+	if z.limits.MaxTotalBytes > 0 && z.total > z.limits.MaxTotalBytes {
+		over := z.total - z.limits.MaxTotalBytes
+		return n - int(min(over, int64(n))), z.fail(ErrArchiveTooLarge)
+	}
+	if z.limits.MaxEntryBytes > 0 && cur.read > z.limits.MaxEntryBytes {
+		over := cur.read - z.limits.MaxEntryBytes
+		return n - int(min(over, int64(n))), ErrEntryTooLarge
+	}
+	return n, nil
+}
+
+// skip moves past the rest of the current entry. When the compressed size is
+// known the remaining bytes are discarded without being decompressed;
+// otherwise the entry must be decompressed to find its end, and the
+// decompressed bytes count towards Limits.MaxTotalBytes.
+func (z *ZipReader) skip() error {
+	cur := z.cur
+	if cur.compressed != nil {
+		if _, err := io.Copy(io.Discard, cur.compressed); err != nil {
+			return formatError(err)
+		}
+		if cur.compressed.N > 0 {
+			return formatError(io.ErrUnexpectedEOF)
+		}
+		cur.done = true
+		return nil
+	}
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := cur.content.Read(buf)
+		cur.read += int64(n)
+		z.total += int64(n)
+		if z.limits.MaxTotalBytes > 0 && z.total > z.limits.MaxTotalBytes {
+			return ErrArchiveTooLarge
+		}
+		if err == io.EOF {
+			// The content was not all read, so the checksum is not verified.
+			return z.readDataDescriptor()
+		}
+		if err != nil {
+			return formatError(err)
+		}
+	}
+}
+
+// finishEntry completes an entry whose content was read to the end,
+// verifying its checksum.
+func (z *ZipReader) finishEntry() error {
+	cur := z.cur
+	if cur.flags&flagDataDescriptor != 0 {
+		if err := z.readDataDescriptor(); err != nil {
+			return err
+		}
+	} else {
+		if cur.compressed.N > 0 {
+			// Trailing compressed bytes after the end of the deflate stream.
+			if _, err := io.Copy(io.Discard, cur.compressed); err != nil {
+				return formatError(err)
+			}
+		}
+		if cur.read != cur.entry.Size {
+			return fmt.Errorf("%w: %q is smaller than its declared size", ErrFormat, cur.entry.Name)
+		}
+	}
+	if cur.hash.Sum32() != cur.crc32 {
+		return fmt.Errorf("%w: %q", ErrChecksum, cur.entry.Name)
+	}
+	cur.done = true
+	return nil
+}
+
+// readDataDescriptor reads the checksum and sizes that follow an entry's data
+// when its header defers them.
+func (z *ZipReader) readDataDescriptor() error {
+	cur := z.cur
+	var word [4]byte
+	if _, err := io.ReadFull(z.r, word[:]); err != nil {
+		return formatError(err)
+	}
+	// The descriptor signature is optional.
+	if binary.LittleEndian.Uint32(word[:]) == dataDescriptorSignature {
+		if _, err := io.ReadFull(z.r, word[:]); err != nil {
+			return formatError(err)
+		}
+	}
+	cur.crc32 = binary.LittleEndian.Uint32(word[:])
+
+	sizes := make([]byte, 8)
+	if cur.zip64 {
+		sizes = make([]byte, 16)
+	}
+	if _, err := io.ReadFull(z.r, sizes); err != nil {
+		return formatError(err)
+	}
+	var size uint64
+	if cur.zip64 {
+		size = binary.LittleEndian.Uint64(sizes[8:])
+	} else {
+		size = uint64(binary.LittleEndian.Uint32(sizes[4:]))
+	}
+	if uint64(cur.read) != size {
+		return fmt.Errorf("%w: %q does not match the size in its data descriptor", ErrFormat, cur.entry.Name)
+	}
+	cur.done = true
+	return nil
+}
+
+// fail records a fatal error so that later calls return it too.
+func (z *ZipReader) fail(err error) error {
+	z.err = err
+	return err
+}
+
+// parseZip64Extra reads the 64-bit sizes from an entry's extra field. Only
+// the sizes whose header fields are saturated are present, in this order.
+func parseZip64Extra(extra []byte, size, compressedSize uint64) (uint64, uint64, error) {
+	for len(extra) >= 4 {
+		id := binary.LittleEndian.Uint16(extra[0:2])
+		n := int(binary.LittleEndian.Uint16(extra[2:4]))
+		extra = extra[4:]
+		if n > len(extra) {
+			break
+		}
+		field := extra[:n]
+		extra = extra[n:]
+		if id != zip64ExtraID {
+			continue
+		}
+		if size == uint32Max {
+			if len(field) < 8 {
+				break
+			}
+			size = binary.LittleEndian.Uint64(field)
+			field = field[8:]
+		}
+		if compressedSize == uint32Max {
+			if len(field) < 8 {
+				break
+			}
+			compressedSize = binary.LittleEndian.Uint64(field)
+		}
+		return size, compressedSize, nil
+	}
+	return 0, 0, fmt.Errorf("%w: invalid zip64 extra field", ErrFormat)
+}
+
+// formatError reports a truncated stream as ErrFormat.
+func formatError(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: unexpected end of stream", ErrFormat)
+	}
+	var corrupt flate.CorruptInputError
+	if errors.As(err, &corrupt) {
+		return fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+	return err
+}
+
+// msDosTimeToTime converts an MS-DOS date and time into a time.Time.
+func msDosTimeToTime(dosDate, dosTime uint16) time.Time {
+	return time.Date(
+		int(dosDate>>9+1980),
+		time.Month(dosDate>>5&0xf),
+		int(dosDate&0x1f),
+		int(dosTime>>11),
+		int(dosTime>>5&0x3f),
+		int(dosTime&0x1f*2),
+		0,
+		time.UTC,
+	)
+}
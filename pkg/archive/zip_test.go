@@ -0,0 +1,353 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"context"
+	"hash/crc32"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/profiler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEntry struct {
+	name    string
+	content string
+	method  uint16
+}
+
+// buildZip writes entries with archive/zip. Deflated entries put their sizes
+// in a data descriptor, as most streaming writers do; stored entries put them
+// in the header, since a stream reader cannot otherwise find their end.
+func buildZip(t *testing.T, entries []testEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		var w io.Writer
+		var err error
+		if e.method == zip.Store {
+			w, err = zw.CreateRaw(&zip.FileHeader{
+				Name:               e.name,
+				Method:             zip.Store,
+				CRC32:              crc32.ChecksumIEEE([]byte(e.content)),
+				CompressedSize64:   uint64(len(e.content)),
+				UncompressedSize64: uint64(len(e.content)),
+			})
+		} else {
+			w, err = zw.CreateHeader(&zip.FileHeader{Name: e.name, Method: e.method})
+		}
+		require.NoError(t, err)
+		_, err = w.Write([]byte(e.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// buildRawZip writes a single pre-compressed entry whose header fields are
+// taken from fh as given, so tests can craft headers that lie.
+func buildRawZip(t *testing.T, fh *zip.FileHeader, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.CreateRaw(fh)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func deflate(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	fw, err := flate.NewWriter(&buf, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = fw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, fw.Close())
+	return buf.Bytes()
+}
+
+func TestZipReader(t *testing.T) {
+	t.Run("reads stored and deflated entries in order", func(t *testing.T) {
+		data := buildZip(t, []testEntry{
+			{name: "dir/", method: zip.Store},
+			{name: "dir/a.txt", content: "hello", method: zip.Store},
+			{name: "dir/b.txt", content: strings.Repeat("world ", 1000), method: zip.Deflate},
+		})
+		zr := NewZipReader(bytes.NewReader(data), Limits{})
+
+		var names []string
+		var contents []string
+		for {
+			entry, err := zr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(zr)
+			require.NoError(t, err)
+			names = append(names, entry.Name)
+			contents = append(contents, string(content))
+		}
+
+		assert.Equal(t, []string{"dir/", "dir/a.txt", "dir/b.txt"}, names)
+		assert.Equal(t, []string{"", "hello", strings.Repeat("world ", 1000)}, contents)
+	})
+
+	t.Run("reports directories and declared sizes", func(t *testing.T) {
+		data := buildRawZip(t, &zip.FileHeader{
+			Name:               "a.txt",
+			Method:             zip.Store,
+			CRC32:              crc32.ChecksumIEEE([]byte("abc")),
+			CompressedSize64:   3,
+			UncompressedSize64: 3,
+		}, []byte("abc"))
+		zr := NewZipReader(bytes.NewReader(data), Limits{})
+
+		entry, err := zr.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "a.txt", entry.Name)
+		assert.Equal(t, int64(3), entry.Size)
+		assert.False(t, entry.IsDir)
+
+		data = buildZip(t, []testEntry{{name: "b.txt", content: "abc", method: zip.Deflate}})
+		zr = NewZipReader(bytes.NewReader(data), Limits{})
+		entry, err = zr.Next()
+		require.NoError(t, err)
+		assert.Equal(t, int64(-1), entry.Size)
+	})
+
+	t.Run("skips unread entries", func(t *testing.T) {
+		data := buildZip(t, []testEntry{
+			{name: "a.txt", content: strings.Repeat("a", 10000), method: zip.Deflate},
+			{name: "b.txt", content: "bbb", method: zip.Store},
+		})
+		zr := NewZipReader(bytes.NewReader(data), Limits{})
+
+		_, err := zr.Next()
+		require.NoError(t, err)
+		buf := make([]byte, 10)
+		_, err = zr.Read(buf)
+		require.NoError(t, err)
+
+		entry, err := zr.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "b.txt", entry.Name)
+		content, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		assert.Equal(t, "bbb", string(content))
+
+		_, err = zr.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("rejects an entry that decompresses past the entry limit", func(t *testing.T) {
+		// Ten megabytes of zeros deflate to a few kilobytes, and the
+		// header defers the sizes, so only decompressing reveals the size.
+		data := buildZip(t, []testEntry{
+			{name: "bomb.bin", content: string(make([]byte, 10<<20)), method: zip.Deflate},
+			{name: "next.txt", content: "next", method: zip.Store},
+		})
+		require.Less(t, len(data), 64<<10)
+		zr := NewZipReader(bytes.NewReader(data), Limits{MaxEntryBytes: 1 << 20})
+
+		_, err := zr.Next()
+		require.NoError(t, err)
+		n, err := io.Copy(io.Discard, zr)
+		assert.ErrorIs(t, err, ErrEntryTooLarge)
+		assert.Equal(t, int64(1<<20), n)
+
+		entry, err := zr.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "next.txt", entry.Name)
+	})
+
+	t.Run("rejects an entry whose declared size exceeds the entry limit", func(t *testing.T) {
+		content := []byte(strings.Repeat("x", 100))
+		data := buildRawZip(t, &zip.FileHeader{
+			Name:               "big.txt",
+			Method:             zip.Store,
+			CRC32:              crc32.ChecksumIEEE(content),
+			CompressedSize64:   100,
+			UncompressedSize64: 100,
+		}, content)
+		zr := NewZipReader(bytes.NewReader(data), Limits{MaxEntryBytes: 10})
+
+		_, err := zr.Next()
+		require.NoError(t, err)
+		n, err := zr.Read(make([]byte, 100))
+		assert.ErrorIs(t, err, ErrEntryTooLarge)
+		assert.Zero(t, n)
+
+		_, err = zr.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("rejects an entry larger than its header declares", func(t *testing.T) {
+		content := make([]byte, 1<<20)
+		data := buildRawZip(t, &zip.FileHeader{
+			Name:               "liar.bin",
+			Method:             zip.Deflate,
+			CRC32:              crc32.ChecksumIEEE(content),
+			CompressedSize64:   uint64(len(deflate(t, content))),
+			UncompressedSize64: 100,
+		}, deflate(t, content))
+		zr := NewZipReader(bytes.NewReader(data), Limits{MaxEntryBytes: 10 << 20})
+
+		_, err := zr.Next()
+		require.NoError(t, err)
+		_, err = io.Copy(io.Discard, zr)
+		assert.ErrorIs(t, err, ErrFormat)
+	})
+
+	t.Run("rejects archives past the total limit", func(t *testing.T) {
+		data := buildZip(t, []testEntry{
+			{name: "a.txt", content: strings.Repeat("a", 600), method: zip.Deflate},
+			{name: "b.txt", content: strings.Repeat("b", 600), method: zip.Deflate},
+		})
+		zr := NewZipReader(bytes.NewReader(data), Limits{MaxTotalBytes: 1000})
+
+		_, err := zr.Next()
+		require.NoError(t, err)
+		_, err = io.Copy(io.Discard, zr)
+		require.NoError(t, err)
+		_, err = zr.Next()
+		require.NoError(t, err)
+		_, err = io.Copy(io.Discard, zr)
+		assert.ErrorIs(t, err, ErrArchiveTooLarge)
+
+		_, err = zr.Next()
+		assert.ErrorIs(t, err, ErrArchiveTooLarge)
+	})
+
+	t.Run("counts skipped entries towards the total limit", func(t *testing.T) {
+		data := buildZip(t, []testEntry{
+			{name: "a.txt", content: strings.Repeat("a", 2000), method: zip.Deflate},
+			{name: "b.txt", content: "b", method: zip.Store},
+		})
+		zr := NewZipReader(bytes.NewReader(data), Limits{MaxTotalBytes: 1000})
+
+		_, err := zr.Next()
+		require.NoError(t, err)
+		_, err = zr.Next()
+		assert.ErrorIs(t, err, ErrArchiveTooLarge)
+	})
+
+	t.Run("rejects too many entries", func(t *testing.T) {
+		data := buildZip(t, []testEntry{
+			{name: "a.txt", method: zip.Store},
+			{name: "b.txt", method: zip.Store},
+			{name: "c.txt", method: zip.Store},
+		})
+		zr := NewZipReader(bytes.NewReader(data), Limits{MaxEntries: 2})
+
+		for range 2 {
+			_, err := zr.Next()
+			require.NoError(t, err)
+		}
+		_, err := zr.Next()
+		assert.ErrorIs(t, err, ErrTooManyEntries)
+	})
+
+	t.Run("rejects a checksum mismatch", func(t *testing.T) {
+		data := buildRawZip(t, &zip.FileHeader{
+			Name:               "a.txt",
+			Method:             zip.Store,
+			CRC32:              crc32.ChecksumIEEE([]byte("abd")),
+			CompressedSize64:   3,
+			UncompressedSize64: 3,
+		}, []byte("abc"))
+		zr := NewZipReader(bytes.NewReader(data), Limits{})
+
+		_, err := zr.Next()
+		require.NoError(t, err)
+		_, err = io.ReadAll(zr)
+		assert.ErrorIs(t, err, ErrChecksum)
+	})
+
+	t.Run("rejects encrypted entries", func(t *testing.T) {
+		data := buildRawZip(t, &zip.FileHeader{
+			Name:               "secret.txt",
+			Method:             zip.Store,
+			Flags:              0x1,
+			CompressedSize64:   3,
+			UncompressedSize64: 3,
+		}, []byte("abc"))
+		zr := NewZipReader(bytes.NewReader(data), Limits{})
+
+		_, err := zr.Next()
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+
+	t.Run("rejects truncated and non-zip streams", func(t *testing.T) {
+		data := buildZip(t, []testEntry{{name: "a.txt", content: "hello", method: zip.Store}})
+		zr := NewZipReader(bytes.NewReader(data[:37]), Limits{})
+		_, err := zr.Next()
+		if err == nil {
+			_, err = io.ReadAll(zr)
+		}
+		assert.ErrorIs(t, err, ErrFormat)
+
+		zr = NewZipReader(strings.NewReader("not a zip file"), Limits{})
+		_, err = zr.Next()
+		assert.ErrorIs(t, err, ErrFormat)
+	})
+}
+
+func TestZipReaderMemoryBounded(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping memory test in short mode")
+	}
+
+	// Stream a 256MB entry through a pipe so that neither the archive nor
+	// its content is ever held in memory.
+	const size = 256 << 20
+	pr, pw := io.Pipe()
+	go func() {
+		zw := zip.NewWriter(pw)
+		w, err := zw.Create("zeros.bin")
+		if err == nil {
+			chunk := make([]byte, 64<<10)
+			for written := 0; written < size && err == nil; written += len(chunk) {
+				_, err = w.Write(chunk)
+			}
+		}
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	var read int64
+	prof := profiler.New(nil, true)
+	profile, err := prof.ProfileFunc(context.Background(), "stream_zip", func() error {
+		zr := NewZipReader(pr, Limits{MaxEntryBytes: size, MaxTotalBytes: size})
+		if _, err := zr.Next(); err != nil {
+			return err
+		}
+		n, err := io.Copy(io.Discard, zr)
+		read = n
+		if err != nil {
+			return err
+		}
+		_, err = zr.Next()
+		if err != io.EOF {
+			return err
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(size), read)
+
+	const maxMemoryDelta = 16 << 20
+	assert.Less(t, profile.MemoryDelta, int64(maxMemoryDelta),
+		"streaming a %d byte entry grew the heap by %d bytes", size, profile.MemoryDelta)
+}
@@ -6,6 +6,8 @@ import (
 	stderrors "errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/github/github-mcp-server/pkg/utils"
@@ -32,6 +34,12 @@ func (e *GitHubAPIError) Error() string {
 	return fmt.Errorf("%s: %w", e.Message, e.Err).Error()
 }
 
+// Unwrap returns the underlying error, so that errors.As can reach the
+// *github.ErrorResponse it wraps.
+func (e *GitHubAPIError) Unwrap() error {
+	return e.Err
+}
+
 type GitHubGraphQLError struct {
 	Message string `json:"message"`
 	Err     error  `json:"-"`
@@ -66,6 +74,106 @@ func (e *GitHubRawAPIError) Error() string {
 	return fmt.Errorf("%s: %w", e.Message, e.Err).Error()
 }
 
+// ssoHeader is set by GitHub when a request is denied because the token has
+// not been authorized for an organization that enforces SAML single sign-on.
+const ssoHeader = "X-GitHub-SSO"
+
+// ssoAuthorizationURL returns the URL at which the token can be authorized for
+// the organization when resp is a 403 caused by SAML SSO enforcement. The
+// header then has the form "required; url=<authorization URL>".
+func ssoAuthorizationURL(resp *http.Response) (string, bool) {
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		return "", false
+	}
+	directive, params, _ := strings.Cut(resp.Header.Get(ssoHeader), ";")
+	if strings.TrimSpace(directive) != "required" {
+		return "", false
+	}
+	for _, param := range strings.Split(params, ";") {
+		if authURL, ok := strings.CutPrefix(strings.TrimSpace(param), "url="); ok {
+			return authURL, true
+		}
+	}
+	return "", true
+}
+
+// ssoErrorResult returns an actionable error result when resp shows the token
+// is not authorized for a SAML SSO organization.
+func ssoErrorResult(message string, resp *http.Response) (*mcp.CallToolResult, bool) {
+	authURL, ok := ssoAuthorizationURL(resp)
+	if !ok {
+		return nil, false
+	}
+	if authURL == "" {
+		return utils.NewToolResultError(fmt.Sprintf(
+			"%s: the organization enforces SAML single sign-on and the token has not been authorized for it. "+
+				"Authorize the token for the organization in your GitHub token settings, then retry.", message)), true
+	}
+	return utils.NewToolResultError(fmt.Sprintf(
+		"%s: the organization enforces SAML single sign-on and the token has not been authorized for it. "+
+			"Authorize the token at %s, then retry.", message, authURL)), true
+}
+
+// sanitizeURL redacts the client_secret query parameter, as go-github does
+// when it formats an ErrorResponse.
+func sanitizeURL(u *url.URL) string {
+	params := u.Query()
+	if params.Get("client_secret") == "" {
+		return u.String()
+	}
+	params.Set("client_secret", "REDACTED")
+	sanitized := *u
+	sanitized.RawQuery = params.Encode()
+	return sanitized.String()
+}
+
+// formatErrorResponse renders a GitHub API error with its HTTP status, the
+// GitHub message, the documentation URL and any field-level validation
+// errors, each on its own line so that callers can act on them.
+func formatErrorResponse(message string, err *github.ErrorResponse) string {
+	var b strings.Builder
+	b.WriteString(message)
+	b.WriteString(": ")
+	if resp := err.Response; resp != nil {
+		if resp.Request != nil && resp.Request.URL != nil {
+			fmt.Fprintf(&b, "%s %s: ", resp.Request.Method, sanitizeURL(resp.Request.URL))
+		}
+		fmt.Fprintf(&b, "%d ", resp.StatusCode)
+	}
+	b.WriteString(err.Message)
+
+	if resp := err.Response; resp != nil {
+		fmt.Fprintf(&b, "\nstatus: %d", resp.StatusCode)
+	}
+	if err.Message != "" {
+		fmt.Fprintf(&b, "\nmessage: %s", err.Message)
+	}
+	if err.DocumentationURL != "" {
+		fmt.Fprintf(&b, "\ndocumentation_url: %s", err.DocumentationURL)
+	}
+	if len(err.Errors) > 0 {
+		b.WriteString("\nfield errors:")
+		for _, fieldErr := range err.Errors {
+			b.WriteString("\n  - ")
+			var parts []string
+			if fieldErr.Resource != "" {
+				parts = append(parts, "resource: "+fieldErr.Resource)
+			}
+			if fieldErr.Field != "" {
+				parts = append(parts, "field: "+fieldErr.Field)
+			}
+			if fieldErr.Code != "" {
+				parts = append(parts, "code: "+fieldErr.Code)
+			}
+			if fieldErr.Message != "" {
+				parts = append(parts, "message: "+fieldErr.Message)
+			}
+			b.WriteString(strings.Join(parts, ", "))
+		}
+	}
+	return b.String()
+}
+
 type GitHubErrorKey struct{}
 type GitHubCtxErrors struct {
 	api     []*GitHubAPIError
@@ -191,6 +299,17 @@ func NewGitHubAPIErrorResponse(ctx context.Context, message string, resp *github
 			"%s: GitHub secondary rate limit exceeded. Wait before retrying.", message))
 	}
 
+	if resp != nil {
+		if result, ok := ssoErrorResult(message, resp.Response); ok {
+			return result
+		}
+	}
+
+	var ghErr *github.ErrorResponse
+	if stderrors.As(err, &ghErr) {
+		return utils.NewToolResultError(formatErrorResponse(message, ghErr))
+	}
+
 	return utils.NewToolResultErrorFromErr(message, err)
 }
 
@@ -209,6 +328,9 @@ func NewGitHubRawAPIErrorResponse(ctx context.Context, message string, resp *htt
 	if ctx != nil {
 		_, _ = addRawAPIErrorToContext(ctx, rawErr) // Explicitly ignore error for graceful handling
 	}
+	if result, ok := ssoErrorResult(message, resp); ok {
+		return result
+	}
 	return utils.NewToolResultErrorFromErr(message, err)
 }
 
@@ -2,12 +2,14 @@ package errors
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"github.com/google/go-github/v89/github"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -376,6 +378,16 @@ func TestGitHubErrorTypes(t *testing.T) {
 		assert.Equal(t, "test message: not found", err.Error())
 	})
 
+	t.Run("GitHubAPIError unwraps to the underlying error", func(t *testing.T) {
+		ghErr := &github.ErrorResponse{Response: &http.Response{StatusCode: 422}, Message: "Validation Failed"}
+
+		var err error = newGitHubAPIError("test message", nil, ghErr)
+
+		var unwrapped *github.ErrorResponse
+		require.True(t, stderrors.As(err, &unwrapped))
+		assert.Equal(t, ghErr, unwrapped)
+	})
+
 	t.Run("GitHubGraphQLError implements error interface", func(t *testing.T) {
 		originalErr := fmt.Errorf("query failed")
 
@@ -687,3 +699,124 @@ func TestNewGitHubAPIErrorResponse_RateLimits(t *testing.T) {
 		assert.Contains(t, text, "validation failed")
 	})
 }
+
+func TestNewGitHubAPIErrorResponse_SAMLSSO(t *testing.T) {
+	ssoResponse := func(header string) *http.Response {
+		resp := &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}}
+		if header != "" {
+			resp.Header.Set("X-GitHub-SSO", header)
+		}
+		return resp
+	}
+
+	t.Run("SSO-enforced 403 tells the user where to authorize the token", func(t *testing.T) {
+		// Given a context with GitHub error tracking enabled
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		resp := &github.Response{Response: ssoResponse("required; url=https://github.com/orgs/octo-org/sso?authorization_request=abc123")}
+		originalErr := fmt.Errorf("Resource protected by organization SAML enforcement")
+
+		// When we create an API error response for the SSO failure
+		result := NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, originalErr)
+
+		// Then the message should explain how to authorize the token
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "failed to get repository: the organization enforces SAML single sign-on")
+		assert.Contains(t, text, "Authorize the token at https://github.com/orgs/octo-org/sso?authorization_request=abc123, then retry.")
+
+		// And the original error should still be stored in context for middleware
+		assertContextHasError(t, ctx, originalErr)
+	})
+
+	t.Run("SSO-enforced 403 without a URL still explains the failure", func(t *testing.T) {
+		resp := &github.Response{Response: ssoResponse("required")}
+
+		result := NewGitHubAPIErrorResponse(context.Background(), "failed to get repository", resp, fmt.Errorf("forbidden"))
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "the organization enforces SAML single sign-on")
+		assert.Contains(t, text, "in your GitHub token settings")
+	})
+
+	t.Run("partial results header is not treated as an SSO failure", func(t *testing.T) {
+		resp := &github.Response{Response: ssoResponse("partial-results; organizations=21955855")}
+
+		result := NewGitHubAPIErrorResponse(context.Background(), "failed to list repositories", resp, fmt.Errorf("forbidden"))
+
+		text := requireErrorText(t, result)
+		assert.NotContains(t, text, "single sign-on")
+		assert.Contains(t, text, "forbidden")
+	})
+
+	t.Run("403 without the SSO header passes through the original error", func(t *testing.T) {
+		resp := &github.Response{Response: ssoResponse("")}
+
+		result := NewGitHubAPIErrorResponse(context.Background(), "failed to get repository", resp, fmt.Errorf("forbidden"))
+
+		text := requireErrorText(t, result)
+		assert.NotContains(t, text, "single sign-on")
+	})
+
+	t.Run("raw API errors detect SSO enforcement too", func(t *testing.T) {
+		resp := ssoResponse("required; url=https://github.com/orgs/octo-org/sso?authorization_request=abc123")
+
+		result := NewGitHubRawAPIErrorResponse(context.Background(), "failed to get raw file", resp, fmt.Errorf("forbidden"))
+
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "Authorize the token at https://github.com/orgs/octo-org/sso?authorization_request=abc123")
+	})
+}
+
+func TestNewGitHubAPIErrorResponse_ErrorDetails(t *testing.T) {
+	t.Run("422 with field errors surfaces status, message, documentation and fields", func(t *testing.T) {
+		// Given a context with GitHub error tracking enabled
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		reqURL, err := url.Parse("https://api.github.com/repos/owner/repo/issues")
+		require.NoError(t, err)
+		httpResp := &http.Response{
+			StatusCode: http.StatusUnprocessableEntity,
+			Request:    &http.Request{Method: http.MethodPost, URL: reqURL},
+		}
+		validationErr := &github.ErrorResponse{
+			Response: httpResp,
+			Message:  "Validation Failed",
+			Errors: []github.Error{
+				{Resource: "Issue", Field: "title", Code: "missing_field"},
+				{Resource: "Label", Field: "name", Code: "custom", Message: "name is too long"},
+			},
+			DocumentationURL: "https://docs.github.com/rest/issues/issues#create-an-issue",
+		}
+
+		// When we create an API error response for the validation failure
+		result := NewGitHubAPIErrorResponse(ctx, "failed to create issue", &github.Response{Response: httpResp}, validationErr)
+
+		// Then every detail of the GitHub error should be in the tool result
+		text := requireErrorText(t, result)
+		assert.Contains(t, text, "failed to create issue: POST https://api.github.com/repos/owner/repo/issues: 422 Validation Failed")
+		assert.Contains(t, text, "status: 422")
+		assert.Contains(t, text, "message: Validation Failed")
+		assert.Contains(t, text, "documentation_url: https://docs.github.com/rest/issues/issues#create-an-issue")
+		assert.Contains(t, text, "- resource: Issue, field: title, code: missing_field")
+		assert.Contains(t, text, "- resource: Label, field: name, code: custom, message: name is too long")
+
+		// And the original error should still be stored in context for middleware
+		assertContextHasError(t, ctx, validationErr)
+	})
+
+	t.Run("client_secret is redacted from the request URL", func(t *testing.T) {
+		reqURL, err := url.Parse("https://api.github.com/applications/grants?client_secret=s3cret")
+		require.NoError(t, err)
+		httpResp := &http.Response{
+			StatusCode: http.StatusNotFound,
+			Request:    &http.Request{Method: http.MethodGet, URL: reqURL},
+		}
+		notFoundErr := &github.ErrorResponse{Response: httpResp, Message: "Not Found"}
+
+		result := NewGitHubAPIErrorResponse(context.Background(), "failed to list grants", &github.Response{Response: httpResp}, notFoundErr)
+
+		text := requireErrorText(t, result)
+		assert.NotContains(t, text, "s3cret")
+		assert.Contains(t, text, "client_secret=REDACTED")
+	})
+}
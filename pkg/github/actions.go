@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/github/github-mcp-server/internal/profiler"
 	buffer "github.com/github/github-mcp-server/pkg/buffer"
@@ -46,8 +47,19 @@ const (
 	actionsMethodDeleteWorkflowRunLogs    = "delete_workflow_run_logs"
 )
 
+const (
+	// inlineJobLogTailLines is how much of the end of each failed job's log
+	// list_workflow_jobs inlines when include_failed_logs is set.
+	inlineJobLogTailLines = 100
+	// maxInlineJobLogsBytes bounds the log content inlined across all jobs.
+	maxInlineJobLogsBytes = 32 * 1024
+	// maxJobLogsConcurrency bounds the number of job logs get_job_logs
+	// fetches at once for failed_only.
+	maxJobLogsConcurrency = 5
+)
+
 // handleFailedJobLogs gets logs for all failed jobs in a workflow run
-func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, tailLines int, contentWindowSize int) (*mcp.CallToolResult, any, error) {
+func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, tailLines int, contentWindowSize int, logOpts logContentOptions) (*mcp.CallToolResult, any, error) {
 	// First, get all jobs for the workflow run
 	jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{
 		Filter: "latest",
@@ -76,19 +88,35 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 		return utils.NewToolResultText(string(r)), nil, nil
 	}
 
-	// Collect logs for all failed jobs
+	// Collect logs for all failed jobs. The fetches run concurrently, but the
+	// results are handled in job order and the context is not safe to record
+	// errors into concurrently, so that happens afterwards.
+	type jobLogFetch struct {
+		result map[string]any
+		resp   *github.Response
+		err    error
+	}
+	fetches, _ := runBounded(ctx, maxJobLogsConcurrency, len(failedJobs), func(ctx context.Context, i int) (jobLogFetch, error) {
+		job := failedJobs[i]
+		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), returnContent, tailLines, contentWindowSize, logOpts)
+		return jobLogFetch{result: jobResult, resp: resp, err: err}, nil
+	})
 	var logResults []map[string]any
-	for _, job := range failedJobs {
-		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), returnContent, tailLines, contentWindowSize)
-		if err != nil {
+	for i, fetch := range fetches {
+		jobResult := fetch.result
+		if err := fetch.err; err != nil || jobResult == nil {
+			if err == nil {
+				// The context was cancelled before the fetch started.
+				err = ctx.Err()
+			}
 			// Continue with other jobs even if one fails
 			jobResult = map[string]any{
-				"job_id":   job.GetID(),
-				"job_name": job.GetName(),
+				"job_id":   failedJobs[i].GetID(),
+				"job_name": failedJobs[i].GetName(),
 				"error":    err.Error(),
 			}
 			// Enable reporting of status codes and error causes
-			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", resp, err) // Explicitly ignore error for graceful handling
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", fetch.resp, err) // Explicitly ignore error for graceful handling
 		}
 
 		logResults = append(logResults, jobResult)
@@ -112,8 +140,8 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 }
 
 // handleSingleJobLogs gets logs for a single job
-func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo string, jobID int64, returnContent bool, tailLines int, contentWindowSize int) (*mcp.CallToolResult, any, error) {
-	jobResult, resp, err := getJobLogData(ctx, client, owner, repo, jobID, "", returnContent, tailLines, contentWindowSize)
+func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo string, jobID int64, returnContent bool, tailLines int, contentWindowSize int, logOpts logContentOptions) (*mcp.CallToolResult, any, error) {
+	jobResult, resp, err := getJobLogData(ctx, client, owner, repo, jobID, "", returnContent, tailLines, contentWindowSize, logOpts)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get job logs", resp, err), nil, nil
 	}
@@ -127,7 +155,7 @@ func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo
 }
 
 // getJobLogData retrieves log data for a single job, either as URL or content
-func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, returnContent bool, tailLines int, contentWindowSize int) (map[string]any, *github.Response, error) {
+func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, returnContent bool, tailLines int, contentWindowSize int, logOpts logContentOptions) (map[string]any, *github.Response, error) {
 	// Get the download URL for the job logs
 	url, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 1)
 	if err != nil {
@@ -144,7 +172,7 @@ func getJobLogData(ctx context.Context, client *github.Client, owner, repo strin
 
 	if returnContent {
 		// Download and return the actual log content
-		content, originalLength, httpResp, err := downloadLogContent(ctx, url.String(), tailLines, contentWindowSize) //nolint:bodyclose // Response body is closed in downloadLogContent, but we need to return httpResp
+		content, originalLength, httpResp, err := downloadLogContent(ctx, url.String(), tailLines, contentWindowSize, logOpts) //nolint:bodyclose // Response body is closed in downloadLogContent, but we need to return httpResp
 		if err != nil {
 			// To keep the return value consistent wrap the response as a GitHub Response
 			ghRes := &github.Response{
@@ -165,7 +193,7 @@ func getJobLogData(ctx context.Context, client *github.Client, owner, repo strin
 	return result, resp, nil
 }
 
-func downloadLogContent(ctx context.Context, logURL string, tailLines int, maxLines int) (string, int, *http.Response, error) {
+func downloadLogContent(ctx context.Context, logURL string, tailLines int, maxLines int, logOpts logContentOptions) (string, int, *http.Response, error) {
 	prof := profiler.New(nil, profiler.IsProfilingEnabled())
 	finish := prof.Start(ctx, "log_buffer_processing")
 
@@ -190,7 +218,7 @@ func downloadLogContent(ctx context.Context, logURL string, tailLines int, maxLi
 	if len(lines) > tailLines {
 		lines = lines[len(lines)-tailLines:]
 	}
-	finalResult := strings.Join(lines, "\n")
+	finalResult := logOpts.apply(strings.Join(lines, "\n"))
 
 	_ = finish(len(lines), int64(len(finalResult)))
 
@@ -297,6 +325,11 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 							},
 						},
 					},
+					"output_format": outputFormatSchemaProperty("**ONLY** used when method is 'list_workflow_runs'"),
+					"include_failed_logs": {
+						Type:        "boolean",
+						Description: "Include the last lines of the logs of each failed job. **ONLY** used when method is 'list_workflow_jobs'",
+					},
 					"workflow_jobs_filter": {
 						Type:        "object",
 						Description: "Filters for workflow jobs. **ONLY** used when method is 'list_workflow_jobs'",
@@ -345,7 +378,12 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			includeFailedLogs, err := OptionalParam[bool](args, "include_failed_logs")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -388,10 +426,14 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 				result, payload, err := listWorkflows(ctx, client, owner, repo, pagination)
 				return attachIFC(result), payload, err
 			case actionsMethodListWorkflowRuns:
-				result, payload, err := listWorkflowRuns(ctx, client, args, owner, repo, resourceID, pagination)
+				outputFormat, err := OptionalOutputFormat(args)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				result, payload, err := listWorkflowRuns(ctx, client, args, owner, repo, resourceID, pagination, outputFormat)
 				return attachIFC(result), payload, err
 			case actionsMethodListWorkflowJobs:
-				result, payload, err := listWorkflowJobs(ctx, client, args, owner, repo, resourceIDInt, pagination)
+				result, payload, err := listWorkflowJobs(ctx, client, args, owner, repo, resourceIDInt, pagination, includeFailedLogs, deps.GetContentWindowSize())
 				return attachIFC(result), payload, err
 			case actionsMethodListWorkflowArtifacts:
 				result, payload, err := listWorkflowArtifacts(ctx, client, owner, repo, resourceIDInt, pagination)
@@ -449,6 +491,7 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 - Provide a job ID for 'get_workflow_job' method.
 `,
 					},
+					"fields": fieldPathsSchemaProperty(),
 				},
 				Required: []string{"method", "owner", "repo", "resource_id"},
 			},
@@ -473,17 +516,23 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			fields, err := OptionalStringArrayParam(args, "fields")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			// attachIFC adds the IFC label to a successful Actions result when
-			// IFC labels are enabled. Workflow runs, jobs, artifacts, usage,
-			// and log URLs reflect attacker-influenceable run output, so
-			// integrity is untrusted; confidentiality follows repo visibility.
+			// attachIFC trims the result to the requested fields and adds the
+			// IFC label to a successful Actions result when IFC labels are
+			// enabled. Workflow runs, jobs, artifacts, usage, and log URLs
+			// reflect attacker-influenceable run output, so integrity is
+			// untrusted; confidentiality follows repo visibility.
 			attachIFC := func(r *mcp.CallToolResult) *mcp.CallToolResult {
-				return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, r, ifc.LabelActionsResult)
+				return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, projectResultFields(r, fields), ifc.LabelActionsResult)
 			}
 
 			var resourceIDInt int64
@@ -682,13 +731,26 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 					},
 					"return_content": {
 						Type:        "boolean",
-						Description: "Returns actual log content instead of URLs",
+						Description: "Returns actual log content instead of URLs. The default depends on the server configuration.",
 					},
 					"tail_lines": {
 						Type:        "number",
 						Description: "Number of lines to return from the end of the log",
 						Default:     json.RawMessage(`500`),
 					},
+					"strip_ansi": {
+						Type:        "boolean",
+						Description: "Remove ANSI escape sequences such as color codes from the returned log content",
+						Default:     json.RawMessage(`true`),
+					},
+					"strip_timestamps": {
+						Type:        "boolean",
+						Description: "Remove the timestamp GitHub prefixes to each line of the returned log content",
+					},
+					"collapse_repeated": {
+						Type:        "boolean",
+						Description: "Fold runs of identical consecutive lines of the returned log content into one line suffixed with (xN)",
+					},
 				},
 				Required: []string{"owner", "repo"},
 			},
@@ -719,7 +781,7 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			returnContent, err := OptionalParam[bool](args, "return_content")
+			returnContent, err := OptionalBoolParamWithDefault(args, "return_content", deps.GetDefaultReturnContent())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -733,6 +795,24 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 				tailLines = 500
 			}
 
+			stripANSI, err := OptionalBoolParamWithDefault(args, "strip_ansi", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			stripTimestamps, err := OptionalParam[bool](args, "strip_timestamps")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			collapseRepeated, err := OptionalParam[bool](args, "collapse_repeated")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			logOpts := logContentOptions{
+				StripANSI:        stripANSI,
+				StripTimestamps:  stripTimestamps,
+				CollapseRepeated: collapseRepeated,
+			}
+
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
@@ -756,11 +836,11 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 
 			if failedOnly && runID > 0 {
 				// Handle failed-only mode: get logs for all failed jobs in the workflow run
-				result, payload, err := handleFailedJobLogs(ctx, client, owner, repo, int64(runID), returnContent, tailLines, deps.GetContentWindowSize())
+				result, payload, err := handleFailedJobLogs(ctx, client, owner, repo, int64(runID), returnContent, tailLines, deps.GetContentWindowSize(), logOpts)
 				return attachIFC(result), payload, err
 			} else if jobID > 0 {
 				// Handle single job mode
-				result, payload, err := handleSingleJobLogs(ctx, client, owner, repo, int64(jobID), returnContent, tailLines, deps.GetContentWindowSize())
+				result, payload, err := handleSingleJobLogs(ctx, client, owner, repo, int64(jobID), returnContent, tailLines, deps.GetContentWindowSize(), logOpts)
 				return attachIFC(result), payload, err
 			}
 
@@ -842,7 +922,7 @@ func listWorkflows(ctx context.Context, client *github.Client, owner, repo strin
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func listWorkflowRuns(ctx context.Context, client *github.Client, args map[string]any, owner, repo, resourceID string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+func listWorkflowRuns(ctx context.Context, client *github.Client, args map[string]any, owner, repo, resourceID string, pagination PaginationParams, outputFormat string) (*mcp.CallToolResult, any, error) {
 	filterArgs, err := OptionalParam[map[string]any](args, "workflow_runs_filter")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
@@ -884,6 +964,10 @@ func listWorkflowRuns(ctx context.Context, client *github.Client, args map[strin
 	}
 
 	defer func() { _ = resp.Body.Close() }()
+	if outputFormat == OutputFormatMarkdown {
+		return utils.NewToolResultText(workflowRunsMarkdown(workflowRuns)), nil, nil
+	}
+
 	r, err := json.Marshal(workflowRuns)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal workflow runs: %w", err)
@@ -892,7 +976,7 @@ func listWorkflowRuns(ctx context.Context, client *github.Client, args map[strin
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func listWorkflowJobs(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, resourceID int64, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+func listWorkflowJobs(ctx context.Context, client *github.Client, args map[string]any, owner, repo string, resourceID int64, pagination PaginationParams, includeFailedLogs bool, contentWindowSize int) (*mcp.CallToolResult, any, error) {
 	filterArgs, err := OptionalParam[map[string]any](args, "workflow_jobs_filter")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
@@ -918,11 +1002,15 @@ func listWorkflowJobs(ctx context.Context, client *github.Client, args map[strin
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow jobs", resp, err), nil, nil
 	}
 
+	defer func() { _ = resp.Body.Close() }()
+
 	response := map[string]any{
 		"jobs": workflowJobs,
 	}
+	if includeFailedLogs {
+		response["failed_job_logs"] = inlineFailedJobLogs(ctx, client, owner, repo, workflowJobs.Jobs, contentWindowSize)
+	}
 
-	defer func() { _ = resp.Body.Close() }()
 	r, err := json.Marshal(response)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal workflow jobs: %w", err)
@@ -931,6 +1019,54 @@ func listWorkflowJobs(ctx context.Context, client *github.Client, args map[strin
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// inlineFailedJobLogs returns the tail of the logs of each failed job. The
+// content across all jobs is bounded by maxInlineJobLogsBytes: a job whose
+// tail does not fit is cut from the start, and once the budget is spent the
+// remaining jobs are listed without content.
+func inlineFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, jobs []*github.WorkflowJob, contentWindowSize int) []map[string]any {
+	logs := []map[string]any{}
+	budget := maxInlineJobLogsBytes
+	for _, job := range jobs {
+		if job.GetConclusion() != "failure" {
+			continue
+		}
+		if budget <= 0 {
+			logs = append(logs, map[string]any{
+				"job_id":       job.GetID(),
+				"job_name":     job.GetName(),
+				"logs_omitted": true,
+				"message":      "Log budget exhausted; use get_job_logs for this job",
+			})
+			continue
+		}
+
+		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), true, inlineJobLogTailLines, contentWindowSize, logContentOptions{StripANSI: true})
+		if err != nil {
+			logs = append(logs, map[string]any{
+				"job_id":   job.GetID(),
+				"job_name": job.GetName(),
+				"error":    err.Error(),
+			})
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", resp, err) // Explicitly ignore error for graceful handling
+			continue
+		}
+
+		content, _ := jobResult["logs_content"].(string)
+		if len(content) > budget {
+			content = content[len(content)-budget:]
+			// Don't start in the middle of a multi-byte character.
+			for len(content) > 0 && !utf8.RuneStart(content[0]) {
+				content = content[1:]
+			}
+			jobResult["logs_content"] = content
+			jobResult["truncated"] = true
+		}
+		budget -= len(content)
+		logs = append(logs, jobResult)
+	}
+	return logs
+}
+
 func listWorkflowArtifacts(ctx context.Context, client *github.Client, owner, repo string, resourceID int64, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
 	opts := &github.ListOptions{
 		PerPage: pagination.PerPage,
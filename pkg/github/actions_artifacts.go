@@ -0,0 +1,364 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/github/github-mcp-server/pkg/archive"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// maxArtifactDownloadBytes bounds the size of an artifact ZIP that is
+	// downloaded.
+	maxArtifactDownloadBytes = 50 * 1024 * 1024
+	// maxArtifactEntryBytes bounds the uncompressed size of a single ZIP
+	// entry that is read.
+	maxArtifactEntryBytes = 10 * 1024 * 1024
+	// maxArtifactUncompressedBytes bounds the uncompressed size of all the
+	// entries of an artifact that are read or skipped.
+	maxArtifactUncompressedBytes = 200 * 1024 * 1024
+	// maxArtifactEntries bounds how many entries an artifact may have.
+	maxArtifactEntries = 10000
+	// maxArtifactListPages bounds how many pages of 100 artifacts are
+	// searched when looking up an artifact by name.
+	maxArtifactListPages = 5
+	// maxArtifactEntriesListed bounds how many entries get_artifact_contents lists.
+	maxArtifactEntriesListed = 500
+	// defaultArtifactEntryMaxBytes is the default content budget of get_artifact_contents.
+	defaultArtifactEntryMaxBytes = 100 * 1024
+)
+
+var (
+	// errArtifactEntryTooLarge is returned when a ZIP entry exceeds the byte
+	// limit it is read with.
+	errArtifactEntryTooLarge = errors.New("artifact entry exceeds the size limit")
+	// errArtifactTooLarge is returned when an artifact download is larger
+	// than maxArtifactDownloadBytes.
+	errArtifactTooLarge = fmt.Errorf("artifact is larger than the %d byte limit", maxArtifactDownloadBytes)
+)
+
+// findWorkflowRunArtifact returns the artifact of a run with the given name.
+// It returns a nil artifact when the run has no artifact of that name.
+func findWorkflowRunArtifact(ctx context.Context, client *github.Client, owner, repo string, runID int64, name string) (*github.Artifact, *github.Response, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for page := 0; page < maxArtifactListPages; page++ {
+		artifacts, resp, err := client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, runID, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, artifact := range artifacts.Artifacts {
+			if artifact.GetName() == name {
+				return artifact, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+	return nil, nil, nil
+}
+
+// signedURLClient fetches the pre-signed URLs the API redirects to for
+// artifact and log downloads. Those URLs carry their own authorization and
+// point at storage hosts outside the API, so they must not go through the
+// GitHub client, whose transport adds the user's token to every request.
+var signedURLClient = &http.Client{}
+
+// openArtifactZip downloads an artifact and returns a reader streaming its
+// ZIP entries within limits, along with the download body to close once
+// reading is done. Artifacts listed as larger than maxArtifactDownloadBytes
+// are rejected. A non-nil result reports why the artifact could not be opened.
+func openArtifactZip(ctx context.Context, client *github.Client, owner, repo string, artifact *github.Artifact, limits archive.Limits) (*archive.ZipReader, io.Closer, *mcp.CallToolResult) {
+	if artifact.GetExpired() {
+		return nil, nil, utils.NewToolResultError(fmt.Sprintf("artifact %q has expired", artifact.GetName()))
+	}
+	if artifact.GetSizeInBytes() > maxArtifactDownloadBytes {
+		return nil, nil, utils.NewToolResultError(fmt.Sprintf("artifact %q is %d bytes, larger than the %d byte limit", artifact.GetName(), artifact.GetSizeInBytes(), maxArtifactDownloadBytes))
+	}
+
+	url, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, artifact.GetID(), 1)
+	if err != nil {
+		return nil, nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact download URL", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, nil, utils.NewToolResultErrorFromErr("failed to create artifact download request", err)
+	}
+	httpResp, err := signedURLClient.Do(req) //nolint:gosec // the URL comes from the GitHub API
+	if err != nil {
+		return nil, nil, utils.NewToolResultErrorFromErr("failed to download artifact", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(io.LimitReader(httpResp.Body, 64*1024))
+		_ = httpResp.Body.Close()
+		if err != nil {
+			return nil, nil, utils.NewToolResultErrorFromErr("failed to read response body", err)
+		}
+		return nil, nil, ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to download artifact", &github.Response{Response: httpResp}, body)
+	}
+
+	// The listed size is not authoritative, so the download is bounded too.
+	body := &artifactDownloadReader{r: httpResp.Body, remaining: maxArtifactDownloadBytes}
+	return archive.NewZipReader(body, limits), httpResp.Body, nil
+}
+
+// artifactDownloadReader fails with errArtifactTooLarge once more than
+// remaining bytes have been read.
+type artifactDownloadReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (a *artifactDownloadReader) Read(p []byte) (int, error) {
+	if a.remaining <= 0 {
+		return 0, errArtifactTooLarge
+	}
+	if int64(len(p)) > a.remaining {
+		p = p[:a.remaining]
+	}
+	n, err := a.r.Read(p)
+	a.remaining -= int64(n)
+	return n, err
+}
+
+// artifactReadError returns the tool error for a failure to read the entries
+// of an artifact.
+func artifactReadError(artifact *github.Artifact, err error) *mcp.CallToolResult {
+	return utils.NewToolResultErrorFromErr(fmt.Sprintf("failed to read artifact %q", artifact.GetName()), err)
+}
+
+// readArtifactEntry reads the content of the current entry of zr, returning
+// errArtifactEntryTooLarge when it exceeds limit bytes.
+func readArtifactEntry(zr *archive.ZipReader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(zr, limit+1))
+	if errors.Is(err, archive.ErrEntryTooLarge) {
+		return nil, errArtifactEntryTooLarge
+	}
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, errArtifactEntryTooLarge
+	}
+	return data, nil
+}
+
+// artifactEntrySize returns the decompressed size of the current entry of zr,
+// of which read bytes were already read. When the entry's header does not
+// declare its size, the rest of the entry is read to count it.
+func artifactEntrySize(zr *archive.ZipReader, entry *archive.Entry, read int64) (int64, error) {
+	if entry.Size >= 0 {
+		return entry.Size, nil
+	}
+	n, err := io.Copy(io.Discard, zr)
+	return read + n, err
+}
+
+// truncateArtifactContent cuts data to limit bytes and reports whether it was
+// longer. A multi-byte UTF-8 character cut off at the limit is dropped.
+func truncateArtifactContent(data []byte, limit int) ([]byte, bool) {
+	if len(data) <= limit {
+		return data, false
+	}
+	data = data[:limit]
+	if i := lastRuneStart(data); !utf8.FullRune(data[i:]) {
+		data = data[:i]
+	}
+	return data, true
+}
+
+// ArtifactEntry is a file in an artifact ZIP archive.
+type ArtifactEntry struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// ArtifactEntryContent is the text content of an artifact entry.
+type ArtifactEntryContent struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+	// Truncated reports that the entry is larger than max_bytes and Content
+	// holds only its beginning.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ArtifactContents is the output of get_artifact_contents.
+type ArtifactContents struct {
+	ArtifactID       int64                 `json:"artifact_id"`
+	Name             string                `json:"name"`
+	Entries          []ArtifactEntry       `json:"entries"`
+	EntriesTruncated bool                  `json:"entries_truncated,omitempty"`
+	Entry            *ArtifactEntryContent `json:"entry,omitempty"`
+}
+
+// GetArtifactContents creates a tool to list the files of a workflow artifact and read one of them.
+func GetArtifactContents(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "get_artifact_contents",
+			Description: t("TOOL_GET_ARTIFACT_CONTENTS_DESCRIPTION",
+				"Download a GitHub Actions artifact and list the files in it. Provide path to also return the text content of one file; "+
+					"content beyond max_bytes is cut off and marked truncated. Binary files cannot be returned."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ARTIFACT_CONTENTS_USER_TITLE", "Get artifact contents"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"artifact_id": {
+						Type:        "number",
+						Description: "The unique identifier of the artifact",
+					},
+					"path": {
+						Type:        "string",
+						Description: "Path of a file inside the artifact to return the content of",
+					},
+					"max_bytes": {
+						Type:        "number",
+						Description: "Maximum number of bytes of content to return for the file at path",
+						Default:     json.RawMessage(strconv.Itoa(defaultArtifactEntryMaxBytes)),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(1024 * 1024.0),
+					},
+				},
+				Required: []string{"owner", "repo", "artifact_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			artifactID, err := RequiredBigInt(args, "artifact_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			entryPath, err := OptionalParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxBytes, err := OptionalIntParamWithDefault(args, "max_bytes", defaultArtifactEntryMaxBytes)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxBytes < 1 || maxBytes > 1024*1024 {
+				return utils.NewToolResultError("max_bytes must be between 1 and 1048576"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			artifact, resp, err := client.Actions.GetArtifact(ctx, owner, repo, artifactID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			zr, body, errResult := openArtifactZip(ctx, client, owner, repo, artifact, archive.Limits{
+				MaxTotalBytes: maxArtifactUncompressedBytes,
+				MaxEntries:    maxArtifactEntries,
+			})
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			defer func() { _ = body.Close() }()
+
+			result := ArtifactContents{
+				ArtifactID: artifactID,
+				Name:       artifact.GetName(),
+				Entries:    []ArtifactEntry{},
+			}
+			var content []byte
+			found := false
+			for {
+				entry, err := zr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return artifactReadError(artifact, err), nil, nil
+				}
+				if entry.IsDir {
+					continue
+				}
+
+				var read int64
+				if entry.Name == entryPath && !found {
+					found = true
+					// One byte past max_bytes tells whether the file is longer.
+					content, err = io.ReadAll(io.LimitReader(zr, int64(maxBytes)+1))
+					if err != nil {
+						return artifactReadError(artifact, err), nil, nil
+					}
+					read = int64(len(content))
+				}
+				if len(result.Entries) >= maxArtifactEntriesListed {
+					result.EntriesTruncated = true
+					continue
+				}
+				size, err := artifactEntrySize(zr, entry, read)
+				if err != nil {
+					return artifactReadError(artifact, err), nil, nil
+				}
+				result.Entries = append(result.Entries, ArtifactEntry{
+					Path: entry.Name,
+					Size: size,
+				})
+			}
+
+			if entryPath != "" {
+				if !found {
+					return utils.NewToolResultError(fmt.Sprintf("artifact %q has no file %q", artifact.GetName(), entryPath)), nil, nil
+				}
+				data, truncated := truncateArtifactContent(content, maxBytes)
+				if slices.Contains(data, 0) || !utf8.Valid(data) {
+					return utils.NewToolResultError(fmt.Sprintf("%q in artifact %q is a binary file; only text files can be returned", entryPath, artifact.GetName())), nil, nil
+				}
+				result.Entry = &ArtifactEntryContent{
+					Path:      entryPath,
+					Content:   string(data),
+					Truncated: truncated,
+				}
+			}
+
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, MarshalledTextResult(result), ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetArtifactContents(t *testing.T) {
+	serverTool := GetArtifactContents(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_artifact_contents", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "max_bytes")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "artifact_id"})
+
+	archive := buildZip(t, map[string]string{
+		"build/output.log": "step 1 ok\nstep 2 ok\n",
+		"build/héllo.txt":  "h" + strings.Repeat("é", 3),
+		"bin/tool":         "\x7fELF\x00\x01",
+	})
+	// The signed download URL must be fetched without the user's token.
+	blobServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"), "artifact download sent credentials")
+		_, _ = w.Write(archive)
+	}))
+	defer blobServer.Close()
+
+	handlers := map[string]http.HandlerFunc{
+		GetReposActionsArtifactsByOwnerByRepoByArtifactID: mockResponse(t, http.StatusOK, &github.Artifact{
+			ID:          github.Ptr(int64(7)),
+			Name:        github.Ptr("build-output"),
+			SizeInBytes: github.Ptr(int64(len(archive))),
+		}),
+		GetReposActionsArtifactsZipByOwnerByRepoByArtifactID: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Location", blobServer.URL+"/artifacts/7.zip")
+			w.WriteHeader(http.StatusFound)
+		},
+	}
+
+	tests := []struct {
+		name            string
+		args            map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedEntry   *ArtifactEntryContent
+	}{
+		{
+			name: "lists entries",
+			args: map[string]any{},
+		},
+		{
+			name:          "returns an entry's content",
+			args:          map[string]any{"path": "build/output.log"},
+			expectedEntry: &ArtifactEntryContent{Path: "build/output.log", Content: "step 1 ok\nstep 2 ok\n"},
+		},
+		{
+			name: "truncates at a character boundary",
+			args: map[string]any{"path": "build/héllo.txt", "max_bytes": float64(4)},
+			// "h" + "é" is 3 bytes; the next "é" would end past the budget.
+			expectedEntry: &ArtifactEntryContent{Path: "build/héllo.txt", Content: "hé", Truncated: true},
+		},
+		{
+			name:            "rejects binary entries",
+			args:            map[string]any{"path": "bin/tool"},
+			expectToolError: true,
+			expectedErrMsg:  `"bin/tool" in artifact "build-output" is a binary file`,
+		},
+		{
+			name:            "unknown entry",
+			args:            map[string]any{"path": "missing.txt"},
+			expectToolError: true,
+			expectedErrMsg:  `artifact "build-output" has no file "missing.txt"`,
+		},
+		{
+			name:            "max_bytes out of range",
+			args:            map[string]any{"max_bytes": float64(2 * 1024 * 1024)},
+			expectToolError: true,
+			expectedErrMsg:  "max_bytes must be between 1 and 1048576",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewAuthenticatedGHClient(t, MockHTTPClientWithHandlers(handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			args := map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(7),
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response ArtifactContents
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, int64(7), response.ArtifactID)
+			assert.Equal(t, "build-output", response.Name)
+			assert.ElementsMatch(t, []ArtifactEntry{
+				{Path: "build/output.log", Size: 20},
+				{Path: "build/héllo.txt", Size: 7},
+				{Path: "bin/tool", Size: 6},
+			}, response.Entries)
+			assert.Equal(t, tc.expectedEntry, response.Entry)
+		})
+	}
+}
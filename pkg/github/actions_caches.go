@@ -0,0 +1,261 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ActionsCacheEntry is a GitHub Actions cache entry.
+type ActionsCacheEntry struct {
+	ID             int64     `json:"id"`
+	Key            string    `json:"key"`
+	Ref            string    `json:"ref"`
+	SizeInBytes    int64     `json:"size_in_bytes"`
+	LastAccessedAt time.Time `json:"last_accessed_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ListActionsCaches creates a tool to list the GitHub Actions caches of a repository.
+func ListActionsCaches(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "list_actions_caches",
+			Description: t("TOOL_LIST_ACTIONS_CACHES_DESCRIPTION",
+				"List the GitHub Actions cache entries of a repository with their key, ref, size and last access time. "+
+					"Use this to find stale or oversized caches when the repository approaches its cache storage limit."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ACTIONS_CACHES_USER_TITLE", "List Actions caches"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Only list caches created for this Git reference, e.g. refs/heads/main or refs/pull/42/merge",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Only list caches whose key starts with this prefix",
+					},
+					"sort": {
+						Type:        "string",
+						Description: "Property to sort the caches by",
+						Enum:        []any{"created_at", "last_accessed_at", "size_in_bytes"},
+					},
+					"direction": {
+						Type:        "string",
+						Description: "Sort direction",
+						Enum:        []any{"asc", "desc"},
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			key, err := OptionalParam[string](args, "key")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sort, err := OptionalParam[string](args, "sort")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			direction, err := OptionalParam[string](args, "direction")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			opts := &github.ActionsCacheListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if ref != "" {
+				opts.Ref = &ref
+			}
+			if key != "" {
+				opts.Key = &key
+			}
+			if sort != "" {
+				opts.Sort = &sort
+			}
+			if direction != "" {
+				opts.Direction = &direction
+			}
+
+			caches, resp, err := client.Actions.ListCaches(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list Actions caches", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			entries := make([]ActionsCacheEntry, 0, len(caches.ActionsCaches))
+			for _, cache := range caches.ActionsCaches {
+				entries = append(entries, ActionsCacheEntry{
+					ID:             cache.GetID(),
+					Key:            cache.GetKey(),
+					Ref:            cache.GetRef(),
+					SizeInBytes:    cache.GetSizeInBytes(),
+					LastAccessedAt: cache.GetLastAccessedAt().Time,
+					CreatedAt:      cache.GetCreatedAt().Time,
+				})
+			}
+
+			result := MarshalledTextResult(map[string]any{
+				"total_count": caches.TotalCount,
+				"caches":      entries,
+			})
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
+
+// DeleteActionsCache creates a tool to delete GitHub Actions cache entries by ID or key.
+func DeleteActionsCache(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "delete_actions_cache",
+			Description: t("TOOL_DELETE_ACTIONS_CACHE_DESCRIPTION",
+				"Delete GitHub Actions cache entries of a repository. Provide cache_id to delete a single entry, "+
+					"or key to delete every entry with exactly that key, optionally limited to one ref. Deleted caches cannot be restored."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DELETE_ACTIONS_CACHE_USER_TITLE", "Delete Actions cache"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"cache_id": {
+						Type:        "number",
+						Description: "The ID of the cache entry to delete. Mutually exclusive with key.",
+					},
+					"key": {
+						Type:        "string",
+						Description: "Delete all cache entries with this exact key. Mutually exclusive with cache_id.",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Only delete entries for this Git reference. Only used with key.",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var cacheID int64
+			if _, ok := args["cache_id"]; ok {
+				cacheID, err = RequiredBigInt(args, "cache_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			key, err := OptionalParam[string](args, "key")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			if (cacheID == 0) == (key == "") {
+				return utils.NewToolResultError("exactly one of cache_id or key must be provided"), nil, nil
+			}
+			if ref != "" && key == "" {
+				return utils.NewToolResultError("ref can only be used with key"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			var resp *github.Response
+			var target map[string]any
+			if cacheID != 0 {
+				resp, err = client.Actions.DeleteCachesByID(ctx, owner, repo, cacheID)
+				target = map[string]any{"cache_id": cacheID}
+			} else {
+				var refPtr *string
+				if ref != "" {
+					refPtr = &ref
+				}
+				resp, err = client.Actions.DeleteCachesByKey(ctx, owner, repo, key, refPtr)
+				target = map[string]any{"key": key}
+				if ref != "" {
+					target["ref"] = ref
+				}
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete Actions cache", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			target["message"] = fmt.Sprintf("Deleted Actions cache in %s/%s", owner, repo)
+			return MarshalledTextResult(target), nil, nil
+		},
+	)
+}
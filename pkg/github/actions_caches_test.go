@@ -0,0 +1,232 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListActionsCaches(t *testing.T) {
+	serverTool := ListActionsCaches(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_actions_caches", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	accessed := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	created := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	cacheList := &github.ActionsCacheList{
+		TotalCount: 1,
+		ActionsCaches: []*github.ActionsCache{
+			{
+				ID:             github.Ptr(int64(505)),
+				Ref:            github.Ptr("refs/heads/main"),
+				Key:            github.Ptr("Linux-node-abc123"),
+				SizeInBytes:    github.Ptr(int64(1024)),
+				LastAccessedAt: &github.Timestamp{Time: accessed},
+				CreatedAt:      &github.Timestamp{Time: created},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]any
+		handler         http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+	}{
+		{
+			name: "lists caches with filters",
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"key":       "Linux-node-",
+				"ref":       "refs/heads/main",
+				"sort":      "size_in_bytes",
+				"direction": "desc",
+			},
+			handler: expectQueryParams(t, map[string]string{
+				"key":       "Linux-node-",
+				"ref":       "refs/heads/main",
+				"sort":      "size_in_bytes",
+				"direction": "desc",
+				"page":      "1",
+				"per_page":  "30",
+			}).andThen(mockResponse(t, http.StatusOK, cacheList)),
+		},
+		{
+			name: "API error",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			handler:         mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			expectToolError: true,
+			expectedErrMsg:  "failed to list Actions caches",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetReposActionsCachesByOwnerByRepo: tc.handler,
+				})),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response struct {
+				TotalCount int                 `json:"total_count"`
+				Caches     []ActionsCacheEntry `json:"caches"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, 1, response.TotalCount)
+			assert.Equal(t, []ActionsCacheEntry{{
+				ID:             505,
+				Key:            "Linux-node-abc123",
+				Ref:            "refs/heads/main",
+				SizeInBytes:    1024,
+				LastAccessedAt: accessed,
+				CreatedAt:      created,
+			}}, response.Caches)
+		})
+	}
+}
+
+func Test_DeleteActionsCache(t *testing.T) {
+	serverTool := DeleteActionsCache(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_actions_cache", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]any
+		handlers        map[string]http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+		expected        map[string]any
+	}{
+		{
+			name: "delete by ID",
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"cache_id": float64(505),
+			},
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposActionsCachesByOwnerByRepoByCacheID: noContent,
+			},
+			expected: map[string]any{"cache_id": float64(505), "message": "Deleted Actions cache in owner/repo"},
+		},
+		{
+			name: "delete by key and ref",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"key":   "Linux-node-abc123",
+				"ref":   "refs/heads/main",
+			},
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposActionsCachesByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"key": "Linux-node-abc123",
+					"ref": "refs/heads/main",
+				}).andThen(mockResponse(t, http.StatusOK, `{"total_count": 1, "actions_caches": []}`)),
+			},
+			expected: map[string]any{"key": "Linux-node-abc123", "ref": "refs/heads/main", "message": "Deleted Actions cache in owner/repo"},
+		},
+		{
+			name: "both cache_id and key",
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"cache_id": float64(505),
+				"key":      "Linux-node-abc123",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "exactly one of cache_id or key must be provided",
+		},
+		{
+			name: "ref without key",
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"cache_id": float64(505),
+				"ref":      "refs/heads/main",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "ref can only be used with key",
+		},
+		{
+			name: "cache not found",
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"cache_id": float64(506),
+			},
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposActionsCachesByOwnerByRepoByCacheID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to delete Actions cache",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var fullCommitSHAPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// CommitWorkflowRun is a workflow run triggered by a commit.
+type CommitWorkflowRun struct {
+	ID           int64     `json:"id"`
+	WorkflowName string    `json:"workflow_name"`
+	WorkflowID   int64     `json:"workflow_id"`
+	Event        string    `json:"event"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion,omitempty"`
+	RunAttempt   int       `json:"run_attempt,omitempty"`
+	HeadBranch   string    `json:"head_branch,omitempty"`
+	HTMLURL      string    `json:"html_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ListWorkflowRunsForCommit creates a tool to list the workflow runs of a commit.
+func ListWorkflowRunsForCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "list_workflow_runs_for_commit",
+			Description: t("TOOL_LIST_WORKFLOW_RUNS_FOR_COMMIT_DESCRIPTION",
+				"List the GitHub Actions workflow runs triggered for a commit, with each run's workflow name, event, status and conclusion. "+
+					"Accepts a full or abbreviated commit SHA, or a branch or tag name, which is resolved to the commit it points to."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_WORKFLOW_RUNS_FOR_COMMIT_USER_TITLE", "List workflow runs for commit"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"sha": {
+						Type:        "string",
+						Description: "Commit SHA, or a branch or tag name",
+					},
+				},
+				Required: []string{"owner", "repo", "sha"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			// The head_sha filter only matches full SHAs, so abbreviated SHAs
+			// and refs are resolved first.
+			if !fullCommitSHAPattern.MatchString(sha) {
+				resolved, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, sha, "")
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to resolve commit %q", sha), resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				sha = resolved
+			}
+
+			runs, resp, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, &github.ListWorkflowRunsOptions{
+				HeadSHA: sha,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow runs", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			commitRuns := make([]CommitWorkflowRun, 0, len(runs.WorkflowRuns))
+			for _, run := range runs.WorkflowRuns {
+				commitRuns = append(commitRuns, CommitWorkflowRun{
+					ID:           run.GetID(),
+					WorkflowName: run.GetName(),
+					WorkflowID:   run.GetWorkflowID(),
+					Event:        run.GetEvent(),
+					Status:       run.GetStatus(),
+					Conclusion:   run.GetConclusion(),
+					RunAttempt:   run.GetRunAttempt(),
+					HeadBranch:   run.GetHeadBranch(),
+					HTMLURL:      run.GetHTMLURL(),
+					CreatedAt:    run.GetCreatedAt().Time,
+				})
+			}
+
+			result := MarshalledTextResult(map[string]any{
+				"sha":         sha,
+				"total_count": runs.GetTotalCount(),
+				"runs":        commitRuns,
+			})
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListWorkflowRunsForCommit(t *testing.T) {
+	serverTool := ListWorkflowRunsForCommit(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_workflow_runs_for_commit", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "sha"})
+
+	const fullSHA = "0123456789abcdef0123456789abcdef01234567"
+	runsResponse := &github.WorkflowRuns{
+		TotalCount: github.Ptr(2),
+		WorkflowRuns: []*github.WorkflowRun{
+			{
+				ID:         github.Ptr(int64(11)),
+				Name:       github.Ptr("CI"),
+				WorkflowID: github.Ptr(int64(100)),
+				Event:      github.Ptr("push"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				RunAttempt: github.Ptr(2),
+				HeadBranch: github.Ptr("main"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/11"),
+			},
+			{
+				ID:         github.Ptr(int64(12)),
+				Name:       github.Ptr("CodeQL"),
+				WorkflowID: github.Ptr(int64(200)),
+				Event:      github.Ptr("push"),
+				Status:     github.Ptr("in_progress"),
+				HeadBranch: github.Ptr("main"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/12"),
+			},
+		},
+	}
+	expectedRuns := []CommitWorkflowRun{
+		{ID: 11, WorkflowName: "CI", WorkflowID: 100, Event: "push", Status: "completed", Conclusion: "failure", RunAttempt: 2, HeadBranch: "main", HTMLURL: "https://github.com/owner/repo/actions/runs/11"},
+		{ID: 12, WorkflowName: "CodeQL", WorkflowID: 200, Event: "push", Status: "in_progress", HeadBranch: "main", HTMLURL: "https://github.com/owner/repo/actions/runs/12"},
+	}
+	listRunsHandler := expectQueryParams(t, map[string]string{
+		"head_sha": fullSHA,
+		"page":     "1",
+		"per_page": "30",
+	}).andThen(mockResponse(t, http.StatusOK, runsResponse))
+
+	tests := []struct {
+		name            string
+		sha             string
+		handlers        map[string]http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+	}{
+		{
+			name: "full SHA is used directly",
+			sha:  fullSHA,
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsRunsByOwnerByRepo: listRunsHandler,
+			},
+		},
+		{
+			name: "branch name is resolved to its commit",
+			sha:  "main",
+			handlers: map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write([]byte(fullSHA))
+				},
+				GetReposActionsRunsByOwnerByRepo: listRunsHandler,
+			},
+		},
+		{
+			name: "unknown ref",
+			sha:  "no-such-branch",
+			handlers: map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "No commit found for SHA: no-such-branch"}`),
+			},
+			expectToolError: true,
+			expectedErrMsg:  `failed to resolve commit "no-such-branch"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   tc.sha,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response struct {
+				SHA        string              `json:"sha"`
+				TotalCount int                 `json:"total_count"`
+				Runs       []CommitWorkflowRun `json:"runs"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, fullSHA, response.SHA)
+			assert.Equal(t, 2, response.TotalCount)
+			assert.Equal(t, expectedRuns, response.Runs)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxCompareWorkflowJobPages bounds how many pages of 100 jobs are read per run.
+const maxCompareWorkflowJobPages = 10
+
+// WorkflowJobChange is a job whose conclusion differs between two runs.
+type WorkflowJobChange struct {
+	Name           string `json:"name"`
+	BaseConclusion string `json:"base_conclusion"`
+	HeadConclusion string `json:"head_conclusion"`
+	// Change is "newly_failed", "newly_passed" or "changed".
+	Change  string `json:"change"`
+	HeadURL string `json:"head_url,omitempty"`
+}
+
+// CompareWorkflowRunsResponse is the output of compare_workflow_runs.
+type CompareWorkflowRunsResponse struct {
+	BaseRunID  int64               `json:"base_run_id"`
+	HeadRunID  int64               `json:"head_run_id"`
+	Changed    []WorkflowJobChange `json:"changed"`
+	OnlyInBase []string            `json:"only_in_base,omitempty"`
+	OnlyInHead []string            `json:"only_in_head,omitempty"`
+	Unchanged  int                 `json:"unchanged"`
+	// Truncated reports that a run has more jobs than were compared.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// isFailedConclusion reports whether a job conclusion counts as a failure.
+func isFailedConclusion(conclusion string) bool {
+	switch conclusion {
+	case "failure", "timed_out", "startup_failure":
+		return true
+	default:
+		return false
+	}
+}
+
+// listAllWorkflowJobs returns the latest attempt of each job of a run, keyed
+// by job name. Matrix jobs have distinct names, so names identify jobs across
+// runs of the same workflow.
+func listAllWorkflowJobs(ctx context.Context, client *github.Client, owner, repo string, runID int64) (map[string]*github.WorkflowJob, bool, *github.Response, error) {
+	jobs := make(map[string]*github.WorkflowJob)
+	opts := &github.ListWorkflowJobsOptions{
+		Filter:      "latest",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for page := 0; page < maxCompareWorkflowJobPages; page++ {
+		result, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, job := range result.Jobs {
+			if _, ok := jobs[job.GetName()]; !ok {
+				jobs[job.GetName()] = job
+			}
+		}
+		if resp.NextPage == 0 {
+			return jobs, false, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+	return jobs, true, nil, nil
+}
+
+// CompareWorkflowRuns creates a tool to diff the job conclusions of two workflow runs.
+func CompareWorkflowRuns(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "compare_workflow_runs",
+			Description: t("TOOL_COMPARE_WORKFLOW_RUNS_DESCRIPTION",
+				"Compare the jobs of two GitHub Actions workflow runs by name and list the jobs whose conclusion changed, "+
+					"marking each as newly failed, newly passed or otherwise changed. Jobs present in only one run are listed separately. "+
+					"Use this to find newly broken or newly fixed jobs between a run and a rerun or a later run of the same workflow."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_COMPARE_WORKFLOW_RUNS_USER_TITLE", "Compare workflow runs"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"base_run_id": {
+						Type:        "number",
+						Description: "The ID of the earlier workflow run to compare from",
+					},
+					"head_run_id": {
+						Type:        "number",
+						Description: "The ID of the later workflow run to compare to",
+					},
+				},
+				Required: []string{"owner", "repo", "base_run_id", "head_run_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			baseRunID, err := RequiredBigInt(args, "base_run_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			headRunID, err := RequiredBigInt(args, "head_run_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			baseJobs, baseTruncated, resp, err := listAllWorkflowJobs(ctx, client, owner, repo, baseRunID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list jobs of run %d", baseRunID), resp, err), nil, nil
+			}
+			headJobs, headTruncated, resp, err := listAllWorkflowJobs(ctx, client, owner, repo, headRunID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list jobs of run %d", headRunID), resp, err), nil, nil
+			}
+
+			response := CompareWorkflowRunsResponse{
+				BaseRunID: baseRunID,
+				HeadRunID: headRunID,
+				Changed:   []WorkflowJobChange{},
+				Truncated: baseTruncated || headTruncated,
+			}
+			for name, baseJob := range baseJobs {
+				headJob, ok := headJobs[name]
+				if !ok {
+					response.OnlyInBase = append(response.OnlyInBase, name)
+					continue
+				}
+				baseConclusion, headConclusion := baseJob.GetConclusion(), headJob.GetConclusion()
+				if baseConclusion == headConclusion {
+					response.Unchanged++
+					continue
+				}
+				change := "changed"
+				switch {
+				case !isFailedConclusion(baseConclusion) && isFailedConclusion(headConclusion):
+					change = "newly_failed"
+				case isFailedConclusion(baseConclusion) && headConclusion == "success":
+					change = "newly_passed"
+				}
+				response.Changed = append(response.Changed, WorkflowJobChange{
+					Name:           name,
+					BaseConclusion: baseConclusion,
+					HeadConclusion: headConclusion,
+					Change:         change,
+					HeadURL:        headJob.GetHTMLURL(),
+				})
+			}
+			for name := range headJobs {
+				if _, ok := baseJobs[name]; !ok {
+					response.OnlyInHead = append(response.OnlyInHead, name)
+				}
+			}
+
+			sort.Slice(response.Changed, func(i, j int) bool { return response.Changed[i].Name < response.Changed[j].Name })
+			sort.Strings(response.OnlyInBase)
+			sort.Strings(response.OnlyInHead)
+
+			result := MarshalledTextResult(response)
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
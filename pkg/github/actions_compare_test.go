@@ -0,0 +1,134 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompareWorkflowRuns(t *testing.T) {
+	serverTool := CompareWorkflowRuns(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "compare_workflow_runs", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "base_run_id", "head_run_id"})
+
+	job := func(name, conclusion string) *github.WorkflowJob {
+		return &github.WorkflowJob{
+			Name:       github.Ptr(name),
+			Conclusion: github.Ptr(conclusion),
+			HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/2/job/" + name),
+		}
+	}
+	runJobs := map[string][][]*github.WorkflowJob{
+		// Run 1 returns its jobs across two pages.
+		"1": {
+			{job("build", "success"), job("test (ubuntu)", "success"), job("test (windows)", "failure")},
+			{job("lint", "success"), job("docs", "success")},
+		},
+		"2": {
+			{job("build", "success"), job("test (ubuntu)", "failure"), job("test (windows)", "success"), job("lint", "cancelled"), job("release", "skipped")},
+		},
+	}
+	jobsHandler := func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		runID := parts[len(parts)-2]
+		assert.Equal(t, "latest", r.URL.Query().Get("filter"))
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		pages, ok := runJobs[runID]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		page := 0
+		if r.URL.Query().Get("page") == "2" {
+			page = 1
+		} else if len(pages) > 1 {
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/actions/runs/`+runID+`/jobs?page=2>; rel="next"`)
+		}
+		data, _ := json.Marshal(&github.Jobs{TotalCount: github.Ptr(len(pages[page])), Jobs: pages[page]})
+		_, _ = w.Write(data)
+	}
+
+	tests := []struct {
+		name             string
+		requestArgs      map[string]any
+		expectToolError  bool
+		expectedErrMsg   string
+		expectedResponse CompareWorkflowRunsResponse
+	}{
+		{
+			name: "classifies changed jobs",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"base_run_id": float64(1),
+				"head_run_id": float64(2),
+			},
+			expectedResponse: CompareWorkflowRunsResponse{
+				BaseRunID: 1,
+				HeadRunID: 2,
+				Changed: []WorkflowJobChange{
+					{Name: "lint", BaseConclusion: "success", HeadConclusion: "cancelled", Change: "changed", HeadURL: "https://github.com/owner/repo/actions/runs/2/job/lint"},
+					{Name: "test (ubuntu)", BaseConclusion: "success", HeadConclusion: "failure", Change: "newly_failed", HeadURL: "https://github.com/owner/repo/actions/runs/2/job/test (ubuntu)"},
+					{Name: "test (windows)", BaseConclusion: "failure", HeadConclusion: "success", Change: "newly_passed", HeadURL: "https://github.com/owner/repo/actions/runs/2/job/test (windows)"},
+				},
+				OnlyInBase: []string{"docs"},
+				OnlyInHead: []string{"release"},
+				Unchanged:  1,
+			},
+		},
+		{
+			name: "run not found",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"base_run_id": float64(1),
+				"head_run_id": float64(3),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to list jobs of run 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetReposActionsRunsJobsByOwnerByRepoByRunID: jobsHandler,
+				})),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response CompareWorkflowRunsResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedResponse, response)
+		})
+	}
+}
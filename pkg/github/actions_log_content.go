@@ -0,0 +1,74 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ansiEscapePattern matches ANSI escape sequences: CSI sequences such as
+// color codes and cursor movement, OSC sequences such as hyperlinks and
+// window titles, and two-character escapes.
+var ansiEscapePattern = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)|[0-Z\\^-~])`)
+
+// logTimestampPattern matches the ISO 8601 timestamp GitHub Actions prefixes
+// to every log line.
+var logTimestampPattern = regexp.MustCompile(`(?m)^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?Z `)
+
+// logContentOptions controls how downloaded job log content is cleaned up
+// before it is returned.
+type logContentOptions struct {
+	// StripANSI removes ANSI escape sequences.
+	StripANSI bool
+	// StripTimestamps removes the timestamp prefix of each line.
+	StripTimestamps bool
+	// CollapseRepeated folds runs of identical consecutive lines into one
+	// line suffixed with "(xN)".
+	CollapseRepeated bool
+}
+
+// apply post-processes log content according to the options. Timestamps are
+// removed before repeated lines are collapsed, since they would otherwise
+// make every line unique.
+func (o logContentOptions) apply(content string) string {
+	if o.StripANSI {
+		content = stripANSI(content)
+	}
+	if o.StripTimestamps {
+		content = stripLogTimestamps(content)
+	}
+	if o.CollapseRepeated {
+		content = collapseRepeatedLines(content)
+	}
+	return content
+}
+
+// stripANSI removes ANSI escape sequences from s.
+func stripANSI(s string) string {
+	return ansiEscapePattern.ReplaceAllString(s, "")
+}
+
+// stripLogTimestamps removes the leading timestamp from each line of s.
+func stripLogTimestamps(s string) string {
+	return logTimestampPattern.ReplaceAllString(s, "")
+}
+
+// collapseRepeatedLines replaces each run of identical consecutive lines in
+// s with a single line followed by the repeat count.
+func collapseRepeatedLines(s string) string {
+	lines := strings.Split(s, "\n")
+	collapsed := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); {
+		j := i + 1
+		for j < len(lines) && lines[j] == lines[i] {
+			j++
+		}
+		if n := j - i; n > 1 {
+			collapsed = append(collapsed, fmt.Sprintf("%s (x%d)", lines[i], n))
+		} else {
+			collapsed = append(collapsed, lines[i])
+		}
+		i = j
+	}
+	return strings.Join(collapsed, "\n")
+}
@@ -0,0 +1,137 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StripANSI(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain text is unchanged",
+			input:    "Run go test ./...\nok  \tpkg\t0.01s",
+			expected: "Run go test ./...\nok  \tpkg\t0.01s",
+		},
+		{
+			name:     "color codes",
+			input:    "\x1b[36;1mgo test ./...\x1b[0m\n\x1b[31mFAIL\x1b[0m pkg/github",
+			expected: "go test ./...\nFAIL pkg/github",
+		},
+		{
+			name:     "256 and true colors",
+			input:    "\x1b[38;5;196merror\x1b[39m \x1b[48;2;0;0;0mbg\x1b[m",
+			expected: "error bg",
+		},
+		{
+			name:     "cursor movement and line erase",
+			input:    "\x1b[2K\x1b[1Gprogress 100%\x1b[?25h",
+			expected: "progress 100%",
+		},
+		{
+			name:     "hyperlinks",
+			input:    "see \x1b]8;;https://example.com\x07docs\x1b]8;;\x1b\\ for details",
+			expected: "see docs for details",
+		},
+		{
+			name:     "two-character escapes",
+			input:    "\x1bMline\x1b=",
+			expected: "line",
+		},
+		{
+			name:     "multi-byte text around escapes",
+			input:    "\x1b[32m✓\x1b[0m passed — 日本語",
+			expected: "✓ passed — 日本語",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, stripANSI(tc.input))
+		})
+	}
+}
+
+func Test_StripLogTimestamps(t *testing.T) {
+	input := "2023-01-01T10:00:00.000Z Run tests\n" +
+		"2023-01-01T10:00:01.1234567Z ok\n" +
+		"2023-01-01T10:00:02Z done\n" +
+		"no timestamp 2023-01-01T10:00:03.000Z here"
+	expected := "Run tests\nok\ndone\nno timestamp 2023-01-01T10:00:03.000Z here"
+	assert.Equal(t, expected, stripLogTimestamps(input))
+}
+
+func Test_CollapseRepeatedLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "no repeats",
+			input:    "a\nb\na",
+			expected: "a\nb\na",
+		},
+		{
+			name:     "consecutive repeats",
+			input:    "start\nwaiting\nwaiting\nwaiting\ndone\ndone",
+			expected: "start\nwaiting (x3)\ndone (x2)",
+		},
+		{
+			name:     "blank lines",
+			input:    "a\n\n\nb",
+			expected: "a\n (x2)\nb",
+		},
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, collapseRepeatedLines(tc.input))
+		})
+	}
+}
+
+func Test_LogContentOptions(t *testing.T) {
+	input := "2023-01-01T10:00:00.000Z \x1b[33mretry\x1b[0m\n2023-01-01T10:00:05.000Z \x1b[33mretry\x1b[0m"
+
+	tests := []struct {
+		name     string
+		opts     logContentOptions
+		expected string
+	}{
+		{
+			name:     "no options",
+			expected: input,
+		},
+		{
+			name:     "strip ANSI only",
+			opts:     logContentOptions{StripANSI: true},
+			expected: "2023-01-01T10:00:00.000Z retry\n2023-01-01T10:00:05.000Z retry",
+		},
+		{
+			name:     "strip timestamps only",
+			opts:     logContentOptions{StripTimestamps: true},
+			expected: "\x1b[33mretry\x1b[0m\n\x1b[33mretry\x1b[0m",
+		},
+		{
+			name:     "all options",
+			opts:     logContentOptions{StripANSI: true, StripTimestamps: true, CollapseRepeated: true},
+			expected: "retry (x2)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.opts.apply(input))
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package github
+
+import (
+	"context"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// SelectedActions lists the actions allowed when a repository only allows
+// selected actions.
+type SelectedActions struct {
+	GitHubOwnedAllowed bool     `json:"github_owned_allowed"`
+	VerifiedAllowed    bool     `json:"verified_allowed"`
+	PatternsAllowed    []string `json:"patterns_allowed"`
+}
+
+// ActionsPermissions is the GitHub Actions policy of a repository.
+type ActionsPermissions struct {
+	Enabled bool `json:"enabled"`
+	// AllowedActions is "all", "local_only" or "selected".
+	AllowedActions     string           `json:"allowed_actions,omitempty"`
+	SelectedActions    *SelectedActions `json:"selected_actions,omitempty"`
+	SHAPinningRequired bool             `json:"sha_pinning_required"`
+	// DefaultWorkflowPermissions is "read" or "write".
+	DefaultWorkflowPermissions   string `json:"default_workflow_permissions,omitempty"`
+	CanApprovePullRequestReviews bool   `json:"can_approve_pull_request_reviews"`
+}
+
+// OIDCSubjectClaim is the OIDC subject claim template of a repository.
+type OIDCSubjectClaim struct {
+	UseDefault       bool     `json:"use_default"`
+	IncludeClaimKeys []string `json:"include_claim_keys,omitempty"`
+}
+
+// GetActionsPermissions creates a tool to read a repository's GitHub Actions policy.
+func GetActionsPermissions(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "get_actions_permissions",
+			Description: t("TOOL_GET_ACTIONS_PERMISSIONS_DESCRIPTION",
+				"Get a repository's GitHub Actions policy: whether Actions are enabled, which actions are allowed to run, "+
+					"and the default permissions of the workflow GITHUB_TOKEN. Use this to audit a repository's CI security posture. "+
+					"Requires admin access to the repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ACTIONS_PERMISSIONS_USER_TITLE", "Get Actions permissions"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			permissions, resp, err := client.Repositories.GetActionsPermissions(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get Actions permissions", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := ActionsPermissions{
+				Enabled:            permissions.GetEnabled(),
+				AllowedActions:     permissions.GetAllowedActions(),
+				SHAPinningRequired: permissions.GetSHAPinningRequired(),
+			}
+
+			// The allowed-actions list only exists when the policy is "selected".
+			if result.AllowedActions == "selected" {
+				allowed, resp, err := client.Repositories.GetActionsAllowed(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get allowed actions", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				result.SelectedActions = &SelectedActions{
+					GitHubOwnedAllowed: allowed.GetGithubOwnedAllowed(),
+					VerifiedAllowed:    allowed.GetVerifiedAllowed(),
+					PatternsAllowed:    allowed.PatternsAllowed,
+				}
+			}
+
+			defaults, resp, err := client.Repositories.GetDefaultWorkflowPermissions(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get default workflow permissions", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+			result.DefaultWorkflowPermissions = defaults.GetDefaultWorkflowPermissions()
+			result.CanApprovePullRequestReviews = defaults.GetCanApprovePullRequestReviews()
+
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, MarshalledTextResult(result), ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
+
+// GetOIDCSubjectClaim creates a tool to read a repository's OIDC subject claim template.
+func GetOIDCSubjectClaim(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "get_oidc_subject_claim",
+			Description: t("TOOL_GET_OIDC_SUBJECT_CLAIM_DESCRIPTION",
+				"Get the template a repository uses for the subject claim of the OIDC tokens its workflows request. "+
+					"When use_default is true the default subject format applies; otherwise the subject is built from include_claim_keys. "+
+					"Use this to audit which workflows a cloud provider's OIDC trust policy can match."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_OIDC_SUBJECT_CLAIM_USER_TITLE", "Get OIDC subject claim template"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			template, resp, err := client.Actions.GetRepoOIDCSubjectClaimCustomTemplate(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get OIDC subject claim template", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := MarshalledTextResult(OIDCSubjectClaim{
+				UseDefault:       template.GetUseDefault(),
+				IncludeClaimKeys: template.IncludeClaimKeys,
+			})
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
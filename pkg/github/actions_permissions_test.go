@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetActionsPermissions(t *testing.T) {
+	serverTool := GetActionsPermissions(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_actions_permissions", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	workflowPermissions := mockResponse(t, http.StatusOK, &github.DefaultWorkflowPermissionRepository{
+		DefaultWorkflowPermissions:   github.Ptr("read"),
+		CanApprovePullRequestReviews: github.Ptr(false),
+	})
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+		expected        ActionsPermissions
+	}{
+		{
+			name: "all actions allowed",
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsPermissionsByOwnerByRepo: mockResponse(t, http.StatusOK, &github.ActionsPermissionsRepository{
+					Enabled:        github.Ptr(true),
+					AllowedActions: github.Ptr("all"),
+				}),
+				GetReposActionsPermissionsWorkflowByOwnerByRepo: workflowPermissions,
+			},
+			expected: ActionsPermissions{
+				Enabled:                    true,
+				AllowedActions:             "all",
+				DefaultWorkflowPermissions: "read",
+			},
+		},
+		{
+			name: "selected actions allowed",
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsPermissionsByOwnerByRepo: mockResponse(t, http.StatusOK, &github.ActionsPermissionsRepository{
+					Enabled:            github.Ptr(true),
+					AllowedActions:     github.Ptr("selected"),
+					SHAPinningRequired: github.Ptr(true),
+				}),
+				GetReposActionsPermissionsSelectedActionsByOwnerByRepo: mockResponse(t, http.StatusOK, &github.ActionsAllowed{
+					GithubOwnedAllowed: github.Ptr(true),
+					VerifiedAllowed:    github.Ptr(false),
+					PatternsAllowed:    []string{"octo-org/*"},
+				}),
+				GetReposActionsPermissionsWorkflowByOwnerByRepo: workflowPermissions,
+			},
+			expected: ActionsPermissions{
+				Enabled:        true,
+				AllowedActions: "selected",
+				SelectedActions: &SelectedActions{
+					GitHubOwnedAllowed: true,
+					PatternsAllowed:    []string{"octo-org/*"},
+				},
+				SHAPinningRequired:         true,
+				DefaultWorkflowPermissions: "read",
+			},
+		},
+		{
+			name: "no admin access",
+			handlers: map[string]http.HandlerFunc{
+				GetReposActionsPermissionsByOwnerByRepo: mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get Actions permissions",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response ActionsPermissions
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_GetOIDCSubjectClaim(t *testing.T) {
+	serverTool := GetOIDCSubjectClaim(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_oidc_subject_claim", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsOIDCCustomizationSubByOwnerByRepo: mockResponse(t, http.StatusOK, &github.OIDCSubjectClaimCustomTemplate{
+				UseDefault:       github.Ptr(false),
+				IncludeClaimKeys: []string{"repo", "context"},
+			}),
+		})),
+	}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response OIDCSubjectClaim
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, OIDCSubjectClaim{IncludeClaimKeys: []string{"repo", "context"}}, response)
+}
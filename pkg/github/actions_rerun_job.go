@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// rerunJobRequest is the body of a job re-run request.
+type rerunJobRequest struct {
+	EnableDebugLogging bool `json:"enable_debug_logging"`
+}
+
+// RerunJob creates a tool to re-run a single workflow job.
+func RerunJob(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "rerun_job",
+			Description: t("TOOL_RERUN_JOB_DESCRIPTION",
+				"Re-run a single job of a GitHub Actions workflow run, along with any jobs that depend on it. "+
+					"Prefer this over re-running all failed jobs when only one job needs another attempt."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_RERUN_JOB_USER_TITLE", "Re-run workflow job"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"job_id": {
+						Type:        "number",
+						Description: "The unique identifier of the workflow job",
+					},
+					"enable_debug_logging": {
+						Type:        "boolean",
+						Description: "Enable debug logging for the re-run",
+					},
+				},
+				Required: []string{"owner", "repo", "job_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Workflow},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			jobID, err := RequiredBigInt(args, "job_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			enableDebugLogging, err := OptionalParam[bool](args, "enable_debug_logging")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			var resp *github.Response
+			if enableDebugLogging {
+				// RerunJobByID does not send a body, so the debug flag needs a
+				// hand-built request.
+				apiURL := fmt.Sprintf("repos/%s/%s/actions/jobs/%d/rerun", owner, repo, jobID)
+				req, reqErr := client.NewRequest(ctx, http.MethodPost, apiURL, &rerunJobRequest{EnableDebugLogging: true})
+				if reqErr != nil {
+					return utils.NewToolResultErrorFromErr("failed to create request", reqErr), nil, nil
+				}
+				resp, err = client.Do(req, nil)
+			} else {
+				resp, err = client.Actions.RerunJobByID(ctx, owner, repo, jobID)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to rerun job", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(map[string]any{
+				"message":              "Job has been queued for re-run",
+				"job_id":               jobID,
+				"enable_debug_logging": enableDebugLogging,
+				"status":               resp.Status,
+				"status_code":          resp.StatusCode,
+			}), nil, nil
+		},
+	)
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RerunJob(t *testing.T) {
+	serverTool := RerunJob(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "rerun_job", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, []string{string(scopes.Workflow)}, serverTool.RequiredScopes)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "enable_debug_logging")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "job_id"})
+
+	// rerunHandler records the request body so the debug flag can be checked.
+	rerunHandler := func(body *string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Body != nil {
+				data, _ := io.ReadAll(r.Body)
+				*body = string(data)
+			}
+			w.WriteHeader(http.StatusCreated)
+		}
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]any
+		handler         func(body *string) http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+		expectedBody    string
+		expectedDebug   bool
+	}{
+		{
+			name: "re-runs the job",
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"job_id": float64(123),
+			},
+			handler:      rerunHandler,
+			expectedBody: "",
+		},
+		{
+			name: "re-runs the job with debug logging",
+			requestArgs: map[string]any{
+				"owner":                "owner",
+				"repo":                 "repo",
+				"job_id":               float64(123),
+				"enable_debug_logging": true,
+			},
+			handler:       rerunHandler,
+			expectedBody:  `{"enable_debug_logging":true}` + "\n",
+			expectedDebug: true,
+		},
+		{
+			name: "job cannot be re-run",
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"job_id": float64(123),
+			},
+			handler: func(_ *string) http.HandlerFunc {
+				return mockResponse(t, http.StatusForbidden, `{"message": "This job cannot be re-run"}`)
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to rerun job",
+		},
+		{
+			name: "missing job_id",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			handler:         rerunHandler,
+			expectToolError: true,
+			expectedErrMsg:  "missing required parameter: job_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var body string
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					PostReposActionsJobsRerunByOwnerByRepoByJobID: tc.handler(&body),
+				})),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedBody, body)
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "Job has been queued for re-run", response["message"])
+			assert.Equal(t, float64(123), response["job_id"])
+			assert.Equal(t, tc.expectedDebug, response["enable_debug_logging"])
+			assert.Equal(t, float64(http.StatusCreated), response["status_code"])
+		})
+	}
+}
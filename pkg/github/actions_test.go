@@ -3,7 +3,10 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
@@ -207,6 +210,151 @@ func Test_ActionsList_ListWorkflowRuns(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 2, *response.TotalCount)
 	})
+
+	t.Run("markdown output", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepo: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				runs := &github.WorkflowRuns{
+					TotalCount: github.Ptr(1),
+					WorkflowRuns: []*github.WorkflowRun{
+						{
+							ID:         github.Ptr(int64(123)),
+							Name:       github.Ptr("CI"),
+							HeadBranch: github.Ptr("main"),
+							Event:      github.Ptr("push"),
+							Status:     github.Ptr("completed"),
+							Conclusion: github.Ptr("success"),
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(runs)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":        "list_workflow_runs",
+			"owner":         "owner",
+			"repo":          "repo",
+			"output_format": "markdown",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		assert.Equal(t,
+			"| ID | Workflow | Branch | Event | Status | Conclusion | Created |\n"+
+				"| --- | --- | --- | --- | --- | --- | --- |\n"+
+				"| 123 | CI | main | push | completed | success |  |\n"+
+				"\nTotal: 1\n",
+			textContent.Text)
+	})
+}
+
+func Test_ActionsList_ListWorkflowJobs(t *testing.T) {
+	toolDef := ActionsList(translations.NullTranslationHelper)
+
+	// Job 2 has a short log; job 3 has a log larger than the inline budget.
+	longLine := strings.Repeat("x", 1023) + "\n"
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/logs/2":
+			_, _ = w.Write([]byte("step 1\nstep 2\nError: test failed\n"))
+		case "/logs/3":
+			_, _ = w.Write([]byte(strings.Repeat(longLine, 64)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(logServer.Close)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposActionsRunsJobsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			jobs := &github.Jobs{
+				TotalCount: github.Ptr(4),
+				Jobs: []*github.WorkflowJob{
+					{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Conclusion: github.Ptr("success")},
+					{ID: github.Ptr(int64(2)), Name: github.Ptr("test"), Conclusion: github.Ptr("failure")},
+					{ID: github.Ptr(int64(3)), Name: github.Ptr("e2e"), Conclusion: github.Ptr("failure")},
+					{ID: github.Ptr(int64(4)), Name: github.Ptr("lint"), Conclusion: github.Ptr("failure")},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(jobs)
+		}),
+		GetReposActionsJobsLogsByOwnerByRepoByJobID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			parts := strings.Split(r.URL.Path, "/")
+			w.Header().Set("Location", logServer.URL+"/logs/"+parts[len(parts)-2])
+			w.WriteHeader(http.StatusFound)
+		}),
+	})
+	deps := BaseDeps{
+		Client:            mustNewGHClient(t, mockedClient),
+		ContentWindowSize: 5000,
+	}
+	handler := toolDef.Handler(deps)
+
+	t.Run("without failed logs", func(t *testing.T) {
+		request := createMCPRequest(map[string]any{
+			"method":      "list_workflow_jobs",
+			"owner":       "owner",
+			"repo":        "repo",
+			"resource_id": "456",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Contains(t, response, "jobs")
+		assert.NotContains(t, response, "failed_job_logs")
+	})
+
+	t.Run("inlines failed job logs within the budget", func(t *testing.T) {
+		request := createMCPRequest(map[string]any{
+			"method":              "list_workflow_jobs",
+			"owner":               "owner",
+			"repo":                "repo",
+			"resource_id":         "456",
+			"include_failed_logs": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			FailedJobLogs []struct {
+				JobID       int64  `json:"job_id"`
+				JobName     string `json:"job_name"`
+				LogsContent string `json:"logs_content"`
+				Truncated   bool   `json:"truncated"`
+				LogsOmitted bool   `json:"logs_omitted"`
+			} `json:"failed_job_logs"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.FailedJobLogs, 3)
+
+		assert.Equal(t, "test", response.FailedJobLogs[0].JobName)
+		assert.Contains(t, response.FailedJobLogs[0].LogsContent, "Error: test failed")
+		assert.False(t, response.FailedJobLogs[0].Truncated)
+
+		assert.Equal(t, "e2e", response.FailedJobLogs[1].JobName)
+		assert.True(t, response.FailedJobLogs[1].Truncated)
+		total := len(response.FailedJobLogs[0].LogsContent) + len(response.FailedJobLogs[1].LogsContent)
+		assert.Equal(t, maxInlineJobLogsBytes, total)
+
+		assert.Equal(t, "lint", response.FailedJobLogs[2].JobName)
+		assert.True(t, response.FailedJobLogs[2].LogsOmitted)
+		assert.Empty(t, response.FailedJobLogs[2].LogsContent)
+	})
 }
 
 func Test_ActionsGet(t *testing.T) {
@@ -308,6 +456,39 @@ func Test_ActionsGet_GetWorkflowRun(t *testing.T) {
 		assert.NotNil(t, response.ID)
 		assert.Equal(t, int64(12345), *response.ID)
 	})
+
+	t.Run("fields trims the workflow run", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, &github.WorkflowRun{
+				ID:         github.Ptr(int64(12345)),
+				Name:       github.Ptr("CI"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				Actor:      &github.User{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1))},
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":      "get_workflow_run",
+			"owner":       "owner",
+			"repo":        "repo",
+			"resource_id": "12345",
+			"fields":      []any{"conclusion", "actor.login"},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		assert.JSONEq(t, `{"conclusion": "failure", "actor": {"login": "octocat"}}`, textContent.Text)
+	})
 }
 
 func Test_ActionsRunTrigger(t *testing.T) {
@@ -581,6 +762,140 @@ func Test_ActionsGetJobLogs_SingleJob(t *testing.T) {
 		assert.Contains(t, response, "logs_url")
 		assert.Equal(t, "Job logs are available for download", response["message"])
 	})
+
+	t.Run("server default returns content unless overridden", func(t *testing.T) {
+		logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("build succeeded"))
+		}))
+		defer logServer.Close()
+
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsJobsLogsByOwnerByRepoByJobID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Location", logServer.URL)
+				w.WriteHeader(http.StatusFound)
+			}),
+		})
+		deps := BaseDeps{
+			Client:               mustNewGHClient(t, mockedClient),
+			ContentWindowSize:    5000,
+			DefaultReturnContent: true,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"job_id": float64(123),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "build succeeded", response["logs_content"])
+
+		request = createMCPRequest(map[string]any{
+			"owner":          "owner",
+			"repo":           "repo",
+			"job_id":         float64(123),
+			"return_content": false,
+		})
+		result, err = handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		response = nil
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, logServer.URL, response["logs_url"])
+		assert.NotContains(t, response, "logs_content")
+	})
+}
+
+func Test_ActionsGetJobLogs_LogContentOptions(t *testing.T) {
+	toolDef := ActionsGetJobLogs(translations.NullTranslationHelper)
+
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("2023-01-01T10:00:00.000Z \x1b[36;1mgo test ./...\x1b[0m\n" +
+			"2023-01-01T10:00:01.000Z retrying\n" +
+			"2023-01-01T10:00:02.000Z retrying\n" +
+			"2023-01-01T10:00:03.000Z \x1b[31mFAIL\x1b[0m pkg/github"))
+	}))
+	defer logServer.Close()
+
+	tests := []struct {
+		name        string
+		options     map[string]any
+		expectedLog string
+	}{
+		{
+			name: "strips ANSI by default",
+			expectedLog: "2023-01-01T10:00:00.000Z go test ./...\n" +
+				"2023-01-01T10:00:01.000Z retrying\n" +
+				"2023-01-01T10:00:02.000Z retrying\n" +
+				"2023-01-01T10:00:03.000Z FAIL pkg/github",
+		},
+		{
+			name:    "keeps escapes when disabled",
+			options: map[string]any{"strip_ansi": false},
+			expectedLog: "2023-01-01T10:00:00.000Z \x1b[36;1mgo test ./...\x1b[0m\n" +
+				"2023-01-01T10:00:01.000Z retrying\n" +
+				"2023-01-01T10:00:02.000Z retrying\n" +
+				"2023-01-01T10:00:03.000Z \x1b[31mFAIL\x1b[0m pkg/github",
+		},
+		{
+			name:        "strips timestamps",
+			options:     map[string]any{"strip_timestamps": true},
+			expectedLog: "go test ./...\nretrying\nretrying\nFAIL pkg/github",
+		},
+		{
+			name:        "collapses repeated lines once timestamps are stripped",
+			options:     map[string]any{"strip_timestamps": true, "collapse_repeated": true},
+			expectedLog: "go test ./...\nretrying (x2)\nFAIL pkg/github",
+		},
+		{
+			name:    "timestamps keep lines distinct when only collapsing",
+			options: map[string]any{"collapse_repeated": true},
+			expectedLog: "2023-01-01T10:00:00.000Z go test ./...\n" +
+				"2023-01-01T10:00:01.000Z retrying\n" +
+				"2023-01-01T10:00:02.000Z retrying\n" +
+				"2023-01-01T10:00:03.000Z FAIL pkg/github",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsJobsLogsByOwnerByRepoByJobID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Location", logServer.URL)
+					w.WriteHeader(http.StatusFound)
+				}),
+			})
+			deps := BaseDeps{
+				Client:            mustNewGHClient(t, mockedClient),
+				ContentWindowSize: 5000,
+			}
+			handler := toolDef.Handler(deps)
+
+			args := map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"job_id":         float64(123),
+				"return_content": true,
+			}
+			for k, v := range tc.options {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedLog, response["logs_content"])
+		})
+	}
 }
 
 func Test_ActionsGetJobLogs_FailedJobs(t *testing.T) {
@@ -645,6 +960,64 @@ func Test_ActionsGetJobLogs_FailedJobs(t *testing.T) {
 		assert.Contains(t, response["message"], "Retrieved logs for")
 	})
 
+	t.Run("logs keep job order and report per-job errors", func(t *testing.T) {
+		var jobs []*github.WorkflowJob
+		for id := int64(1); id <= 8; id++ {
+			jobs = append(jobs, &github.WorkflowJob{
+				ID:         github.Ptr(id),
+				Name:       github.Ptr(fmt.Sprintf("job-%d", id)),
+				Conclusion: github.Ptr("failure"),
+			})
+		}
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsJobsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, &github.Jobs{TotalCount: github.Ptr(len(jobs)), Jobs: jobs}),
+			GetReposActionsJobsLogsByOwnerByRepoByJobID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				segments := strings.Split(r.URL.Path, "/")
+				jobID := segments[len(segments)-2]
+				if jobID == "3" {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					return
+				}
+				w.Header().Set("Location", "https://github.com/logs/job/"+jobID)
+				w.WriteHeader(http.StatusFound)
+			}),
+		})
+
+		client := mustNewGHClient(t, mockedClient)
+		deps := BaseDeps{
+			Client:            client,
+			ContentWindowSize: 5000,
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"run_id":      float64(456),
+			"failed_only": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response struct {
+			FailedJobs int              `json:"failed_jobs"`
+			Logs       []map[string]any `json:"logs"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 8, response.FailedJobs)
+		require.Len(t, response.Logs, 8)
+		for i, log := range response.Logs {
+			assert.Equal(t, float64(i+1), log["job_id"])
+			if i+1 == 3 {
+				assert.Contains(t, log["error"], "failed to get job logs for job 3")
+				continue
+			}
+			assert.Equal(t, fmt.Sprintf("https://github.com/logs/job/%d", i+1), log["logs_url"])
+		}
+	})
+
 	t.Run("no failed jobs found", func(t *testing.T) {
 		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
 			GetReposActionsRunsJobsByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
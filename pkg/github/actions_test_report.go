@@ -0,0 +1,352 @@
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/archive"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// maxTestReportBytes bounds the uncompressed bytes read across all
+	// report files of an artifact.
+	maxTestReportBytes = 20 * 1024 * 1024
+	// maxTestReportFailures bounds how many failures are returned.
+	maxTestReportFailures = 50
+	// maxTestFailureDetailsBytes bounds the details kept for each failure.
+	maxTestFailureDetailsBytes = 2000
+)
+
+// TestFailure is a failed test case of a test report.
+type TestFailure struct {
+	Name      string `json:"name"`
+	ClassName string `json:"class_name,omitempty"`
+	File      string `json:"file"`
+	Message   string `json:"message,omitempty"`
+	Details   string `json:"details,omitempty"`
+}
+
+// TestReport summarizes the JUnit XML and TAP files of an artifact.
+type TestReport struct {
+	RunID        int64    `json:"run_id"`
+	ArtifactName string   `json:"artifact_name"`
+	Files        []string `json:"files"`
+	// SkippedFiles lists report files that were too large or unparseable.
+	SkippedFiles      []string      `json:"skipped_files,omitempty"`
+	Total             int           `json:"total"`
+	Passed            int           `json:"passed"`
+	Failed            int           `json:"failed"`
+	Skipped           int           `json:"skipped"`
+	Failures          []TestFailure `json:"failures"`
+	FailuresTruncated bool          `json:"failures_truncated,omitempty"`
+}
+
+// addFailure records a failure, keeping at most maxTestReportFailures.
+func (r *TestReport) addFailure(f TestFailure) {
+	r.Failed++
+	if len(r.Failures) >= maxTestReportFailures {
+		r.FailuresTruncated = true
+		return
+	}
+	if len(f.Details) > maxTestFailureDetailsBytes {
+		f.Details = strings.ToValidUTF8(f.Details[:maxTestFailureDetailsBytes], "") + "…"
+	}
+	r.Failures = append(r.Failures, f)
+}
+
+// junitSuite is a JUnit <testsuite> or <testsuites> element. Suites may nest.
+type junitSuite struct {
+	XMLName xml.Name
+	Suites  []junitSuite    `xml:"testsuite"`
+	Cases   []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitOutcome `xml:"failure"`
+	Error     *junitOutcome `xml:"error"`
+	Skipped   *junitOutcome `xml:"skipped"`
+}
+
+type junitOutcome struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// parseJUnitReport adds the test cases of a JUnit XML document to report.
+func parseJUnitReport(data []byte, file string, report *TestReport) error {
+	var root junitSuite
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return err
+	}
+	if root.XMLName.Local != "testsuites" && root.XMLName.Local != "testsuite" {
+		return fmt.Errorf("unexpected root element <%s>", root.XMLName.Local)
+	}
+
+	var walk func(s junitSuite)
+	walk = func(s junitSuite) {
+		for _, tc := range s.Cases {
+			report.Total++
+			outcome := tc.Failure
+			if outcome == nil {
+				outcome = tc.Error
+			}
+			switch {
+			case outcome != nil:
+				details := strings.TrimSpace(outcome.Text)
+				message := outcome.Message
+				if message == "" {
+					message, _, _ = strings.Cut(details, "\n")
+				}
+				report.addFailure(TestFailure{
+					Name:      tc.Name,
+					ClassName: tc.ClassName,
+					File:      file,
+					Message:   message,
+					Details:   details,
+				})
+			case tc.Skipped != nil:
+				report.Skipped++
+			default:
+				report.Passed++
+			}
+		}
+		for _, child := range s.Suites {
+			walk(child)
+		}
+	}
+	walk(root)
+	return nil
+}
+
+// tapTestLine matches a top-level TAP test line such as
+// "not ok 3 - handles empty input # TODO not implemented".
+var tapTestLine = regexp.MustCompile(`^(not )?ok\b(?:\s+\d+)?(?:\s*-)?\s*([^#]*?)\s*(?:#\s*(.*))?$`)
+
+// parseTAPReport adds the tests of a TAP document to report. Indented
+// subtests are summarized by their parent and are not counted.
+func parseTAPReport(data []byte, file string, report *TestReport) {
+	var pending *TestFailure
+	var yaml []string
+	inYAML := false
+
+	flush := func() {
+		if pending == nil {
+			return
+		}
+		if len(yaml) > 0 {
+			pending.Details = strings.Join(yaml, "\n")
+			for _, line := range yaml {
+				if msg, ok := strings.CutPrefix(strings.TrimSpace(line), "message:"); ok {
+					pending.Message = strings.Trim(strings.TrimSpace(msg), `"'`)
+					break
+				}
+			}
+		}
+		report.addFailure(*pending)
+		pending, yaml, inYAML = nil, nil, false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxTestFailureDetailsBytes*64)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if pending != nil {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case !inYAML && trimmed == "---" && line != trimmed:
+				inYAML = true
+				continue
+			case inYAML && trimmed == "...":
+				flush()
+				continue
+			case inYAML:
+				yaml = append(yaml, strings.TrimSpace(line))
+				continue
+			}
+		}
+
+		m := tapTestLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		flush()
+		report.Total++
+		directive := strings.ToUpper(m[3])
+		switch {
+		case strings.HasPrefix(directive, "SKIP"), strings.HasPrefix(directive, "TODO"):
+			report.Skipped++
+		case m[1] != "":
+			pending = &TestFailure{Name: m[2], File: file}
+		default:
+			report.Passed++
+		}
+	}
+	flush()
+}
+
+// isTAPReport reports whether a file holds TAP output.
+func isTAPReport(name string, data []byte) bool {
+	if strings.EqualFold(path.Ext(name), ".tap") {
+		return true
+	}
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("TAP version"))
+}
+
+// buildTestReport parses the JUnit XML and TAP files of an artifact. It
+// returns an error only when the archive itself cannot be read.
+func buildTestReport(zr *archive.ZipReader, report *TestReport) error {
+	budget := int64(maxTestReportBytes)
+	for {
+		f, err := zr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if f.IsDir {
+			continue
+		}
+		ext := strings.ToLower(path.Ext(f.Name))
+		if ext != ".xml" && ext != ".tap" && ext != ".txt" {
+			continue
+		}
+		data, err := readArtifactEntry(zr, min(budget, maxArtifactEntryBytes))
+		if errors.Is(err, errArtifactEntryTooLarge) {
+			report.SkippedFiles = append(report.SkippedFiles, f.Name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		budget -= int64(len(data))
+
+		switch {
+		case ext == ".xml":
+			if err := parseJUnitReport(data, f.Name, report); err != nil {
+				// Not every XML file in an artifact is a test report.
+				continue
+			}
+		case isTAPReport(f.Name, data):
+			parseTAPReport(data, f.Name, report)
+		default:
+			continue
+		}
+		report.Files = append(report.Files, f.Name)
+	}
+}
+
+// GetTestReport creates a tool to summarize the test results uploaded as a workflow run artifact.
+func GetTestReport(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "get_test_report",
+			Description: t("TOOL_GET_TEST_REPORT_DESCRIPTION",
+				"Download a workflow run artifact containing test results and summarize the JUnit XML and TAP files in it: "+
+					"counts of passed, failed and skipped tests, and the name and failure message of each failed test. "+
+					"Use this to see which tests failed without reading through job logs."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_TEST_REPORT_USER_TITLE", "Get test report from artifact"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"run_id": {
+						Type:        "number",
+						Description: "The unique identifier of the workflow run",
+					},
+					"artifact_name": {
+						Type:        "string",
+						Description: "The name of the artifact holding the test results",
+					},
+				},
+				Required: []string{"owner", "repo", "run_id", "artifact_name"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			runID, err := RequiredBigInt(args, "run_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			artifactName, err := RequiredParam[string](args, "artifact_name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			artifact, resp, err := findWorkflowRunArtifact(ctx, client, owner, repo, runID, artifactName)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow run artifacts", resp, err), nil, nil
+			}
+			if artifact == nil {
+				return utils.NewToolResultError(fmt.Sprintf("workflow run %d has no artifact named %q", runID, artifactName)), nil, nil
+			}
+
+			zr, body, errResult := openArtifactZip(ctx, client, owner, repo, artifact, archive.Limits{
+				MaxEntryBytes: maxArtifactEntryBytes,
+				MaxTotalBytes: maxArtifactUncompressedBytes,
+				MaxEntries:    maxArtifactEntries,
+			})
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			defer func() { _ = body.Close() }()
+
+			report := TestReport{
+				RunID:        runID,
+				ArtifactName: artifactName,
+				Files:        []string{},
+				Failures:     []TestFailure{},
+			}
+			if err := buildTestReport(zr, &report); err != nil {
+				return artifactReadError(artifact, err), nil, nil
+			}
+			if len(report.Files) == 0 && len(report.SkippedFiles) == 0 {
+				return utils.NewToolResultError(fmt.Sprintf("artifact %q contains no JUnit XML or TAP test reports", artifactName)), nil, nil
+			}
+
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, MarshalledTextResult(report), ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
@@ -0,0 +1,233 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildZip returns a ZIP archive holding the given files.
+func buildZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+const junitFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="pkg/github">
+    <testcase classname="pkg/github" name="TestGetMe"/>
+    <testcase classname="pkg/github" name="TestListIssues">
+      <failure message="expected 2 issues, got 1" type="AssertionError">issues_test.go:42: expected 2 issues, got 1</failure>
+    </testcase>
+    <testcase classname="pkg/github" name="TestSearch">
+      <error>panic: runtime error
+goroutine 7 [running]</error>
+    </testcase>
+    <testcase classname="pkg/github" name="TestSlow"><skipped message="short mode"/></testcase>
+  </testsuite>
+  <testsuite name="pkg/utils">
+    <testsuite name="nested">
+      <testcase classname="pkg/utils" name="TestResult"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+
+const tapFixture = `TAP version 13
+1..5
+ok 1 - parses config
+not ok 2 - handles empty input
+  ---
+  message: "expected [] but got undefined"
+  severity: fail
+  ...
+ok 3 - skipped on windows # SKIP not supported
+not ok 4 - future feature # TODO not implemented
+    ok 1 - indented subtest is not counted
+not ok 5 timeout
+`
+
+func Test_ParseJUnitReport(t *testing.T) {
+	report := TestReport{}
+	require.NoError(t, parseJUnitReport([]byte(junitFixture), "junit.xml", &report))
+
+	assert.Equal(t, 5, report.Total)
+	assert.Equal(t, 2, report.Passed)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, []TestFailure{
+		{Name: "TestListIssues", ClassName: "pkg/github", File: "junit.xml", Message: "expected 2 issues, got 1", Details: "issues_test.go:42: expected 2 issues, got 1"},
+		{Name: "TestSearch", ClassName: "pkg/github", File: "junit.xml", Message: "panic: runtime error", Details: "panic: runtime error\ngoroutine 7 [running]"},
+	}, report.Failures)
+
+	assert.Error(t, parseJUnitReport([]byte(`<project><name>x</name></project>`), "pom.xml", &TestReport{}))
+}
+
+func Test_ParseTAPReport(t *testing.T) {
+	report := TestReport{}
+	parseTAPReport([]byte(tapFixture), "results.tap", &report)
+
+	assert.Equal(t, 5, report.Total)
+	assert.Equal(t, 1, report.Passed)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, 2, report.Skipped)
+	assert.Equal(t, []TestFailure{
+		{
+			Name:    "handles empty input",
+			File:    "results.tap",
+			Message: "expected [] but got undefined",
+			Details: "message: \"expected [] but got undefined\"\nseverity: fail",
+		},
+		{Name: "timeout", File: "results.tap"},
+	}, report.Failures)
+}
+
+func Test_TestReportFailureLimit(t *testing.T) {
+	report := TestReport{}
+	for range maxTestReportFailures + 5 {
+		report.addFailure(TestFailure{Name: "t", Details: string(bytes.Repeat([]byte("x"), maxTestFailureDetailsBytes+10))})
+	}
+	assert.Equal(t, maxTestReportFailures+5, report.Failed)
+	assert.Len(t, report.Failures, maxTestReportFailures)
+	assert.True(t, report.FailuresTruncated)
+	assert.Len(t, report.Failures[0].Details, maxTestFailureDetailsBytes+len("…"))
+}
+
+func Test_GetTestReport(t *testing.T) {
+	serverTool := GetTestReport(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_test_report", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "run_id", "artifact_name"})
+
+	archives := map[string][]byte{
+		"/reports": buildZip(t, map[string]string{
+			"junit/unit.xml": junitFixture,
+			"tap/e2e.tap":    tapFixture,
+			"coverage.html":  "<html></html>",
+			"pom.xml":        "<project/>",
+		}),
+		"/empty": buildZip(t, map[string]string{"readme.txt": "nothing here"}),
+	}
+	// The signed download URLs must be fetched without the user's token.
+	blobServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"), "artifact download sent credentials")
+		_, _ = w.Write(archives[r.URL.Path])
+	}))
+	defer blobServer.Close()
+
+	artifacts := &github.ArtifactList{
+		TotalCount: github.Ptr(int64(4)),
+		Artifacts: []*github.Artifact{
+			{ID: github.Ptr(int64(1)), Name: github.Ptr("test-results"), SizeInBytes: github.Ptr(int64(2048))},
+			{ID: github.Ptr(int64(2)), Name: github.Ptr("coverage"), SizeInBytes: github.Ptr(int64(2048))},
+			{ID: github.Ptr(int64(3)), Name: github.Ptr("old-results"), Expired: github.Ptr(true)},
+			{ID: github.Ptr(int64(4)), Name: github.Ptr("huge-results"), SizeInBytes: github.Ptr(int64(maxArtifactDownloadBytes + 1))},
+		},
+	}
+	handlers := map[string]http.HandlerFunc{
+		GetReposActionsRunsArtifactsByOwnerByRepoByRunID: mockResponse(t, http.StatusOK, artifacts),
+		GetReposActionsArtifactsZipByOwnerByRepoByArtifactID: func(w http.ResponseWriter, r *http.Request) {
+			target := "/reports"
+			if r.URL.Path == "/repos/owner/repo/actions/artifacts/2/zip" {
+				target = "/empty"
+			}
+			w.Header().Set("Location", blobServer.URL+target)
+			w.WriteHeader(http.StatusFound)
+		},
+	}
+
+	tests := []struct {
+		name            string
+		artifactName    string
+		expectToolError bool
+		expectedErrMsg  string
+	}{
+		{
+			name:         "summarizes JUnit and TAP files",
+			artifactName: "test-results",
+		},
+		{
+			name:            "artifact without test reports",
+			artifactName:    "coverage",
+			expectToolError: true,
+			expectedErrMsg:  `artifact "coverage" contains no JUnit XML or TAP test reports`,
+		},
+		{
+			name:            "unknown artifact",
+			artifactName:    "missing",
+			expectToolError: true,
+			expectedErrMsg:  `workflow run 42 has no artifact named "missing"`,
+		},
+		{
+			name:            "expired artifact",
+			artifactName:    "old-results",
+			expectToolError: true,
+			expectedErrMsg:  `artifact "old-results" has expired`,
+		},
+		{
+			name:            "artifact over the size limit",
+			artifactName:    "huge-results",
+			expectToolError: true,
+			expectedErrMsg:  "larger than the",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewAuthenticatedGHClient(t, MockHTTPClientWithHandlers(handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"run_id":        float64(42),
+				"artifact_name": tc.artifactName,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var report TestReport
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &report))
+			assert.Equal(t, int64(42), report.RunID)
+			assert.ElementsMatch(t, []string{"junit/unit.xml", "tap/e2e.tap"}, report.Files)
+			assert.Equal(t, 10, report.Total)
+			assert.Equal(t, 3, report.Passed)
+			assert.Equal(t, 4, report.Failed)
+			assert.Equal(t, 3, report.Skipped)
+			assert.Len(t, report.Failures, 4)
+		})
+	}
+}
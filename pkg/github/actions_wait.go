@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	defaultWorkflowRunWaitTimeout = 5 * time.Minute
+	maxWorkflowRunWaitTimeout     = 15 * time.Minute
+)
+
+type workflowRunPollConfigKey struct{}
+
+// WorkflowRunPollConfig configures how wait_for_workflow_run polls. The delay
+// between polls starts at InitialDelay and grows by half after each poll, up
+// to MaxDelay.
+type WorkflowRunPollConfig struct {
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// ContextWithWorkflowRunPollConfig returns a context with workflow run polling
+// configuration. Use this in tests to shorten the delays.
+func ContextWithWorkflowRunPollConfig(ctx context.Context, config WorkflowRunPollConfig) context.Context {
+	return context.WithValue(ctx, workflowRunPollConfigKey{}, config)
+}
+
+// getWorkflowRunPollConfig returns the workflow run polling configuration from
+// context, or defaults.
+func getWorkflowRunPollConfig(ctx context.Context) WorkflowRunPollConfig {
+	if config, ok := ctx.Value(workflowRunPollConfigKey{}).(WorkflowRunPollConfig); ok {
+		return config
+	}
+	// Default: 5s, 7.5s, 11.25s, ... capped at 30s, about 15 polls in 5 minutes.
+	return WorkflowRunPollConfig{InitialDelay: 5 * time.Second, MaxDelay: 30 * time.Second}
+}
+
+// WorkflowRunWaitResult is the output of wait_for_workflow_run.
+type WorkflowRunWaitResult struct {
+	RunID  int64  `json:"run_id"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status"`
+	// Conclusion is set once the run has completed, e.g. "success" or "failure".
+	Conclusion string `json:"conclusion,omitempty"`
+	HTMLURL    string `json:"html_url"`
+	Completed  bool   `json:"completed"`
+	// TimedOut reports that the timeout was reached before the run completed.
+	// The run keeps going; call the tool again to keep waiting.
+	TimedOut       bool `json:"timed_out,omitempty"`
+	Polls          int  `json:"polls"`
+	ElapsedSeconds int  `json:"elapsed_seconds"`
+}
+
+// WaitForWorkflowRun creates a tool that blocks until a workflow run completes
+// or a timeout is reached.
+func WaitForWorkflowRun(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "wait_for_workflow_run",
+			Description: t("TOOL_WAIT_FOR_WORKFLOW_RUN_DESCRIPTION",
+				"Wait for a GitHub Actions workflow run to complete and return its conclusion and URL. "+
+					"This tool blocks: it polls the run with increasing delays until its status is 'completed' or 'timeout_seconds' (default 300) has passed. "+
+					"On timeout it returns the current status with timed_out=true and the run keeps going; call the tool again to keep waiting."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_WAIT_FOR_WORKFLOW_RUN_USER_TITLE", "Wait for workflow run"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"run_id": {
+						Type:        "number",
+						Description: "The ID of the workflow run",
+					},
+					"timeout_seconds": {
+						Type:        "number",
+						Description: "Maximum number of seconds to wait for the run to complete",
+						Default:     json.RawMessage(strconv.Itoa(int(defaultWorkflowRunWaitTimeout.Seconds()))),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(maxWorkflowRunWaitTimeout.Seconds()),
+					},
+				},
+				Required: []string{"owner", "repo", "run_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, request *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			runID, err := RequiredBigInt(args, "run_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			timeoutSeconds, err := OptionalIntParamWithDefault(args, "timeout_seconds", int(defaultWorkflowRunWaitTimeout.Seconds()))
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			timeout := time.Duration(timeoutSeconds) * time.Second
+			if timeout < time.Second || timeout > maxWorkflowRunWaitTimeout {
+				return utils.NewToolResultError(fmt.Sprintf("timeout_seconds must be between 1 and %d", int(maxWorkflowRunWaitTimeout.Seconds()))), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			pollConfig := getWorkflowRunPollConfig(ctx)
+			progressToken := request.Params.GetProgressToken()
+			start := time.Now()
+			deadline := start.Add(timeout)
+			delay := pollConfig.InitialDelay
+
+			result := WorkflowRunWaitResult{RunID: runID}
+			for {
+				run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow run", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+
+				result.Polls++
+				result.Name = run.GetName()
+				result.Status = run.GetStatus()
+				result.Conclusion = run.GetConclusion()
+				result.HTMLURL = run.GetHTMLURL()
+				if result.Status == "completed" {
+					result.Completed = true
+					break
+				}
+
+				remaining := time.Until(deadline)
+				if remaining <= 0 {
+					result.TimedOut = true
+					break
+				}
+
+				if progressToken != nil && request.Session != nil {
+					_ = request.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+						ProgressToken: progressToken,
+						Progress:      time.Since(start).Seconds(),
+						Total:         timeout.Seconds(),
+						Message:       fmt.Sprintf("Workflow run %d is %s, waiting...", runID, result.Status),
+					})
+				}
+
+				// Poll once more at the deadline rather than sleeping past it.
+				select {
+				case <-ctx.Done():
+					return utils.NewToolResultErrorFromErr("stopped waiting for workflow run", ctx.Err()), nil, nil
+				case <-time.After(min(delay, remaining)):
+				}
+				delay = min(delay+delay/2, pollConfig.MaxDelay)
+			}
+			result.ElapsedSeconds = int(time.Since(start).Seconds())
+
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, MarshalledTextResult(result), ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
@@ -0,0 +1,175 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WaitForWorkflowRun(t *testing.T) {
+	serverTool := WaitForWorkflowRun(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "wait_for_workflow_run", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "timeout_seconds")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "run_id"})
+
+	// runHandler reports the run as in progress for the first inProgressPolls
+	// polls and as completed afterwards; a negative count never completes.
+	runHandler := func(inProgressPolls int32) http.HandlerFunc {
+		var polls atomic.Int32
+		return func(w http.ResponseWriter, _ *http.Request) {
+			run := &github.WorkflowRun{
+				ID:      github.Ptr(int64(42)),
+				Name:    github.Ptr("CI"),
+				Status:  github.Ptr("in_progress"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/42"),
+			}
+			if n := polls.Add(1); inProgressPolls >= 0 && n > inProgressPolls {
+				run.Status = github.Ptr("completed")
+				run.Conclusion = github.Ptr("success")
+			}
+			data, _ := json.Marshal(run)
+			_, _ = w.Write(data)
+		}
+	}
+
+	tests := []struct {
+		name            string
+		handler         http.HandlerFunc
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expected        WorkflowRunWaitResult
+	}{
+		{
+			name:    "waits until the run completes",
+			handler: runHandler(2),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expected: WorkflowRunWaitResult{
+				RunID:      42,
+				Name:       "CI",
+				Status:     "completed",
+				Conclusion: "success",
+				HTMLURL:    "https://github.com/owner/repo/actions/runs/42",
+				Completed:  true,
+				Polls:      3,
+			},
+		},
+		{
+			name:    "reports the current status on timeout",
+			handler: runHandler(-1),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(42),
+				"timeout_seconds": float64(1),
+			},
+			expected: WorkflowRunWaitResult{
+				RunID:    42,
+				Name:     "CI",
+				Status:   "in_progress",
+				HTMLURL:  "https://github.com/owner/repo/actions/runs/42",
+				TimedOut: true,
+			},
+		},
+		{
+			name:    "run not found",
+			handler: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get workflow run",
+		},
+		{
+			name: "timeout out of range",
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(42),
+				"timeout_seconds": float64(3600),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "timeout_seconds must be between 1 and 900",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetReposActionsRunsByOwnerByRepoByRunID: tc.handler,
+				})),
+			}
+			handler := serverTool.Handler(deps)
+
+			ctx := ContextWithWorkflowRunPollConfig(ContextWithDeps(context.Background(), deps), WorkflowRunPollConfig{
+				InitialDelay: 10 * time.Millisecond,
+				MaxDelay:     100 * time.Millisecond,
+			})
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ctx, &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response WorkflowRunWaitResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			if tc.expected.TimedOut {
+				assert.Greater(t, response.Polls, 1)
+				response.Polls = 0
+			}
+			response.ElapsedSeconds = 0
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+
+	t.Run("stops waiting when the context is canceled", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsRunsByOwnerByRepoByRunID: runHandler(-1),
+			})),
+		}
+		handler := serverTool.Handler(deps)
+
+		ctx, cancel := context.WithTimeout(ContextWithDeps(context.Background(), deps), 50*time.Millisecond)
+		defer cancel()
+		ctx = ContextWithWorkflowRunPollConfig(ctx, WorkflowRunPollConfig{InitialDelay: time.Hour, MaxDelay: time.Hour})
+		request := createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"run_id": float64(42),
+		})
+		result, err := handler(ctx, &request)
+		require.NoError(t, err)
+
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "stopped waiting for workflow run")
+	})
+}
@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// WorkflowState is the state of a workflow after it was enabled or disabled.
+type WorkflowState struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+}
+
+// EnableWorkflow creates a tool to enable a workflow.
+func EnableWorkflow(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return workflowStateTool(t, true)
+}
+
+// DisableWorkflow creates a tool to disable a workflow.
+func DisableWorkflow(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return workflowStateTool(t, false)
+}
+
+// workflowStateTool builds enable_workflow or disable_workflow. Both take the
+// same arguments and report the workflow's state after the change.
+func workflowStateTool(t translations.TranslationHelperFunc, enable bool) inventory.ServerTool {
+	name, description, title := "disable_workflow",
+		t("TOOL_DISABLE_WORKFLOW_DESCRIPTION", "Disable a GitHub Actions workflow so that it is no longer triggered, for example to pause a noisy scheduled workflow. Returns the workflow's resulting state."),
+		t("TOOL_DISABLE_WORKFLOW_USER_TITLE", "Disable workflow")
+	if enable {
+		name, description, title = "enable_workflow",
+			t("TOOL_ENABLE_WORKFLOW_DESCRIPTION", "Enable a disabled GitHub Actions workflow so that it is triggered again. Returns the workflow's resulting state."),
+			t("TOOL_ENABLE_WORKFLOW_USER_TITLE", "Enable workflow")
+	}
+
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name:        name,
+			Description: description,
+			Annotations: &mcp.ToolAnnotations{
+				Title:        title,
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"workflow_id": {
+						Type:        "string",
+						Description: "The workflow ID (numeric) or workflow file name (e.g., main.yml, ci.yaml)",
+					},
+				},
+				Required: []string{"owner", "repo", "workflow_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			workflowID, err := RequiredParam[string](args, "workflow_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			action := "disable"
+			if enable {
+				action = "enable"
+			}
+
+			var resp *github.Response
+			workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64)
+			switch {
+			case parseErr == nil && enable:
+				resp, err = client.Actions.EnableWorkflowByID(ctx, owner, repo, workflowIDInt)
+			case parseErr == nil:
+				resp, err = client.Actions.DisableWorkflowByID(ctx, owner, repo, workflowIDInt)
+			case enable:
+				resp, err = client.Actions.EnableWorkflowByFileName(ctx, owner, repo, workflowID)
+			default:
+				resp, err = client.Actions.DisableWorkflowByFileName(ctx, owner, repo, workflowID)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to %s workflow", action), resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			var workflow *github.Workflow
+			if parseErr == nil {
+				workflow, resp, err = client.Actions.GetWorkflowByID(ctx, owner, repo, workflowIDInt)
+			} else {
+				workflow, resp, err = client.Actions.GetWorkflowByFileName(ctx, owner, repo, workflowID)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := MarshalledTextResult(WorkflowState{
+				ID:      workflow.GetID(),
+				Name:    workflow.GetName(),
+				Path:    workflow.GetPath(),
+				State:   workflow.GetState(),
+				HTMLURL: workflow.GetHTMLURL(),
+			})
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
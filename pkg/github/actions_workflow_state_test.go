@@ -0,0 +1,125 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EnableDisableWorkflow(t *testing.T) {
+	enableTool := EnableWorkflow(translations.NullTranslationHelper)
+	disableTool := DisableWorkflow(translations.NullTranslationHelper)
+	for _, serverTool := range []inventory.ServerTool{enableTool, disableTool} {
+		tool := serverTool.Tool
+		require.NoError(t, toolsnaps.Test(tool.Name, tool))
+		assert.False(t, tool.Annotations.ReadOnlyHint)
+		schema, ok := tool.InputSchema.(*jsonschema.Schema)
+		require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+		assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "workflow_id"})
+	}
+	assert.Equal(t, "enable_workflow", enableTool.Tool.Name)
+	assert.Equal(t, "disable_workflow", disableTool.Tool.Name)
+
+	// workflowHandler reports the workflow in the given state, checking that
+	// it was addressed by the expected path segment.
+	workflowHandler := func(workflowID, state string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/repos/owner/repo/actions/workflows/"+workflowID, r.URL.Path)
+			data, _ := json.Marshal(&github.Workflow{
+				ID:      github.Ptr(int64(161335)),
+				Name:    github.Ptr("Nightly"),
+				Path:    github.Ptr(".github/workflows/nightly.yml"),
+				State:   github.Ptr(state),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/.github/workflows/nightly.yml"),
+			})
+			_, _ = w.Write(data)
+		}
+	}
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	tests := []struct {
+		name            string
+		serverTool      inventory.ServerTool
+		workflowID      string
+		handlers        map[string]http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+		expectedState   string
+	}{
+		{
+			name:       "disable by file name",
+			serverTool: disableTool,
+			workflowID: "nightly.yml",
+			handlers: map[string]http.HandlerFunc{
+				PutReposActionsWorkflowsDisableByOwnerByRepoByWorkflowID: noContent,
+				GetReposActionsWorkflowsByOwnerByRepoByWorkflowID:        workflowHandler("nightly.yml", "disabled_manually"),
+			},
+			expectedState: "disabled_manually",
+		},
+		{
+			name:       "enable by numeric ID",
+			serverTool: enableTool,
+			workflowID: "161335",
+			handlers: map[string]http.HandlerFunc{
+				PutReposActionsWorkflowsEnableByOwnerByRepoByWorkflowID: noContent,
+				GetReposActionsWorkflowsByOwnerByRepoByWorkflowID:       workflowHandler("161335", "active"),
+			},
+			expectedState: "active",
+		},
+		{
+			name:       "workflow not found",
+			serverTool: enableTool,
+			workflowID: "missing.yml",
+			handlers: map[string]http.HandlerFunc{
+				PutReposActionsWorkflowsEnableByOwnerByRepoByWorkflowID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to enable workflow",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := tc.serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": tc.workflowID,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response WorkflowState
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, WorkflowState{
+				ID:      161335,
+				Name:    "Nightly",
+				Path:    ".github/workflows/nightly.yml",
+				State:   tc.expectedState,
+				HTMLURL: "https://github.com/owner/repo/blob/main/.github/workflows/nightly.yml",
+			}, response)
+		})
+	}
+}
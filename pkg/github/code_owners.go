@@ -0,0 +1,272 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// codeOwnersLocations are the places GitHub looks for a CODEOWNERS file, in
+// the order it looks. The first one found is used.
+var codeOwnersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// codeOwnersRule is one pattern line of a CODEOWNERS file.
+type codeOwnersRule struct {
+	Line    int
+	Pattern string
+	Owners  []string
+	re      *regexp.Regexp
+}
+
+// parseCodeOwners parses the rules of a CODEOWNERS file. Lines GitHub would
+// skip as invalid are skipped too and reported as errors.
+func parseCodeOwners(content string) ([]codeOwnersRule, []string) {
+	var rules []codeOwnersRule
+	var errs []string
+	for i, line := range strings.Split(content, "\n") {
+		fields := splitCodeOwnersLine(line)
+		if len(fields) == 0 {
+			continue
+		}
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, "!") {
+			errs = append(errs, fmt.Sprintf("line %d: negated patterns are not supported in CODEOWNERS", i+1))
+			continue
+		}
+		re, err := compileCodeOwnersPattern(pattern)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: %v", i+1, err))
+			continue
+		}
+		rules = append(rules, codeOwnersRule{Line: i + 1, Pattern: pattern, Owners: fields[1:], re: re})
+	}
+	return rules, errs
+}
+
+// splitCodeOwnersLine splits a line into its pattern and owners, dropping
+// comments. A backslash escapes the next character, so "\#" and "\ " can
+// appear in patterns; escapes are kept for compileCodeOwnersPattern.
+func splitCodeOwnersLine(line string) []string {
+	var fields []string
+	var field strings.Builder
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			field.WriteByte(c)
+			field.WriteByte(line[i+1])
+			i++
+		case c == '#' && field.Len() == 0:
+			return fields
+		case c == ' ' || c == '\t' || c == '\r':
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+		default:
+			field.WriteByte(c)
+		}
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// compileCodeOwnersPattern converts a CODEOWNERS pattern into a regular
+// expression matching the repository paths of the files it owns. Patterns
+// follow gitignore rules, as GitHub applies them to CODEOWNERS:
+//   - a pattern with a leading or inner slash is relative to the repository
+//     root; one without a slash matches at any depth
+//   - "*" and "?" do not match slashes; "**" matches any number of directories
+//   - a pattern naming a directory owns every file under it, and a trailing
+//     slash makes the pattern match directories only
+//   - a pattern ending in "/*" only owns the files directly in that directory
+func compileCodeOwnersPattern(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(trimmed, "/")
+	trimmed = strings.TrimPrefix(trimmed, "/")
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid pattern %q", pattern)
+	}
+
+	var re strings.Builder
+	re.WriteString("^")
+	if !anchored {
+		re.WriteString("(?:.*/)?")
+	}
+	re.WriteString(globRegexpSource(trimmed))
+	last := trimmed[strings.LastIndex(trimmed, "/")+1:]
+	switch {
+	case last == "**":
+	case dirOnly:
+		re.WriteString("/.*")
+	case last == "*" && anchored:
+		// "docs/*" owns docs/a.md but not docs/guides/b.md.
+	default:
+		re.WriteString("(?:/.*)?")
+	}
+	re.WriteString("$")
+	return regexp.Compile(re.String())
+}
+
+// matchCodeOwners returns the rule owning filePath: the last matching rule,
+// or nil when no rule matches.
+func matchCodeOwners(rules []codeOwnersRule, filePath string) *codeOwnersRule {
+	filePath = strings.TrimPrefix(filePath, "/")
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].re.MatchString(filePath) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// CodeOwnersResult is the output of get_code_owners.
+type CodeOwnersResult struct {
+	Path string `json:"path"`
+	// File is the CODEOWNERS file that was read, or empty if there is none.
+	File string `json:"file,omitempty"`
+	// Pattern and Line identify the rule that owns the path.
+	Pattern string `json:"pattern,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	// Users and Teams are ready to pass to request_reviewers; teams are "org/slug".
+	Users  []string `json:"users"`
+	Teams  []string `json:"teams"`
+	Emails []string `json:"emails,omitempty"`
+	// Errors lists CODEOWNERS lines that were skipped because they are invalid.
+	Errors  []string `json:"errors,omitempty"`
+	Message string   `json:"message,omitempty"`
+}
+
+// GetCodeOwners creates a tool to find the code owners of a file from the repository's CODEOWNERS file.
+func GetCodeOwners(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "get_code_owners",
+			Description: t("TOOL_GET_CODE_OWNERS_DESCRIPTION",
+				"Find the code owners of a file from the repository's CODEOWNERS file ("+strings.Join(codeOwnersLocations, ", ")+"). "+
+					"Returns the users and teams of the last rule matching the path, as GitHub applies them. "+
+					"Use it to choose reviewers for a change, for example with request_reviewers."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_CODE_OWNERS_USER_TITLE", "Get code owners"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"path": {
+						Type:        "string",
+						Description: "Path of the file in the repository, e.g. src/app/main.go",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag or commit SHA to read CODEOWNERS at. Defaults to the repository's default branch",
+					},
+				},
+				Required: []string{"owner", "repo", "path"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			filePath, err := RequiredParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			output := CodeOwnersResult{Path: filePath, Users: []string{}, Teams: []string{}}
+			var content string
+			for _, location := range codeOwnersLocations {
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, location, &github.RepositoryContentGetOptions{Ref: ref})
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get CODEOWNERS file", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				if file == nil {
+					// A directory with the name of a CODEOWNERS location is not a CODEOWNERS file.
+					continue
+				}
+				content, err = file.GetContent()
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to decode CODEOWNERS file", err), nil, nil
+				}
+				output.File = location
+				break
+			}
+
+			switch output.File {
+			case "":
+				output.Message = "the repository has no CODEOWNERS file"
+			default:
+				rules, errs := parseCodeOwners(content)
+				output.Errors = errs
+				rule := matchCodeOwners(rules, filePath)
+				if rule == nil {
+					output.Message = "no CODEOWNERS rule matches the path"
+					break
+				}
+				output.Pattern = rule.Pattern
+				output.Line = rule.Line
+				for _, o := range rule.Owners {
+					switch {
+					case strings.HasPrefix(o, "@") && strings.Contains(o, "/"):
+						output.Teams = append(output.Teams, strings.TrimPrefix(o, "@"))
+					case strings.HasPrefix(o, "@"):
+						output.Users = append(output.Users, strings.TrimPrefix(o, "@"))
+					default:
+						output.Emails = append(output.Emails, o)
+					}
+				}
+				if len(rule.Owners) == 0 {
+					output.Message = "the matching rule has no owners, so the path is unowned"
+				}
+			}
+
+			result := MarshalledTextResult(output)
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelGetFileContents)
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,207 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MatchCodeOwners(t *testing.T) {
+	rules, errs := parseCodeOwners(`# Default owners
+*       @global-owner1 @global-owner2
+*.js    @js-owner # inline comment
+*.go    docs@example.com
+/build/logs/ @doctocat
+docs/*  @docs-owner
+apps/   @octocat
+/docs/  @doctocat-docs
+/scripts/ @doctocat @octocat
+**/logs @octo-logs
+/apps/github
+/assets/**/*.png @org/design
+path\ with\ spaces/ @spacey
+\#hash.txt @hash-owner
+!negated @nobody
+`)
+	assert.Equal(t, []string{"line 15: negated patterns are not supported in CODEOWNERS"}, errs)
+
+	tests := []struct {
+		path           string
+		expectedLine   int
+		expectedOwners []string
+	}{
+		{path: "README.md", expectedLine: 2, expectedOwners: []string{"@global-owner1", "@global-owner2"}},
+		{path: "src/app/index.js", expectedLine: 3, expectedOwners: []string{"@js-owner"}},
+		{path: "main.go", expectedLine: 4, expectedOwners: []string{"docs@example.com"}},
+		{path: "build/logs/2024/app.log", expectedLine: 10, expectedOwners: []string{"@octo-logs"}},
+		{path: "build/logs.txt", expectedLine: 2, expectedOwners: []string{"@global-owner1", "@global-owner2"}},
+		{path: "docs/getting-started.md", expectedLine: 8, expectedOwners: []string{"@doctocat-docs"}},
+		{path: "src/docs/getting-started.md", expectedLine: 2, expectedOwners: []string{"@global-owner1", "@global-owner2"}},
+		{path: "web/apps/main.go", expectedLine: 7, expectedOwners: []string{"@octocat"}},
+		{path: "apps/github/index.ts", expectedLine: 11, expectedOwners: []string{}},
+		{path: "scripts/deploy/run.sh", expectedLine: 9, expectedOwners: []string{"@doctocat", "@octocat"}},
+		{path: "deep/nested/logs", expectedLine: 10, expectedOwners: []string{"@octo-logs"}},
+		{path: "assets/logo.png", expectedLine: 12, expectedOwners: []string{"@org/design"}},
+		{path: "assets/icons/small/logo.png", expectedLine: 12, expectedOwners: []string{"@org/design"}},
+		{path: "path with spaces/file.txt", expectedLine: 13, expectedOwners: []string{"@spacey"}},
+		{path: "#hash.txt", expectedLine: 14, expectedOwners: []string{"@hash-owner"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			rule := matchCodeOwners(rules, tc.path)
+			require.NotNil(t, rule)
+			assert.Equal(t, tc.expectedLine, rule.Line)
+			assert.ElementsMatch(t, tc.expectedOwners, rule.Owners)
+		})
+	}
+
+	assert.Nil(t, matchCodeOwners(nil, "README.md"))
+}
+
+func Test_GetCodeOwners(t *testing.T) {
+	serverTool := GetCodeOwners(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "get_code_owners", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "path"})
+
+	codeOwnersFile := func(content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type:     github.Ptr("file"),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+		}
+	}
+	notFound := mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)
+	// contents serves the CODEOWNERS file at one location and 404s elsewhere.
+	contents := func(location, content string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/repos/owner/repo/contents/"+location {
+				notFound(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, codeOwnersFile(content))(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       CodeOwnersResult
+	}{
+		{
+			name: "returns users and teams of the last matching rule",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": contents("CODEOWNERS", "* @octocat\n/src/ @hubot @owner/backend dev@example.com\n"),
+			},
+			requestArgs: map[string]any{"path": "src/main.go"},
+			expected: CodeOwnersResult{
+				Path:    "src/main.go",
+				File:    "CODEOWNERS",
+				Pattern: "/src/",
+				Line:    2,
+				Users:   []string{"hubot"},
+				Teams:   []string{"owner/backend"},
+				Emails:  []string{"dev@example.com"},
+			},
+		},
+		{
+			name: "reads .github/CODEOWNERS at a ref",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": expectQueryParams(t, map[string]string{
+					"ref": "release",
+				}).andThen(contents(".github/CODEOWNERS", "*.md @docs\n")),
+			},
+			requestArgs: map[string]any{"path": "README.md", "ref": "release"},
+			expected: CodeOwnersResult{
+				Path:    "README.md",
+				File:    ".github/CODEOWNERS",
+				Pattern: "*.md",
+				Line:    1,
+				Users:   []string{"docs"},
+				Teams:   []string{},
+			},
+		},
+		{
+			name: "no matching rule",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": contents("docs/CODEOWNERS", "*.md @docs\n"),
+			},
+			requestArgs: map[string]any{"path": "main.go"},
+			expected: CodeOwnersResult{
+				Path:    "main.go",
+				File:    "docs/CODEOWNERS",
+				Users:   []string{},
+				Teams:   []string{},
+				Message: "no CODEOWNERS rule matches the path",
+			},
+		},
+		{
+			name: "no CODEOWNERS file",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": notFound,
+			},
+			requestArgs: map[string]any{"path": "main.go"},
+			expected: CodeOwnersResult{
+				Path:    "main.go",
+				Users:   []string{},
+				Teams:   []string{},
+				Message: "the repository has no CODEOWNERS file",
+			},
+		},
+		{
+			name: "API error",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+			},
+			requestArgs:    map[string]any{"path": "main.go"},
+			expectError:    true,
+			expectedErrMsg: "failed to get CODEOWNERS file",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response CodeOwnersResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -133,7 +133,7 @@ func ListCodeScanningAlerts(t translations.TranslationHelperFunc) inventory.Serv
 		},
 		Required: []string{"owner", "repo"},
 	}
-	WithPagination(schema)
+	WithPageAndCursorPagination(schema)
 
 	return NewTool(
 		ToolsetMetadataCodeSecurity,
@@ -173,7 +173,7 @@ func ListCodeScanningAlerts(t translations.TranslationHelperFunc) inventory.Serv
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -182,15 +182,14 @@ func ListCodeScanningAlerts(t translations.TranslationHelperFunc) inventory.Serv
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
+			listOptions, cursorOptions := restPaginationOptions(pagination)
 			alerts, resp, err := client.CodeScanning.ListAlertsForRepo(ctx, owner, repo, &github.AlertListOptions{
-				Ref:      ref,
-				State:    state,
-				Severity: severity,
-				ToolName: toolName,
-				ListOptions: github.ListOptions{
-					Page:    pagination.Page,
-					PerPage: pagination.PerPage,
-				},
+				Ref:               ref,
+				State:             state,
+				Severity:          severity,
+				ToolName:          toolName,
+				ListCursorOptions: cursorOptions,
+				ListOptions:       listOptions,
 			})
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -209,7 +208,12 @@ func ListCodeScanningAlerts(t translations.TranslationHelperFunc) inventory.Serv
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list alerts", resp, body), nil, nil
 			}
 
-			r, err := json.Marshal(alerts)
+			response := map[string]any{
+				"alerts":   alerts,
+				"pageInfo": buildPageInfo(resp),
+			}
+
+			r, err := json.Marshal(response)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to marshal alerts", err), nil, nil
 			}
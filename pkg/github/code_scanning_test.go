@@ -139,6 +139,8 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 	assert.Contains(t, schema.Properties, "tool_name")
 	assert.Contains(t, schema.Properties, "page")
 	assert.Contains(t, schema.Properties, "perPage")
+	assert.Contains(t, schema.Properties, "after")
+	assert.Contains(t, schema.Properties, "before")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
 
 	// Setup mock alerts for success case
@@ -158,12 +160,13 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]any
-		expectError    bool
-		expectedAlerts []*github.Alert
-		expectedErrMsg string
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedAlerts   []*github.Alert
+		expectedPageInfo pageInfo
+		expectedErrMsg   string
 	}{
 		{
 			name: "successful alerts listing",
@@ -209,6 +212,53 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 			expectError:    false,
 			expectedAlerts: mockAlerts,
 		},
+		{
+			name: "cursor pagination replaces page numbers",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningAlertsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"after":    "Y3Vyc29yOjQy",
+					"per_page": "30",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockAlerts),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"page":  float64(3),
+				"after": "Y3Vyc29yOjQy",
+			},
+			expectError:    false,
+			expectedAlerts: mockAlerts,
+		},
+		{
+			name: "successful alerts listing surfaces cursors from the Link header",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCodeScanningAlertsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"before":   "Y3Vyc29yOjUw",
+					"per_page": "30",
+				}).andThen(
+					func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/code-scanning/alerts?after=nextcursor&per_page=30>; rel="next", `+
+							`<https://api.github.com/repos/owner/repo/code-scanning/alerts?before=prevcursor&per_page=30>; rel="prev"`)
+						mockResponse(t, http.StatusOK, mockAlerts)(w, nil)
+					},
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"before": "Y3Vyc29yOjUw",
+			},
+			expectError:    false,
+			expectedAlerts: mockAlerts,
+			expectedPageInfo: pageInfo{
+				HasNextPage:     true,
+				HasPreviousPage: true,
+				NextCursor:      "nextcursor",
+				PrevCursor:      "prevcursor",
+			},
+		},
 		{
 			name: "alerts listing fails",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -257,11 +307,15 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlerts []*github.Alert
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
+			var returned struct {
+				Alerts   []*github.Alert `json:"alerts"`
+				PageInfo pageInfo        `json:"pageInfo"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			assert.NoError(t, err)
-			assert.Len(t, returnedAlerts, len(tc.expectedAlerts))
-			for i, alert := range returnedAlerts {
+			assert.Equal(t, tc.expectedPageInfo, returned.PageInfo)
+			assert.Len(t, returned.Alerts, len(tc.expectedAlerts))
+			for i, alert := range returned.Alerts {
 				assert.Equal(t, *tc.expectedAlerts[i].Number, *alert.Number)
 				assert.Equal(t, *tc.expectedAlerts[i].State, *alert.State)
 				assert.Equal(t, *tc.expectedAlerts[i].Rule.ID, *alert.Rule.ID)
@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// CommunityProfile is the output of get_community_profile: which community
+// health files a repository has, and GitHub's overall health percentage.
+type CommunityProfile struct {
+	HealthPercentage       int  `json:"health_percentage"`
+	HasReadme              bool `json:"has_readme"`
+	HasLicense             bool `json:"has_license"`
+	HasCodeOfConduct       bool `json:"has_code_of_conduct"`
+	HasContributing        bool `json:"has_contributing"`
+	HasIssueTemplate       bool `json:"has_issue_template"`
+	HasPullRequestTemplate bool `json:"has_pull_request_template"`
+	ContentReportsEnabled  bool `json:"content_reports_enabled"`
+	// License is the SPDX ID of the detected license, or its name when it has none.
+	License string `json:"license,omitempty"`
+	// CodeOfConduct is the name of the detected code of conduct.
+	CodeOfConduct string `json:"code_of_conduct,omitempty"`
+	Documentation string `json:"documentation,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+}
+
+func convertToCommunityProfile(metrics *github.CommunityHealthMetrics) CommunityProfile {
+	profile := CommunityProfile{
+		HealthPercentage:      metrics.GetHealthPercentage(),
+		ContentReportsEnabled: metrics.GetContentReportsEnabled(),
+		Documentation:         metrics.GetDocumentation(),
+	}
+	if metrics.UpdatedAt != nil {
+		profile.UpdatedAt = metrics.UpdatedAt.Format(time.RFC3339)
+	}
+	if files := metrics.Files; files != nil {
+		profile.HasReadme = files.Readme != nil
+		profile.HasLicense = files.License != nil
+		// code_of_conduct is set when GitHub recognizes the code of conduct,
+		// code_of_conduct_file whenever the file exists.
+		profile.HasCodeOfConduct = files.CodeOfConduct != nil || files.CodeOfConductFile != nil
+		profile.HasContributing = files.Contributing != nil
+		profile.HasIssueTemplate = files.IssueTemplate != nil
+		profile.HasPullRequestTemplate = files.PullRequestTemplate != nil
+		if files.License != nil {
+			profile.License = files.License.GetSPDXID()
+			if profile.License == "" || profile.License == "NOASSERTION" {
+				profile.License = files.License.GetName()
+			}
+		}
+		if files.CodeOfConduct != nil {
+			profile.CodeOfConduct = files.CodeOfConduct.GetName()
+		}
+	}
+	return profile
+}
+
+// GetCommunityProfile creates a tool to get the community health profile of a repository.
+func GetCommunityProfile(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "get_community_profile",
+			Description: t("TOOL_GET_COMMUNITY_PROFILE_DESCRIPTION",
+				"Get the community health profile of a public GitHub repository: whether it has a README, license, code of conduct, "+
+					"contributing guide, and issue and pull request templates, and GitHub's overall health percentage."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_COMMUNITY_PROFILE_USER_TITLE", "Get community profile"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			metrics, resp, err := client.Repositories.GetCommunityHealthMetrics(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get community profile", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := MarshalledTextResult(convertToCommunityProfile(metrics))
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCommunityProfile(t *testing.T) {
+	serverTool := GetCommunityProfile(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "get_community_profile", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		expectError    bool
+		expectedErrMsg string
+		expected       CommunityProfile
+	}{
+		{
+			name: "repository with most community files",
+			handlers: map[string]http.HandlerFunc{
+				GetReposCommunityProfileByOwnerByRepo: mockResponse(t, http.StatusOK, `{
+					"health_percentage": 85,
+					"documentation": "https://docs.example.com",
+					"files": {
+						"code_of_conduct": {"name": "Contributor Covenant", "key": "contributor_covenant"},
+						"code_of_conduct_file": {"url": "https://api.github.com/repos/owner/repo/contents/CODE_OF_CONDUCT.md"},
+						"contributing": {"url": "https://api.github.com/repos/owner/repo/contents/CONTRIBUTING.md"},
+						"issue_template": {"url": "https://api.github.com/repos/owner/repo/contents/.github/ISSUE_TEMPLATE"},
+						"pull_request_template": null,
+						"license": {"name": "MIT License", "key": "mit", "spdx_id": "MIT"},
+						"readme": {"url": "https://api.github.com/repos/owner/repo/contents/README.md"}
+					},
+					"updated_at": "2024-06-03T10:00:00Z",
+					"content_reports_enabled": true
+				}`),
+			},
+			expected: CommunityProfile{
+				HealthPercentage:      85,
+				HasReadme:             true,
+				HasLicense:            true,
+				HasCodeOfConduct:      true,
+				HasContributing:       true,
+				HasIssueTemplate:      true,
+				ContentReportsEnabled: true,
+				License:               "MIT",
+				CodeOfConduct:         "Contributor Covenant",
+				Documentation:         "https://docs.example.com",
+				UpdatedAt:             "2024-06-03T10:00:00Z",
+			},
+		},
+		{
+			name: "unrecognized license and code of conduct",
+			handlers: map[string]http.HandlerFunc{
+				GetReposCommunityProfileByOwnerByRepo: mockResponse(t, http.StatusOK, `{
+					"health_percentage": 42,
+					"files": {
+						"code_of_conduct": null,
+						"code_of_conduct_file": {"url": "https://api.github.com/repos/owner/repo/contents/CODE_OF_CONDUCT.md"},
+						"license": {"name": "Other", "key": "other", "spdx_id": "NOASSERTION"}
+					}
+				}`),
+			},
+			expected: CommunityProfile{
+				HealthPercentage: 42,
+				HasLicense:       true,
+				HasCodeOfConduct: true,
+				License:          "Other",
+			},
+		},
+		{
+			name: "repository not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposCommunityProfileByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get community profile",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response CommunityProfile
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -3,14 +3,19 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/shurcooL/githubv4"
@@ -301,3 +306,375 @@ func GetTeamMembers(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 	)
 }
+
+// OrganizationMembership describes the authenticated user's membership of an organization.
+type OrganizationMembership struct {
+	Org   string `json:"org"`
+	Role  string `json:"role"`
+	State string `json:"state"`
+	// TwoFactorRequirementEnabled is only visible to organization owners and
+	// is omitted for organizations where the user is a regular member.
+	TwoFactorRequirementEnabled *bool `json:"two_factor_requirement_enabled,omitempty"`
+}
+
+// ListMyOrganizations creates a tool to list the organizations the authenticated user belongs to.
+func ListMyOrganizations(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "list_my_organizations",
+			Description: t("TOOL_LIST_MY_ORGANIZATIONS_DESCRIPTION",
+				"List the organizations the authenticated user belongs to, with the user's role ('admin' for owners, 'member' otherwise) and membership state. "+
+					"Use this to find out which organizations you can act in before calling organization-scoped tools."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_MY_ORGANIZATIONS_USER_TITLE", "List my organizations"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"state": {
+						Type:        "string",
+						Description: "Only list memberships in this state. Pending memberships are invitations the user has not accepted yet",
+						Enum:        []any{"active", "pending"},
+					},
+				},
+			}),
+		},
+		[]scopes.Scope{scopes.ReadOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			state, err := OptionalParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			memberships, resp, err := client.Organizations.ListOrgMemberships(ctx, &github.ListOrgMembershipsOptions{
+				State: state,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list organization memberships",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			organizations := make([]OrganizationMembership, 0, len(memberships))
+			for _, membership := range memberships {
+				m := OrganizationMembership{
+					Org:   membership.GetOrganization().GetLogin(),
+					Role:  membership.GetRole(),
+					State: membership.GetState(),
+				}
+
+				// The 2FA requirement is not part of the membership payload and
+				// only owners can read it from the organization itself, so skip
+				// the extra request for everyone else.
+				if m.Role == "admin" && m.State == "active" {
+					org, resp, err := client.Organizations.Get(ctx, m.Org)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to get organization %s", m.Org),
+							resp,
+							err,
+						), nil, nil
+					}
+					_ = resp.Body.Close()
+					m.TwoFactorRequirementEnabled = org.TwoFactorRequirementEnabled
+				}
+
+				organizations = append(organizations, m)
+			}
+
+			result := MarshalledTextResult(organizations)
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelOrgMemberships())
+			return result, nil, nil
+		},
+	)
+}
+
+// RenderMarkdown creates a tool to render markdown to HTML the way GitHub does.
+func RenderMarkdown(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "render_markdown",
+			Description: t("TOOL_RENDER_MARKDOWN_DESCRIPTION",
+				"Render markdown to HTML with GitHub's renderer, to preview how a comment, issue or README will look. "+
+					"In 'gfm' mode line breaks are kept and @mentions and issue references are linked; "+
+					"set 'context' to the repository the text will be posted in so that references such as #123 link to its issues."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_RENDER_MARKDOWN_USER_TITLE", "Render markdown"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"text": {
+						Type:        "string",
+						Description: "Markdown to render",
+					},
+					"mode": {
+						Type:        "string",
+						Description: "Rendering mode: 'gfm' renders like a comment or issue, 'markdown' renders like a README file",
+						Enum:        []any{"gfm", "markdown"},
+						Default:     json.RawMessage(`"gfm"`),
+					},
+					"context": {
+						Type:        "string",
+						Description: "Repository in 'owner/repo' form used to link issue references. Only used in 'gfm' mode",
+					},
+				},
+				Required: []string{"text"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			text, err := RequiredParam[string](args, "text")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			mode, err := OptionalParam[string](args, "mode")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if mode == "" {
+				mode = "gfm"
+			}
+			if mode != "gfm" && mode != "markdown" {
+				return utils.NewToolResultError(fmt.Sprintf("invalid mode %q: must be one of gfm, markdown", mode)), nil, nil
+			}
+			repoContext, err := OptionalParam[string](args, "context")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if repoContext != "" {
+				owner, repo, ok := strings.Cut(repoContext, "/")
+				if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+					return utils.NewToolResultError(fmt.Sprintf("invalid context %q: must be in 'owner/repo' form", repoContext)), nil, nil
+				}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			html, resp, err := client.Markdown.Render(ctx, text, &github.MarkdownOptions{
+				Mode:    mode,
+				Context: repoContext,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to render markdown",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return utils.NewToolResultText(html), nil, nil
+		},
+	)
+}
+
+// emojiCache holds the emoji list per API base URL. GitHub only changes the
+// list with new releases, so it is fetched once per host for the lifetime of
+// the process.
+var emojiCache sync.Map
+
+// ListEmojis creates a tool to list the emoji shortcodes GitHub supports.
+func ListEmojis(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "list_emojis",
+			Description: t("TOOL_LIST_EMOJIS_DESCRIPTION",
+				"List the emoji shortcodes GitHub renders in markdown (e.g. :rocket:), mapped to their image URLs. "+
+					"Use 'query' to filter by name, as the full list has nearly two thousand entries."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_EMOJIS_USER_TITLE", "List emojis"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"query": {
+						Type:        "string",
+						Description: "Only list emojis whose name contains this text (case-insensitive)",
+					},
+				},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			query, err := OptionalParam[string](args, "query")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			key := client.BaseURL()
+			cached, ok := emojiCache.Load(key)
+			if !ok {
+				emojis, resp, err := client.Emojis.List(ctx)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list emojis",
+						resp,
+						err,
+					), nil, nil
+				}
+				_ = resp.Body.Close()
+				cached, _ = emojiCache.LoadOrStore(key, emojis)
+			}
+			emojis := cached.(map[string]string)
+
+			query = strings.ToLower(query)
+			filtered := make(map[string]string)
+			for name, url := range emojis {
+				if strings.Contains(strings.ToLower(name), query) {
+					filtered[name] = url
+				}
+			}
+
+			return MarshalledTextResult(filtered), nil, nil
+		},
+	)
+}
+
+// AuthRateLimit is the core REST rate limit of the authenticated token.
+type AuthRateLimit struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Used      int       `json:"used"`
+	ResetAt   time.Time `json:"reset_at"`
+}
+
+// AuthStatus describes the authenticated user and the token the server uses.
+type AuthStatus struct {
+	Login string `json:"login"`
+	// TokenType is the kind of token, taken from its prefix when the server
+	// knows it and otherwise inferred from the response headers.
+	TokenType string `json:"token_type"`
+	// Scopes are the OAuth scopes granted to the token. Only classic personal
+	// access tokens and OAuth app tokens report scopes; for other tokens
+	// ScopesReported is false and access is governed by fine-grained
+	// permissions instead.
+	Scopes         []string       `json:"scopes"`
+	ScopesReported bool           `json:"scopes_reported"`
+	ExpiresAt      string         `json:"expires_at,omitempty"`
+	RateLimit      *AuthRateLimit `json:"rate_limit,omitempty"`
+}
+
+// tokenTypeName returns the name get_auth_status reports for a token type.
+func tokenTypeName(tokenType utils.TokenType) string {
+	switch tokenType {
+	case utils.TokenTypePersonalAccessToken:
+		return "classic_personal_access_token"
+	case utils.TokenTypeFineGrainedPersonalAccessToken:
+		return "fine_grained_personal_access_token"
+	case utils.TokenTypeOAuthAccessToken:
+		return "oauth_access_token"
+	case utils.TokenTypeUserToServerGitHubAppToken:
+		return "github_app_user_token"
+	case utils.TokenTypeServerToServerGitHubAppToken:
+		return "github_app_installation_token"
+	default:
+		return "unknown"
+	}
+}
+
+// GetAuthStatus creates a tool to report the authenticated user together with
+// the scopes, type and rate limit of the token.
+func GetAuthStatus(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "get_auth_status",
+			Description: t("TOOL_GET_AUTH_STATUS_DESCRIPTION",
+				"Get the authenticated user's login together with the token's type, granted OAuth scopes, expiry and remaining rate limit. "+
+					"Use this to diagnose why a call failed with 403 or 404, e.g. because the token lacks a scope such as 'repo' or 'workflow'."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_AUTH_STATUS_USER_TITLE", "Get authentication status"),
+				ReadOnlyHint: true,
+			},
+			// Use json.RawMessage to ensure "properties" is included even when empty.
+			// OpenAI strict mode requires the properties field to be present.
+			InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			user, res, err := client.Users.Get(ctx, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get user",
+					res,
+					err,
+				), nil, nil
+			}
+			_ = res.Body.Close()
+
+			// An empty header still means the token is scoped, just with no
+			// scopes granted, so presence is checked separately from value.
+			scopesHeader := res.Header.Values(scopes.OAuthScopesHeader)
+			scopesReported := len(scopesHeader) > 0
+			status := AuthStatus{
+				Login:          user.GetLogin(),
+				Scopes:         []string{},
+				ScopesReported: scopesReported,
+				ExpiresAt:      res.Header.Get("GitHub-Authentication-Token-Expiration"),
+			}
+			if scopesReported {
+				status.Scopes = scopes.ParseScopeHeader(strings.Join(scopesHeader, ","))
+			}
+
+			switch tokenInfo, ok := ghcontext.GetTokenInfo(ctx); {
+			case ok:
+				status.TokenType = tokenTypeName(tokenInfo.TokenType)
+			case scopesReported:
+				// Both classic personal access tokens and OAuth app tokens
+				// report scopes; without the token itself they look alike.
+				status.TokenType = "classic_personal_access_token_or_oauth_access_token"
+			default:
+				status.TokenType = "fine_grained_or_github_app_token"
+			}
+
+			if res.Rate.Limit > 0 {
+				status.RateLimit = &AuthRateLimit{
+					Limit:     res.Rate.Limit,
+					Remaining: res.Rate.Remaining,
+					Used:      res.Rate.Used,
+					ResetAt:   res.Rate.Reset.Time,
+				}
+			}
+
+			result := MarshalledTextResult(status)
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.LabelGetMe())
+			return result, nil, nil
+		},
+	)
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/google/go-github/v89/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
@@ -159,6 +161,8 @@ func Test_GetMe_IFC_FeatureFlag(t *testing.T) {
 			translations.NullTranslationHelper,
 			FeatureFlags{},
 			0,
+			false,
+			0,
 			func(_ context.Context, flagName string) (bool, error) {
 				return flagName == FeatureFlagIFCLabels && enabled, nil
 			},
@@ -582,3 +586,359 @@ func Test_GetTeamMembers(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListMyOrganizations(t *testing.T) {
+	t.Parallel()
+
+	serverTool := ListMyOrganizations(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_my_organizations", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "list_my_organizations tool should be read-only")
+	assert.Equal(t, []string{"read:org"}, serverTool.RequiredScopes)
+
+	mockMemberships := []*github.Membership{
+		{
+			State:        github.Ptr("active"),
+			Role:         github.Ptr("admin"),
+			Organization: &github.Organization{Login: github.Ptr("owned-org")},
+		},
+		{
+			State:        github.Ptr("active"),
+			Role:         github.Ptr("member"),
+			Organization: &github.Organization{Login: github.Ptr("member-org")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       []OrganizationMembership
+	}{
+		{
+			name: "reports 2FA requirement only for owned organizations",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserMembershipsOrgs: mockResponse(t, http.StatusOK, mockMemberships),
+				GetOrgsByOrg: func(w http.ResponseWriter, r *http.Request) {
+					// Only the organization the user owns should be fetched.
+					assert.Equal(t, "/orgs/owned-org", r.URL.Path)
+					mockResponse(t, http.StatusOK, &github.Organization{
+						Login:                       github.Ptr("owned-org"),
+						TwoFactorRequirementEnabled: github.Ptr(true),
+					})(w, r)
+				},
+			}),
+			requestArgs: map[string]any{},
+			expected: []OrganizationMembership{
+				{Org: "owned-org", Role: "admin", State: "active", TwoFactorRequirementEnabled: github.Ptr(true)},
+				{Org: "member-org", Role: "member", State: "active"},
+			},
+		},
+		{
+			name: "filters by state",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserMembershipsOrgs: expectQueryParams(t, map[string]string{
+					"state":    "pending",
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.Membership{
+					{
+						State:        github.Ptr("pending"),
+						Role:         github.Ptr("admin"),
+						Organization: &github.Organization{Login: github.Ptr("invited-org")},
+					},
+				})),
+			}),
+			requestArgs: map[string]any{
+				"state": "pending",
+			},
+			expected: []OrganizationMembership{
+				{Org: "invited-org", Role: "admin", State: "pending"},
+			},
+		},
+		{
+			name: "missing read:org scope",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserMembershipsOrgs: mockResponse(t, http.StatusForbidden, `{"message": "You need at least read:org scope or user scope to list your organizations."}`),
+			}),
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "failed to list organization memberships",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, tc.mockedClient),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response []OrganizationMembership
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_RenderMarkdown(t *testing.T) {
+	t.Parallel()
+
+	serverTool := RenderMarkdown(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "render_markdown", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "render_markdown tool should be read-only")
+	assert.Empty(t, serverTool.RequiredScopes)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       string
+	}{
+		{
+			name: "renders gfm with repository context by default",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostMarkdown: expectRequestBody(t, map[string]any{
+					"text":    "Fixes #1",
+					"mode":    "gfm",
+					"context": "owner/repo",
+				}).andThen(mockResponse(t, http.StatusOK, `<p>Fixes <a href="https://github.com/owner/repo/issues/1">#1</a></p>`)),
+			}),
+			requestArgs: map[string]any{
+				"text":    "Fixes #1",
+				"context": "owner/repo",
+			},
+			expected: `<p>Fixes <a href="https://github.com/owner/repo/issues/1">#1</a></p>`,
+		},
+		{
+			name: "renders plain markdown",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostMarkdown: expectRequestBody(t, map[string]any{
+					"text": "# Title",
+					"mode": "markdown",
+				}).andThen(mockResponse(t, http.StatusOK, "<h1>Title</h1>")),
+			}),
+			requestArgs: map[string]any{
+				"text": "# Title",
+				"mode": "markdown",
+			},
+			expected: "<h1>Title</h1>",
+		},
+		{
+			name:         "invalid context",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"text":    "Fixes #1",
+				"context": "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: "must be in 'owner/repo' form",
+		},
+		{
+			name: "API error",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostMarkdown: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+			}),
+			requestArgs: map[string]any{
+				"text": "hello",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to render markdown",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, tc.mockedClient),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expected, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_ListEmojis(t *testing.T) {
+	serverTool := ListEmojis(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_emojis", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "list_emojis tool should be read-only")
+	assert.Empty(t, serverTool.RequiredScopes)
+
+	emojiCache.Clear()
+	t.Cleanup(emojiCache.Clear)
+
+	calls := 0
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetEmojis: func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				_, _ = w.Write([]byte(`{
+					"rocket": "https://github.githubassets.com/images/icons/emoji/unicode/1f680.png",
+					"sparkles": "https://github.githubassets.com/images/icons/emoji/unicode/2728.png",
+					"Star": "https://github.githubassets.com/images/icons/emoji/unicode/2b50.png",
+					"star2": "https://github.githubassets.com/images/icons/emoji/unicode/1f31f.png"
+				}`))
+			},
+		})),
+	}
+	handler := serverTool.Handler(deps)
+
+	call := func(args map[string]any) map[string]string {
+		request := createMCPRequest(args)
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		var emojis map[string]string
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &emojis))
+		return emojis
+	}
+
+	assert.Len(t, call(map[string]any{}), 4)
+	assert.Equal(t, map[string]string{
+		"Star":  "https://github.githubassets.com/images/icons/emoji/unicode/2b50.png",
+		"star2": "https://github.githubassets.com/images/icons/emoji/unicode/1f31f.png",
+	}, call(map[string]any{"query": "STAR"}))
+	assert.Equal(t, 1, calls, "emojis should be fetched once and then served from the cache")
+}
+
+func Test_GetAuthStatus(t *testing.T) {
+	t.Parallel()
+
+	serverTool := GetAuthStatus(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_auth_status", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "get_auth_status tool should be read-only")
+
+	userHandler := func(headers map[string]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			for k, v := range headers {
+				w.Header().Set(k, v)
+			}
+			w.Header().Set("X-RateLimit-Limit", "5000")
+			w.Header().Set("X-RateLimit-Remaining", "4990")
+			w.Header().Set("X-RateLimit-Used", "10")
+			w.Header().Set("X-RateLimit-Reset", "1767225600")
+			_, _ = w.Write([]byte(`{"login": "testuser"}`))
+		}
+	}
+	rateLimit := &AuthRateLimit{
+		Limit:     5000,
+		Remaining: 4990,
+		Used:      10,
+		ResetAt:   time.Unix(1767225600, 0),
+	}
+
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		tokenInfo      *ghcontext.TokenInfo
+		expectedStatus AuthStatus
+	}{
+		{
+			name: "classic token reports scopes",
+			handler: userHandler(map[string]string{
+				"X-OAuth-Scopes":                         "repo, read:org, workflow",
+				"GitHub-Authentication-Token-Expiration": "2026-12-31 00:00:00 UTC",
+			}),
+			expectedStatus: AuthStatus{
+				Login:          "testuser",
+				TokenType:      "classic_personal_access_token_or_oauth_access_token",
+				Scopes:         []string{"repo", "read:org", "workflow"},
+				ScopesReported: true,
+				ExpiresAt:      "2026-12-31 00:00:00 UTC",
+				RateLimit:      rateLimit,
+			},
+		},
+		{
+			name:    "fine-grained token without scopes header",
+			handler: userHandler(nil),
+			expectedStatus: AuthStatus{
+				Login:     "testuser",
+				TokenType: "fine_grained_or_github_app_token",
+				Scopes:    []string{},
+				RateLimit: rateLimit,
+			},
+		},
+		{
+			name:      "token type from context",
+			handler:   userHandler(map[string]string{"X-OAuth-Scopes": ""}),
+			tokenInfo: &ghcontext.TokenInfo{TokenType: utils.TokenTypeOAuthAccessToken},
+			expectedStatus: AuthStatus{
+				Login:          "testuser",
+				TokenType:      "oauth_access_token",
+				Scopes:         []string{},
+				ScopesReported: true,
+				RateLimit:      rateLimit,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetUser: tc.handler,
+				})),
+				Obsv: stubExporters(),
+			}
+			handler := serverTool.Handler(deps)
+
+			ctx := ContextWithDeps(context.Background(), deps)
+			if tc.tokenInfo != nil {
+				ctx = ghcontext.WithTokenInfo(ctx, tc.tokenInfo)
+			}
+			request := createMCPRequest(map[string]any{})
+			result, err := handler(ctx, &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var status AuthStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &status))
+			require.NotNil(t, status.RateLimit)
+			assert.True(t, tc.expectedStatus.RateLimit.ResetAt.Equal(status.RateLimit.ResetAt))
+			status.RateLimit.ResetAt = tc.expectedStatus.RateLimit.ResetAt
+			assert.Equal(t, tc.expectedStatus, status)
+		})
+	}
+}
@@ -357,7 +357,7 @@ func AssignCopilotToIssue(t translations.TranslationHelperFunc) inventory.Server
 				},
 				nil,
 			); err != nil {
-				return nil, nil, fmt.Errorf("failed to update issue with agent assignment: %w", err)
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update issue with agent assignment", err), nil, nil
 			}
 
 			// Poll for a linked PR created by Copilot after the assignment
@@ -710,7 +710,7 @@ func AssignCopilotToIssueWithIntent(t translations.TranslationHelperFunc) invent
 			assignmentTime := time.Now().UTC()
 
 			if err := client.Mutate(ctxWithFeatures, &updateIssueMutation, input, nil); err != nil {
-				return nil, nil, fmt.Errorf("failed to update issue with agent assignment: %w", err)
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update issue with agent assignment", err), nil, nil
 			}
 
 			result := map[string]any{
@@ -80,6 +80,10 @@ func wrapHandlerWithCSVOutput(next inventory.HandlerFunc) inventory.HandlerFunc
 			if csvDeps == nil || !csvDeps.IsFeatureEnabled(ctx, FeatureFlagCSVOutput) {
 				return result, nil
 			}
+			// An explicit markdown request takes precedence over CSV output.
+			if requestedMarkdownOutput(req) {
+				return result, nil
+			}
 			return convertJSONTextResultToCSV(result), nil
 		}
 	}
@@ -128,6 +128,24 @@ func TestCSVOutputPreservesOriginalJSONWhenFlagOff(t *testing.T) {
 	assert.JSONEq(t, jsonResponse, text.Text)
 }
 
+func TestCSVOutputLeavesMarkdownOutputUntouched(t *testing.T) {
+	const markdownResponse = "| # | Title |\n| --- | --- |\n| 1 | First |\n"
+	tools := withCSVOutput([]inventory.ServerTool{testCSVOutputTool("list_things", markdownResponse)})
+	require.Len(t, tools, 1)
+
+	deps := newCSVOutputTestDeps(true)
+	request := &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{
+			Arguments: json.RawMessage(`{"output_format":"markdown"}`),
+		},
+	}
+	result, err := tools[0].Handler(deps)(ContextWithDeps(context.Background(), deps), request)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.False(t, result.IsError)
+	assert.Equal(t, markdownResponse, textResult(t, result))
+}
+
 func TestCSVOutputVariantMovesMetadataToPreamble(t *testing.T) {
 	csvText, err := jsonTextToCSV(`{
 		"issues": [
@@ -158,7 +158,7 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			pagination, err := OptionalCursorPaginationParams(args)
+			pagination, err := OptionalCursorPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
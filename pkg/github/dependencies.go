@@ -95,6 +95,14 @@ type ToolDependencies interface {
 	// GetContentWindowSize returns the content window size for log truncation
 	GetContentWindowSize() int
 
+	// GetDefaultReturnContent returns whether content-capable tools return
+	// content rather than download URLs when a call does not say
+	GetDefaultReturnContent() bool
+
+	// GetDefaultPerPage returns the page size paginated tools use when a
+	// call does not pass perPage
+	GetDefaultPerPage() int
+
 	// IsFeatureEnabled checks if a feature flag is enabled.
 	IsFeatureEnabled(ctx context.Context, flagName string) bool
 
@@ -117,10 +125,12 @@ type BaseDeps struct {
 	RawClient *raw.Client
 
 	// Static dependencies
-	RepoAccessCache   *lockdown.RepoAccessCache
-	T                 translations.TranslationHelperFunc
-	Flags             FeatureFlags
-	ContentWindowSize int
+	RepoAccessCache      *lockdown.RepoAccessCache
+	T                    translations.TranslationHelperFunc
+	Flags                FeatureFlags
+	ContentWindowSize    int
+	DefaultReturnContent bool
+	DefaultPerPage       int
 
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
@@ -141,19 +151,23 @@ func NewBaseDeps(
 	t translations.TranslationHelperFunc,
 	flags FeatureFlags,
 	contentWindowSize int,
+	defaultReturnContent bool,
+	defaultPerPage int,
 	featureChecker inventory.FeatureFlagChecker,
 	obsv observability.Exporters,
 ) *BaseDeps {
 	return &BaseDeps{
-		Client:            client,
-		GQLClient:         gqlClient,
-		RawClient:         rawClient,
-		RepoAccessCache:   repoAccessCache,
-		T:                 t,
-		Flags:             flags,
-		ContentWindowSize: contentWindowSize,
-		featureChecker:    featureChecker,
-		Obsv:              obsv,
+		Client:               client,
+		GQLClient:            gqlClient,
+		RawClient:            rawClient,
+		RepoAccessCache:      repoAccessCache,
+		T:                    t,
+		Flags:                flags,
+		ContentWindowSize:    contentWindowSize,
+		DefaultReturnContent: defaultReturnContent,
+		DefaultPerPage:       defaultPerPage,
+		featureChecker:       featureChecker,
+		Obsv:                 obsv,
 	}
 }
 
@@ -186,6 +200,12 @@ func (d BaseDeps) GetFlags(_ context.Context) FeatureFlags { return d.Flags }
 // GetContentWindowSize implements ToolDependencies.
 func (d BaseDeps) GetContentWindowSize() int { return d.ContentWindowSize }
 
+// GetDefaultReturnContent implements ToolDependencies.
+func (d BaseDeps) GetDefaultReturnContent() bool { return d.DefaultReturnContent }
+
+// GetDefaultPerPage implements ToolDependencies.
+func (d BaseDeps) GetDefaultPerPage() int { return defaultPerPageOr(d.DefaultPerPage) }
+
 // Logger implements ToolDependencies.
 func (d BaseDeps) Logger(_ context.Context) *slog.Logger {
 	return d.Obsv.Logger()
@@ -267,12 +287,14 @@ func NewToolFromHandler(
 
 type RequestDeps struct {
 	// Static dependencies
-	apiHosts          utils.APIHostResolver
-	version           string
-	lockdownMode      bool
-	RepoAccessOpts    []lockdown.RepoAccessOption
-	T                 translations.TranslationHelperFunc
-	ContentWindowSize int
+	apiHosts             utils.APIHostResolver
+	version              string
+	lockdownMode         bool
+	RepoAccessOpts       []lockdown.RepoAccessOption
+	T                    translations.TranslationHelperFunc
+	ContentWindowSize    int
+	DefaultReturnContent bool
+	DefaultPerPage       int
 
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
@@ -289,18 +311,22 @@ func NewRequestDeps(
 	repoAccessOpts []lockdown.RepoAccessOption,
 	t translations.TranslationHelperFunc,
 	contentWindowSize int,
+	defaultReturnContent bool,
+	defaultPerPage int,
 	featureChecker inventory.FeatureFlagChecker,
 	obsv observability.Exporters,
 ) *RequestDeps {
 	return &RequestDeps{
-		apiHosts:          apiHosts,
-		version:           version,
-		lockdownMode:      lockdownMode,
-		RepoAccessOpts:    repoAccessOpts,
-		T:                 t,
-		ContentWindowSize: contentWindowSize,
-		featureChecker:    featureChecker,
-		obsv:              obsv,
+		apiHosts:             apiHosts,
+		version:              version,
+		lockdownMode:         lockdownMode,
+		RepoAccessOpts:       repoAccessOpts,
+		T:                    t,
+		ContentWindowSize:    contentWindowSize,
+		DefaultReturnContent: defaultReturnContent,
+		DefaultPerPage:       defaultPerPage,
+		featureChecker:       featureChecker,
+		obsv:                 obsv,
 	}
 }
 
@@ -419,6 +445,12 @@ func (d *RequestDeps) GetFlags(ctx context.Context) FeatureFlags {
 // GetContentWindowSize implements ToolDependencies.
 func (d *RequestDeps) GetContentWindowSize() int { return d.ContentWindowSize }
 
+// GetDefaultReturnContent implements ToolDependencies.
+func (d *RequestDeps) GetDefaultReturnContent() bool { return d.DefaultReturnContent }
+
+// GetDefaultPerPage implements ToolDependencies.
+func (d *RequestDeps) GetDefaultPerPage() int { return defaultPerPageOr(d.DefaultPerPage) }
+
 // Logger implements ToolDependencies.
 func (d *RequestDeps) Logger(_ context.Context) *slog.Logger {
 	return d.obsv.Logger()
@@ -35,6 +35,8 @@ func TestIsFeatureEnabled_WithEnabledFlag(t *testing.T) {
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		false,   // defaultReturnContent
+		0,       // defaultPerPage
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -59,8 +61,10 @@ func TestIsFeatureEnabled_WithoutChecker(t *testing.T) {
 		nil, // repoAccessCache
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
-		0,   // contentWindowSize
-		nil, // featureChecker (nil)
+		0,     // contentWindowSize
+		false, // defaultReturnContent
+		0,     // defaultPerPage
+		nil,   // featureChecker (nil)
 		testExporters(),
 	)
 
@@ -85,6 +89,8 @@ func TestIsFeatureEnabled_EmptyFlagName(t *testing.T) {
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		false,   // defaultReturnContent
+		0,       // defaultPerPage
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -110,6 +116,8 @@ func TestIsFeatureEnabled_CheckerError(t *testing.T) {
 		translations.NullTranslationHelper,
 		github.FeatureFlags{},
 		0,       // contentWindowSize
+		false,   // defaultReturnContent
+		0,       // defaultPerPage
 		checker, // featureChecker
 		testExporters(),
 	)
@@ -0,0 +1,493 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxDeploymentStateLookups caps how many deployments list_deployments looks
+// up the latest status for, since each lookup is a separate API call.
+const maxDeploymentStateLookups = 30
+
+// validDeploymentStates is the set of states accepted by the create deployment status endpoint.
+var validDeploymentStates = map[string]bool{
+	"error":       true,
+	"failure":     true,
+	"inactive":    true,
+	"in_progress": true,
+	"queued":      true,
+	"pending":     true,
+	"success":     true,
+}
+
+// ListDeployments creates a tool to list deployments in a repository.
+func ListDeployments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "list_deployments",
+			Description: t("TOOL_LIST_DEPLOYMENTS_DESCRIPTION",
+				"List deployments in a GitHub repository, newest first. Each deployment includes its environment, ref, SHA, creator and creation time. "+
+					"Set include_state to also report the state of each deployment's most recent status; this costs one extra API call per deployment, so it is done for at most the first 30."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_DEPLOYMENTS_USER_TITLE", "List deployments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"environment": {
+						Type:        "string",
+						Description: "Only list deployments to this environment (e.g. 'production')",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Only list deployments of this branch, tag or SHA name",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "Only list deployments of this commit SHA",
+					},
+					"include_state": {
+						Type:        "boolean",
+						Description: "Look up the state of each deployment's most recent status (one extra API call per deployment, for at most the first 30)",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			environment, err := OptionalParam[string](args, "environment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := OptionalParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeState, err := OptionalParam[bool](args, "include_state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployments, resp, err := client.Repositories.ListDeployments(ctx, owner, repo, &github.DeploymentsListOptions{
+				Environment: environment,
+				Ref:         ref,
+				SHA:         sha,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list deployments",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			minimalDeployments := make([]MinimalDeployment, 0, len(deployments))
+			for _, deployment := range deployments {
+				minimalDeployments = append(minimalDeployments, convertToMinimalDeployment(deployment))
+			}
+
+			if includeState {
+				// Deployments carry no state of their own; it lives on the
+				// statuses, which the API returns newest first.
+				lookups := deployments[:min(len(deployments), maxDeploymentStateLookups)]
+				statuses, _ := runBounded(ctx, maxGetFilesConcurrency, len(lookups), func(ctx context.Context, i int) (deploymentStatusLookup, error) {
+					statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, lookups[i].GetID(), &github.ListOptions{PerPage: 1})
+					if err != nil {
+						return deploymentStatusLookup{resp: resp, err: err}, err
+					}
+					_ = resp.Body.Close()
+					if len(statuses) > 0 {
+						return deploymentStatusLookup{state: statuses[0].GetState()}, nil
+					}
+					return deploymentStatusLookup{}, nil
+				})
+				for i, status := range statuses {
+					if status.err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to list statuses for deployment %d", lookups[i].GetID()),
+							status.resp,
+							status.err,
+						), nil, nil
+					}
+					minimalDeployments[i].State = status.state
+				}
+			}
+
+			result := MarshalledTextResult(minimalDeployments)
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
+
+// deploymentStatusLookup is the outcome of looking up a deployment's latest
+// status for list_deployments.
+type deploymentStatusLookup struct {
+	state string
+	resp  *github.Response
+	err   error
+}
+
+// GetDeploymentStatus creates a tool to list the statuses reported for a deployment.
+func GetDeploymentStatus(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "get_deployment_status",
+			Description: t("TOOL_GET_DEPLOYMENT_STATUS_DESCRIPTION",
+				"Get the statuses reported for a deployment, newest first. The first status is the deployment's current state."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_DEPLOYMENT_STATUS_USER_TITLE", "Get deployment status"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"deployment_id": {
+						Type:        "number",
+						Description: "The unique identifier of the deployment",
+					},
+				},
+				Required: []string{"owner", "repo", "deployment_id"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			deploymentID, err := RequiredBigInt(args, "deployment_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, deploymentID, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list statuses for deployment %d", deploymentID),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			minimalStatuses := make([]MinimalDeploymentStatus, 0, len(statuses))
+			for _, status := range statuses {
+				minimalStatuses = append(minimalStatuses, convertToMinimalDeploymentStatus(status))
+			}
+
+			result := MarshalledTextResult(minimalStatuses)
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
+
+// CreateDeployment creates a tool to create a deployment for a ref.
+func CreateDeployment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name: "create_deployment",
+			Description: t("TOOL_CREATE_DEPLOYMENT_DESCRIPTION",
+				"Create a deployment of a branch, tag or SHA to an environment. "+
+					"By default GitHub verifies that all commit status and check contexts on the ref are successful; pass 'required_contexts' to choose which contexts must pass, or an empty array to skip the verification. "+
+					"Use create_deployment_status to report the deployment's progress."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_DEPLOYMENT_USER_TITLE", "Create deployment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ref": {
+						Type:        "string",
+						Description: "The branch, tag or SHA to deploy",
+					},
+					"environment": {
+						Type:        "string",
+						Description: "Name of the target environment (defaults to 'production')",
+					},
+					"description": {
+						Type:        "string",
+						Description: "Short description of the deployment",
+					},
+					"required_contexts": {
+						Type:        "array",
+						Description: "Status check contexts that must pass before deploying. Omit to require all contexts; pass an empty array to bypass the checks",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"auto_merge": {
+						Type:        "boolean",
+						Description: "Merge the default branch into the ref first if the ref is behind it (defaults to true)",
+					},
+				},
+				Required: []string{"owner", "repo", "ref"},
+			},
+		},
+		[]scopes.Scope{scopes.RepoDeployment},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := RequiredParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			environment, err := OptionalParam[string](args, "environment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			description, err := OptionalParam[string](args, "description")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			autoMerge, hasAutoMerge, err := OptionalParamOK[bool](args, "auto_merge")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			req := github.DeploymentRequest{Ref: ref}
+			if environment != "" {
+				req.Environment = github.Ptr(environment)
+			}
+			if description != "" {
+				req.Description = github.Ptr(description)
+			}
+			if hasAutoMerge {
+				req.AutoMerge = github.Ptr(autoMerge)
+			}
+			// An omitted list and an empty list mean different things to the
+			// API, so only send required_contexts when the caller passed it.
+			if _, ok := args["required_contexts"]; ok {
+				requiredContexts, err := OptionalStringArrayParam(args, "required_contexts")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				req.RequiredContexts = requiredContexts
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployment, resp, err := client.Repositories.CreateDeployment(ctx, owner, repo, req)
+			// 202 means GitHub merged the default branch into the ref instead
+			// of creating a deployment; the ref now has a new head.
+			if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+				return utils.NewToolResultText(fmt.Sprintf("The default branch was merged into %s because it was behind; no deployment was created. Call create_deployment again to deploy the merged ref.", ref)), nil, nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create deployment",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(convertToMinimalDeployment(deployment)), nil, nil
+		},
+	)
+}
+
+// CreateDeploymentStatus creates a tool to report the status of a deployment.
+func CreateDeploymentStatus(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataActions,
+		mcp.Tool{
+			Name:        "create_deployment_status",
+			Description: t("TOOL_CREATE_DEPLOYMENT_STATUS_DESCRIPTION", "Report the state of a deployment, optionally with links to its logs and the deployed environment."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_DEPLOYMENT_STATUS_USER_TITLE", "Create deployment status"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"deployment_id": {
+						Type:        "number",
+						Description: "The unique identifier of the deployment",
+					},
+					"state": {
+						Type:        "string",
+						Description: "The new state of the deployment",
+						Enum:        []any{"queued", "pending", "in_progress", "success", "failure", "error", "inactive"},
+					},
+					"description": {
+						Type:        "string",
+						Description: "Short description of the status (max 140 characters)",
+					},
+					"log_url": {
+						Type:        "string",
+						Description: "URL of the deployment's logs",
+					},
+					"environment_url": {
+						Type:        "string",
+						Description: "URL for accessing the deployed environment",
+					},
+				},
+				Required: []string{"owner", "repo", "deployment_id", "state"},
+			},
+		},
+		[]scopes.Scope{scopes.RepoDeployment},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			deploymentID, err := RequiredBigInt(args, "deployment_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			state, err := RequiredParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if !validDeploymentStates[state] {
+				return utils.NewToolResultError(fmt.Sprintf("invalid state %q: must be one of queued, pending, in_progress, success, failure, error, inactive", state)), nil, nil
+			}
+			description, err := OptionalParam[string](args, "description")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			logURL, err := OptionalParam[string](args, "log_url")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			environmentURL, err := OptionalParam[string](args, "environment_url")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			req := github.DeploymentStatusRequest{State: state}
+			if description != "" {
+				req.Description = github.Ptr(description)
+			}
+			if logURL != "" {
+				req.LogURL = github.Ptr(logURL)
+			}
+			if environmentURL != "" {
+				req.EnvironmentURL = github.Ptr(environmentURL)
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			status, resp, err := client.Repositories.CreateDeploymentStatus(ctx, owner, repo, deploymentID, req)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create status for deployment %d", deploymentID),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(convertToMinimalDeploymentStatus(status)), nil, nil
+		},
+	)
+}
@@ -0,0 +1,517 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListDeployments(t *testing.T) {
+	serverTool := ListDeployments(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "list_deployments", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "environment")
+	assert.Contains(t, schema.Properties, "ref")
+	assert.Contains(t, schema.Properties, "sha")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	createdAt := github.Timestamp{Time: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+	mockDeployments := []*github.Deployment{
+		{
+			ID:          github.Ptr(int64(2)),
+			SHA:         github.Ptr("abc123"),
+			Ref:         github.Ptr("main"),
+			Task:        github.Ptr("deploy"),
+			Environment: github.Ptr("production"),
+			Creator:     &github.User{Login: github.Ptr("octocat")},
+			CreatedAt:   &createdAt,
+		},
+		{
+			ID:          github.Ptr(int64(1)),
+			SHA:         github.Ptr("abc123"),
+			Ref:         github.Ptr("main"),
+			Environment: github.Ptr("production"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       []MinimalDeployment
+	}{
+		{
+			name: "lists deployments with their latest state",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"environment": "production",
+					"ref":         "main",
+					"page":        "1",
+					"per_page":    "30",
+				}).andThen(mockResponse(t, http.StatusOK, mockDeployments)),
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+					if r.URL.Path == "/repos/owner/repo/deployments/2/statuses" {
+						mockResponse(t, http.StatusOK, []*github.DeploymentStatus{{State: github.Ptr("success")}})(w, r)
+						return
+					}
+					mockResponse(t, http.StatusOK, []*github.DeploymentStatus{})(w, r)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"environment":   "production",
+				"ref":           "main",
+				"include_state": true,
+			},
+			expected: []MinimalDeployment{
+				{
+					ID:          2,
+					SHA:         "abc123",
+					Ref:         "main",
+					Task:        "deploy",
+					Environment: "production",
+					State:       "success",
+					Creator:     "octocat",
+					CreatedAt:   "2026-05-01T12:00:00Z",
+				},
+				{
+					ID:          1,
+					SHA:         "abc123",
+					Ref:         "main",
+					Environment: "production",
+				},
+			},
+		},
+		{
+			name: "skips status lookups unless include_state is set",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo: mockResponse(t, http.StatusOK, mockDeployments[1:]),
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: func(w http.ResponseWriter, _ *http.Request) {
+					t.Error("unexpected deployment status lookup")
+					w.WriteHeader(http.StatusInternalServerError)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expected: []MinimalDeployment{
+				{
+					ID:          1,
+					SHA:         "abc123",
+					Ref:         "main",
+					Environment: "production",
+				},
+			},
+		},
+		{
+			name: "status lookup fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo:                       mockResponse(t, http.StatusOK, mockDeployments),
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+			}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"include_state": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list statuses for deployment 2",
+		},
+		{
+			name: "list deployments fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsByOwnerByRepo: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list deployments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response []MinimalDeployment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_GetDeploymentStatus(t *testing.T) {
+	serverTool := GetDeploymentStatus(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "get_deployment_status", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "deployment_id"})
+
+	mockStatuses := []*github.DeploymentStatus{
+		{
+			ID:             github.Ptr(int64(11)),
+			State:          github.Ptr("success"),
+			Environment:    github.Ptr("production"),
+			EnvironmentURL: github.Ptr("https://example.com"),
+			LogURL:         github.Ptr("https://example.com/logs"),
+			Creator:        &github.User{Login: github.Ptr("deploy-bot")},
+		},
+		{
+			ID:    github.Ptr(int64(10)),
+			State: github.Ptr("in_progress"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       []MinimalDeploymentStatus
+	}{
+		{
+			name: "returns statuses newest first",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: mockResponse(t, http.StatusOK, mockStatuses),
+			}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(42),
+			},
+			expected: []MinimalDeploymentStatus{
+				{
+					ID:             11,
+					State:          "success",
+					Environment:    "production",
+					EnvironmentURL: "https://example.com",
+					LogURL:         "https://example.com/logs",
+					Creator:        "deploy-bot",
+				},
+				{
+					ID:    10,
+					State: "in_progress",
+				},
+			},
+		},
+		{
+			name:         "missing deployment_id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: deployment_id",
+		},
+		{
+			name: "deployment not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list statuses for deployment 42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response []MinimalDeploymentStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_CreateDeployment(t *testing.T) {
+	serverTool := CreateDeployment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "create_deployment", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "required_contexts")
+	assert.Contains(t, schema.Properties, "auto_merge")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "ref"})
+	assert.Equal(t, []string{"repo_deployment"}, serverTool.RequiredScopes)
+
+	mockDeployment := &github.Deployment{
+		ID:          github.Ptr(int64(42)),
+		SHA:         github.Ptr("abc123"),
+		Ref:         github.Ptr("main"),
+		Environment: github.Ptr("staging"),
+		Creator:     &github.User{Login: github.Ptr("octocat")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+		expected       MinimalDeployment
+	}{
+		{
+			name: "creates a deployment skipping status checks",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"ref":               "main",
+					"environment":       "staging",
+					"required_contexts": []any{},
+					"auto_merge":        false,
+				}).andThen(mockResponse(t, http.StatusCreated, mockDeployment)),
+			}),
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"ref":               "main",
+				"environment":       "staging",
+				"required_contexts": []any{},
+				"auto_merge":        false,
+			},
+			expected: MinimalDeployment{
+				ID:          42,
+				SHA:         "abc123",
+				Ref:         "main",
+				Environment: "staging",
+				Creator:     "octocat",
+			},
+		},
+		{
+			name: "omitted required_contexts are not sent",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"ref": "main",
+				}).andThen(mockResponse(t, http.StatusCreated, mockDeployment)),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
+			},
+			expected: MinimalDeployment{
+				ID:          42,
+				SHA:         "abc123",
+				Ref:         "main",
+				Environment: "staging",
+				Creator:     "octocat",
+			},
+		},
+		{
+			name: "auto-merge instead of deployment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsByOwnerByRepo: mockResponse(t, http.StatusAccepted, map[string]string{
+					"message": "Auto-merged main into topic on deployment.",
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "topic",
+			},
+			expectedText: "The default branch was merged into topic because it was behind; no deployment was created.",
+		},
+		{
+			name: "failed status checks",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsByOwnerByRepo: mockResponse(t, http.StatusConflict, map[string]string{
+					"message": "Conflict: Commit status checks failed for main.",
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create deployment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			if tc.expectedText != "" {
+				assert.Contains(t, textContent.Text, tc.expectedText)
+				return
+			}
+			var response MinimalDeployment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_CreateDeploymentStatus(t *testing.T) {
+	serverTool := CreateDeploymentStatus(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "create_deployment_status", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "log_url")
+	assert.Contains(t, schema.Properties, "environment_url")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "deployment_id", "state"})
+	assert.Equal(t, []string{"repo_deployment"}, serverTool.RequiredScopes)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       MinimalDeploymentStatus
+	}{
+		{
+			name: "reports success with links",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposDeploymentsStatusesByOwnerByRepoByDeploymentID: expectRequestBody(t, map[string]any{
+					"state":           "success",
+					"log_url":         "https://ci.example.com/42",
+					"environment_url": "https://staging.example.com",
+				}).andThen(mockResponse(t, http.StatusCreated, &github.DeploymentStatus{
+					ID:             github.Ptr(int64(7)),
+					State:          github.Ptr("success"),
+					LogURL:         github.Ptr("https://ci.example.com/42"),
+					EnvironmentURL: github.Ptr("https://staging.example.com"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"deployment_id":   float64(42),
+				"state":           "success",
+				"log_url":         "https://ci.example.com/42",
+				"environment_url": "https://staging.example.com",
+			},
+			expected: MinimalDeploymentStatus{
+				ID:             7,
+				State:          "success",
+				LogURL:         "https://ci.example.com/42",
+				EnvironmentURL: "https://staging.example.com",
+			},
+		},
+		{
+			name:         "invalid state",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(42),
+				"state":         "done",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid state "done"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response MinimalDeploymentStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ToolsetCatalog is the server state reported by describe_toolset.
+type ToolsetCatalog struct {
+	// Inventory is the server's tool inventory. The server fills it in once
+	// the inventory is built, which happens after this tool is created.
+	Inventory *inventory.Inventory
+	// GHESVersion is the detected GitHub Enterprise Server version. Empty
+	// for other hosts or when detection failed.
+	GHESVersion string
+	// GHESToolFiltering reports whether tools unsupported on GHESVersion
+	// were removed from the inventory.
+	GHESToolFiltering bool
+}
+
+// ToolsetDescription is the output of describe_toolset.
+type ToolsetDescription struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Enabled     bool     `json:"enabled"`
+	Tools       []string `json:"tools"`
+	// UnsupportedReason explains why the toolset does not work on the
+	// detected GitHub Enterprise Server version.
+	UnsupportedReason string `json:"unsupported_reason,omitempty"`
+	// RemovedTools lists the tools that were hidden for UnsupportedReason.
+	RemovedTools []string `json:"removed_tools,omitempty"`
+}
+
+// DescribeToolsetTool creates a tool that reports a toolset's description,
+// whether it is enabled and which of its tools are available, including the
+// tools hidden because the GitHub host does not support them. It is
+// registered by the local server, which owns the inventory, rather than
+// through AllTools.
+func DescribeToolsetTool(t translations.TranslationHelperFunc, catalog *ToolsetCatalog) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "describe_toolset",
+			Description: t("TOOL_DESCRIBE_TOOLSET_DESCRIPTION",
+				"Describe a toolset: what it is for, whether it is enabled, and which of its tools are available in this session. "+
+					"Use this to find out why an expected tool is missing, for example because the GitHub Enterprise Server version does not support it."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DESCRIBE_TOOLSET_USER_TITLE", "Describe toolset"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"toolset": {
+						Type:        "string",
+						Description: "Toolset ID, such as 'repos' or 'discussions'",
+					},
+				},
+				Required: []string{"toolset"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			toolsetID, err := RequiredParam[string](args, "toolset")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if catalog.Inventory == nil {
+				return utils.NewToolResultError("toolset information is not available yet"), nil, nil
+			}
+
+			description, ok := describeToolset(ctx, catalog, inventory.ToolsetID(toolsetID))
+			if !ok {
+				var ids []string
+				for _, toolset := range catalog.Inventory.AvailableToolsets() {
+					ids = append(ids, string(toolset.ID))
+				}
+				return utils.NewToolResultError(fmt.Sprintf("unknown toolset %q; available toolsets: %s", toolsetID, strings.Join(ids, ", "))), nil, nil
+			}
+
+			return attachStaticIFCLabel(ctx, deps, MarshalledTextResult(description), ifc.PublicTrusted()), nil, nil
+		},
+	)
+}
+
+func describeToolset(ctx context.Context, catalog *ToolsetCatalog, id inventory.ToolsetID) (ToolsetDescription, bool) {
+	inv := catalog.Inventory
+	var description ToolsetDescription
+	found := false
+	for _, toolset := range inv.AvailableToolsets() {
+		if toolset.ID == id {
+			description = ToolsetDescription{ID: string(id), Description: toolset.Description, Tools: []string{}}
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ToolsetDescription{}, false
+	}
+
+	for _, toolset := range inv.EnabledToolsets() {
+		if toolset.ID == id {
+			description.Enabled = true
+			break
+		}
+	}
+	for _, tool := range inv.AvailableTools(ctx) {
+		if tool.Toolset.ID == id {
+			description.Tools = append(description.Tools, inv.ToolNamePrefix()+tool.Tool.Name)
+		}
+	}
+
+	if catalog.GHESVersion == "" {
+		return description, true
+	}
+	description.UnsupportedReason = GHESToolsetUnsupportedReason(id, catalog.GHESVersion)
+	if description.UnsupportedReason != "" && catalog.GHESToolFiltering {
+		for _, tool := range inv.AllTools() {
+			if tool.Toolset.ID == id {
+				description.RemovedTools = append(description.RemovedTools, inv.ToolNamePrefix()+tool.Tool.Name)
+			}
+		}
+	}
+	return description, true
+}
@@ -0,0 +1,103 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DescribeToolset(t *testing.T) {
+	serverTool := DescribeToolsetTool(translations.NullTranslationHelper, &ToolsetCatalog{})
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "describe_toolset", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, ToolsetMetadataContext.ID, serverTool.Toolset.ID)
+
+	describe := func(t *testing.T, catalog *ToolsetCatalog, toolset string) ToolsetDescription {
+		t.Helper()
+		st := DescribeToolsetTool(translations.NullTranslationHelper, catalog)
+		deps := BaseDeps{}
+		request := createMCPRequest(map[string]any{"toolset": toolset})
+		result, err := st.Handler(deps)(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var description ToolsetDescription
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &description))
+		return description
+	}
+
+	t.Run("enabled toolset lists its tools", func(t *testing.T) {
+		inv, err := NewInventory(translations.NullTranslationHelper).
+			WithToolsets([]string{"discussions"}).
+			Build()
+		require.NoError(t, err)
+
+		description := describe(t, &ToolsetCatalog{Inventory: inv}, "discussions")
+		assert.Equal(t, "discussions", description.ID)
+		assert.Equal(t, ToolsetMetadataDiscussions.Description, description.Description)
+		assert.True(t, description.Enabled)
+		assert.Contains(t, description.Tools, "list_discussions")
+		assert.Empty(t, description.UnsupportedReason)
+		assert.Empty(t, description.RemovedTools)
+	})
+
+	t.Run("disabled toolset has no tools", func(t *testing.T) {
+		inv, err := NewInventory(translations.NullTranslationHelper).
+			WithToolsets([]string{"repos"}).
+			Build()
+		require.NoError(t, err)
+
+		description := describe(t, &ToolsetCatalog{Inventory: inv}, "discussions")
+		assert.False(t, description.Enabled)
+		assert.Empty(t, description.Tools)
+	})
+
+	t.Run("tools hidden on an unsupported GHES version are reported", func(t *testing.T) {
+		inv, err := NewInventory(translations.NullTranslationHelper).
+			WithToolsets([]string{"discussions"}).
+			WithFilter(CreateGHESToolFilter("3.5.4")).
+			Build()
+		require.NoError(t, err)
+
+		description := describe(t, &ToolsetCatalog{Inventory: inv, GHESVersion: "3.5.4", GHESToolFiltering: true}, "discussions")
+		assert.True(t, description.Enabled)
+		assert.Empty(t, description.Tools)
+		assert.Equal(t, "requires GitHub Enterprise Server 3.6 or later (3.5.4 is installed)", description.UnsupportedReason)
+		assert.Contains(t, description.RemovedTools, "list_discussions")
+	})
+
+	t.Run("unsupported toolset without filtering keeps its tools", func(t *testing.T) {
+		inv, err := NewInventory(translations.NullTranslationHelper).
+			WithToolsets([]string{"discussions"}).
+			Build()
+		require.NoError(t, err)
+
+		description := describe(t, &ToolsetCatalog{Inventory: inv, GHESVersion: "3.5.4"}, "discussions")
+		assert.Contains(t, description.Tools, "list_discussions")
+		assert.NotEmpty(t, description.UnsupportedReason)
+		assert.Empty(t, description.RemovedTools)
+	})
+
+	t.Run("unknown toolset", func(t *testing.T) {
+		inv, err := NewInventory(translations.NullTranslationHelper).Build()
+		require.NoError(t, err)
+
+		st := DescribeToolsetTool(translations.NullTranslationHelper, &ToolsetCatalog{Inventory: inv})
+		deps := BaseDeps{}
+		request := createMCPRequest(map[string]any{"toolset": "nope"})
+		result, err := st.Handler(deps)(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		errorText := getErrorResult(t, result).Text
+		assert.Contains(t, errorText, `unknown toolset "nope"`)
+		assert.Contains(t, errorText, "discussions")
+	})
+}
@@ -196,7 +196,7 @@ func ListDiscussions(t translations.TranslationHelperFunc) inventory.ServerTool
 			}
 
 			// Get pagination parameters and convert to GraphQL format
-			pagination, err := OptionalCursorPaginationParams(args)
+			pagination, err := OptionalCursorPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return nil, nil, err
 			}
@@ -441,7 +441,7 @@ func GetDiscussionComments(t translations.TranslationHelperFunc) inventory.Serve
 			}
 
 			// Get pagination parameters and convert to GraphQL format
-			pagination, err := OptionalCursorPaginationParams(args)
+			pagination, err := OptionalCursorPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return nil, nil, err
 			}
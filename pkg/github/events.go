@@ -0,0 +1,180 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// UserEventsResponse is the output of list_user_events.
+type UserEventsResponse struct {
+	Events []MinimalEvent `json:"events"`
+	// PublicOnly reports whether only public events were requested from GitHub.
+	PublicOnly bool `json:"public_only"`
+	// Note explains why private events were not included when they were asked for.
+	Note      string `json:"note,omitempty"`
+	NextPage  int    `json:"nextPage,omitempty"`
+	PrevPage  int    `json:"prevPage,omitempty"`
+	FirstPage int    `json:"firstPage,omitempty"`
+	LastPage  int    `json:"lastPage,omitempty"`
+}
+
+// ListUserEvents creates a tool to list the recent activity of a user or organization.
+func ListUserEvents(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataUsers,
+		mcp.Tool{
+			Name: "list_user_events",
+			Description: t("TOOL_LIST_USER_EVENTS_DESCRIPTION",
+				"List recent activity events (pushes, issues, pull requests, reviews, releases, etc.) performed by a GitHub user, or the public events of an organization. "+
+					"Returns the event type, repository, and creation time, newest first. GitHub only keeps events from the past 90 days. "+
+					"Private events are only returned when the target is the authenticated user; for anyone else only public events are listed."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_USER_EVENTS_USER_TITLE", "List user events"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "GitHub username whose events to list. Defaults to the authenticated user. Cannot be combined with 'org'.",
+					},
+					"org": {
+						Type:        "string",
+						Description: "Organization whose public events to list. Cannot be combined with 'username'.",
+					},
+					"public_only": {
+						Type:        "boolean",
+						Description: "Only list public events. Ignored for organizations, whose feed is always public.",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			username, err := OptionalParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			org, err := OptionalParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			publicOnly, err := OptionalParam[bool](args, "public_only")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if username != "" && org != "" {
+				return utils.NewToolResultError("only one of 'username' or 'org' may be provided"), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			var response UserEventsResponse
+			var events []*github.Event
+			var resp *github.Response
+			if org != "" {
+				response.PublicOnly = true
+				events, resp, err = client.Activity.ListEventsForOrganization(ctx, org, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list events for organization %s", org),
+						resp,
+						err,
+					), nil, nil
+				}
+			} else {
+				// Private events are only served to the user they belong to, so the
+				// authenticated login is needed both as the default target and to
+				// decide whether private events can be included at all.
+				if username == "" || !publicOnly {
+					me, meResp, err := client.Users.Get(ctx, "")
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							"failed to get authenticated user",
+							meResp,
+							err,
+						), nil, nil
+					}
+					_ = meResp.Body.Close()
+
+					if username == "" {
+						username = me.GetLogin()
+					} else if !publicOnly && !strings.EqualFold(username, me.GetLogin()) {
+						publicOnly = true
+						response.Note = fmt.Sprintf("Private events are only available for the authenticated user; listing public events for %s.", username)
+					}
+				}
+
+				response.PublicOnly = publicOnly
+				events, resp, err = client.Activity.ListEventsPerformedByUser(ctx, username, publicOnly, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list events for user %s", username),
+						resp,
+						err,
+					), nil, nil
+				}
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list events", resp, body), nil, nil
+			}
+
+			allPublic := true
+			response.Events = make([]MinimalEvent, 0, len(events))
+			for _, event := range events {
+				minimalEvent := convertToMinimalEvent(event)
+				allPublic = allPublic && minimalEvent.Public
+				response.Events = append(response.Events, minimalEvent)
+			}
+			response.NextPage = resp.NextPage
+			response.PrevPage = resp.PrevPage
+			response.FirstPage = resp.FirstPage
+			response.LastPage = resp.LastPage
+
+			result := MarshalledTextResult(response)
+			// Events point at activity anyone may have triggered in the target's
+			// repositories, so they are untrusted. A feed that includes private
+			// events is only visible to the authenticated user.
+			label := ifc.PublicUntrusted()
+			if !allPublic {
+				label = ifc.PrivateUntrusted()
+			}
+			result = attachStaticIFCLabel(ctx, deps, result, label)
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,153 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListUserEvents(t *testing.T) {
+	serverTool := ListUserEvents(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "list_user_events", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	for _, key := range []string{"username", "org", "public_only", "page", "perPage"} {
+		assert.Contains(t, schema.Properties, key)
+	}
+	assert.Empty(t, schema.Required)
+
+	createdAt := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	pushEvent := &github.Event{
+		ID:        github.Ptr("1"),
+		Type:      github.Ptr("PushEvent"),
+		Public:    github.Ptr(true),
+		Actor:     &github.User{Login: github.Ptr("octocat")},
+		Repo:      &github.Repository{Name: github.Ptr("octocat/hello-world")},
+		CreatedAt: &github.Timestamp{Time: createdAt},
+	}
+	privateEvent := &github.Event{
+		ID:        github.Ptr("2"),
+		Type:      github.Ptr("IssuesEvent"),
+		Public:    github.Ptr(false),
+		Actor:     &github.User{Login: github.Ptr("octocat")},
+		Repo:      &github.Repository{Name: github.Ptr("octocat/secret")},
+		CreatedAt: &github.Timestamp{Time: createdAt},
+	}
+	me := &github.User{Login: github.Ptr("octocat")}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       UserEventsResponse
+	}{
+		{
+			name: "defaults to the authenticated user including private events",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser:                  mockResponse(t, http.StatusOK, me),
+				GetUsersEventsByUsername: mockResponse(t, http.StatusOK, []*github.Event{pushEvent, privateEvent}),
+			}),
+			requestArgs: map[string]any{},
+			expected: UserEventsResponse{
+				Events: []MinimalEvent{
+					{ID: "1", Type: "PushEvent", Actor: "octocat", Repo: "octocat/hello-world", Public: true, CreatedAt: "2024-05-01T09:30:00Z"},
+					{ID: "2", Type: "IssuesEvent", Actor: "octocat", Repo: "octocat/secret", Public: false, CreatedAt: "2024-05-01T09:30:00Z"},
+				},
+			},
+		},
+		{
+			name: "falls back to public events for another user",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUser:                        mockResponse(t, http.StatusOK, me),
+				GetUsersEventsPublicByUsername: mockResponse(t, http.StatusOK, []*github.Event{pushEvent}),
+			}),
+			requestArgs: map[string]any{"username": "monalisa"},
+			expected: UserEventsResponse{
+				Events: []MinimalEvent{
+					{ID: "1", Type: "PushEvent", Actor: "octocat", Repo: "octocat/hello-world", Public: true, CreatedAt: "2024-05-01T09:30:00Z"},
+				},
+				PublicOnly: true,
+				Note:       "Private events are only available for the authenticated user; listing public events for monalisa.",
+			},
+		},
+		{
+			name: "public only does not look up the authenticated user",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUsersEventsPublicByUsername: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "5",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.Event{})),
+			}),
+			requestArgs: map[string]any{"username": "monalisa", "public_only": true, "page": float64(2), "perPage": float64(5)},
+			expected:    UserEventsResponse{Events: []MinimalEvent{}, PublicOnly: true},
+		},
+		{
+			name: "organization events",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsEventsByOrg: mockResponse(t, http.StatusOK, []*github.Event{pushEvent}),
+			}),
+			requestArgs: map[string]any{"org": "octo-org"},
+			expected: UserEventsResponse{
+				Events: []MinimalEvent{
+					{ID: "1", Type: "PushEvent", Actor: "octocat", Repo: "octocat/hello-world", Public: true, CreatedAt: "2024-05-01T09:30:00Z"},
+				},
+				PublicOnly: true,
+			},
+		},
+		{
+			name:           "username and org are mutually exclusive",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"username": "octocat", "org": "octo-org"},
+			expectError:    true,
+			expectedErrMsg: "only one of 'username' or 'org' may be provided",
+		},
+		{
+			name: "user not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUsersEventsPublicByUsername: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			}),
+			requestArgs:    map[string]any{"username": "ghost", "public_only": true},
+			expectError:    true,
+			expectedErrMsg: "failed to list events for user ghost",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response UserEventsResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -35,6 +35,12 @@ const FeatureFlagIssueDependencies = "issue_dependencies"
 // a redeploy.
 const FeatureFlagFieldsParam = "fields_param"
 
+// FeatureFlagGHESToolFiltering is the feature flag name for hiding toolsets
+// that the detected GitHub Enterprise Server version does not support. Without
+// it the local server only warns about them at startup, and their tools fail
+// with 404s when called. describe_toolset reports the hidden tools either way.
+const FeatureFlagGHESToolFiltering = "ghes_tool_filtering"
+
 // AllowedFeatureFlags is the allowlist of feature flags that can be enabled
 // by users via --features CLI flag or X-MCP-Features HTTP header.
 // Only flags in this list are accepted; unknown flags are silently ignored.
@@ -46,9 +52,11 @@ var AllowedFeatureFlags = []string{
 	FeatureFlagIFCLabels,
 	FeatureFlagIssuesGranular,
 	FeatureFlagPullRequestsGranular,
+	FeatureFlagLabelsGranular,
 	FeatureFlagFileBlame,
 	FeatureFlagIssueDependencies,
 	FeatureFlagFieldsParam,
+	FeatureFlagGHESToolFiltering,
 }
 
 // InsidersFeatureFlags is the list of feature flags that insiders mode enables.
@@ -101,6 +101,8 @@ func TestHelloWorld_ConditionalBehavior_Featureflag(t *testing.T) {
 				translations.NullTranslationHelper,
 				FeatureFlags{},
 				0,
+				false,
+				0,
 				featureCheckerFor(enabledFlags...),
 				stubExporters(),
 			)
@@ -0,0 +1,39 @@
+package github
+
+import (
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// fieldPathsSchemaProperty builds the optional `fields` parameter of read tools
+// that project their JSON response with utils.ProjectJSONFields. Unlike
+// fieldsSchemaProperty it accepts free-form dot paths rather than an enum.
+func fieldPathsSchemaProperty() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "array",
+		Description: "Subset of fields to return, as dot-separated paths (e.g. [\"number\", \"title\", \"user.login\"]). Paths through arrays apply to each element (e.g. \"labels.name\"). If omitted, all fields are returned. Ignored for responses that are not JSON, such as diffs.",
+		Items: &jsonschema.Schema{
+			Type: "string",
+		},
+	}
+}
+
+// projectResultFields trims a successful JSON text result down to the requested
+// dot-path fields. Error results, empty field lists and non-JSON text are
+// returned unchanged.
+func projectResultFields(result *mcp.CallToolResult, fields []string) *mcp.CallToolResult {
+	if result == nil || result.IsError || len(fields) == 0 || len(result.Content) != 1 {
+		return result
+	}
+	text, ok := result.Content[0].(*mcp.TextContent)
+	if !ok {
+		return result
+	}
+	projected, err := utils.ProjectJSONFields([]byte(text.Text), fields)
+	if err != nil {
+		return result
+	}
+	text.Text = string(projected)
+	return result
+}
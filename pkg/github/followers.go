@@ -0,0 +1,259 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ListFollowers creates a tool to list the followers of a user.
+func ListFollowers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataFollowers,
+		mcp.Tool{
+			Name:        "list_followers",
+			Description: t("TOOL_LIST_FOLLOWERS_DESCRIPTION", "List the logins of users who follow a GitHub user. Results are paginated; the response includes `nextPage`, `prevPage`, `firstPage`, and `lastPage` fields. To get the next page, use the `nextPage` value as the `page` parameter."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_FOLLOWERS_USER_TITLE", "List followers"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "GitHub username (omit for the authenticated user's followers)",
+					},
+				},
+			}),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			return listFollowUsers(ctx, deps, args, "followers", func(client *github.Client, username string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+				return client.Users.ListFollowers(ctx, username, opts)
+			})
+		},
+	)
+}
+
+// ListFollowing creates a tool to list the users a user follows.
+func ListFollowing(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataFollowers,
+		mcp.Tool{
+			Name:        "list_following",
+			Description: t("TOOL_LIST_FOLLOWING_DESCRIPTION", "List the logins of users a GitHub user follows. Results are paginated; the response includes `nextPage`, `prevPage`, `firstPage`, and `lastPage` fields. To get the next page, use the `nextPage` value as the `page` parameter."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_FOLLOWING_USER_TITLE", "List followed users"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "GitHub username (omit for the users the authenticated user follows)",
+					},
+				},
+			}),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			return listFollowUsers(ctx, deps, args, "followed users", func(client *github.Client, username string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+				return client.Users.ListFollowing(ctx, username, opts)
+			})
+		},
+	)
+}
+
+// listFollowUsers implements list_followers and list_following, which differ
+// only in the endpoint they call. An empty username lists for the
+// authenticated user.
+func listFollowUsers(
+	ctx context.Context,
+	deps ToolDependencies,
+	args map[string]any,
+	what string,
+	list func(client *github.Client, username string, opts *github.ListOptions) ([]*github.User, *github.Response, error),
+) (*mcp.CallToolResult, any, error) {
+	username, err := OptionalParam[string](args, "username")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+
+	users, resp, err := list(client, username, &github.ListOptions{
+		Page:    pagination.Page,
+		PerPage: pagination.PerPage,
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx,
+			fmt.Sprintf("failed to list %s", what),
+			resp,
+			err,
+		), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to list %s", what), resp, body), nil, nil
+	}
+
+	logins := make([]string, 0, len(users))
+	for _, u := range users {
+		logins = append(logins, u.GetLogin())
+	}
+
+	response := map[string]any{
+		"items":     logins,
+		"nextPage":  resp.NextPage,
+		"prevPage":  resp.PrevPage,
+		"firstPage": resp.FirstPage,
+		"lastPage":  resp.LastPage,
+	}
+
+	callResult := MarshalledTextResult(response)
+	// Follower relationships are public, and anyone can follow anyone, so
+	// the logins are outsider-controlled.
+	callResult = attachStaticIFCLabel(ctx, deps, callResult, ifc.PublicUntrusted())
+	return callResult, nil, nil
+}
+
+// FollowUser creates a tool to follow a user as the authenticated user.
+func FollowUser(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataFollowers,
+		mcp.Tool{
+			Name:        "follow_user",
+			Description: t("TOOL_FOLLOW_USER_DESCRIPTION", "Follow a GitHub user as the authenticated user"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:          t("TOOL_FOLLOW_USER_USER_TITLE", "Follow user"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "GitHub username of the user to follow",
+					},
+				},
+				Required: []string{"username"},
+			},
+		},
+		[]scopes.Scope{scopes.UserFollow},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Users.Follow(ctx, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to follow user %s", username),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to follow user", resp, body), nil, nil
+			}
+
+			return utils.NewToolResultText(fmt.Sprintf("Successfully followed user %s", username)), nil, nil
+		},
+	)
+}
+
+// UnfollowUser creates a tool to unfollow a user as the authenticated user.
+func UnfollowUser(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataFollowers,
+		mcp.Tool{
+			Name:        "unfollow_user",
+			Description: t("TOOL_UNFOLLOW_USER_DESCRIPTION", "Unfollow a GitHub user as the authenticated user"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:          t("TOOL_UNFOLLOW_USER_USER_TITLE", "Unfollow user"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "GitHub username of the user to unfollow",
+					},
+				},
+				Required: []string{"username"},
+			},
+		},
+		[]scopes.Scope{scopes.UserFollow},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Users.Unfollow(ctx, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to unfollow user %s", username),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to unfollow user", resp, body), nil, nil
+			}
+
+			return utils.NewToolResultText(fmt.Sprintf("Successfully unfollowed user %s", username)), nil, nil
+		},
+	)
+}
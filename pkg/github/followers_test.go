@@ -0,0 +1,212 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListFollowers(t *testing.T) {
+	serverTool := ListFollowers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "list_followers", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "username")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.Empty(t, schema.Required)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedLogins []string
+	}{
+		{
+			name: "lists the authenticated user's followers",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserFollowers: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.User{
+					{Login: github.Ptr("hubot")},
+					{Login: github.Ptr("monalisa")},
+				})),
+			}),
+			requestArgs: map[string]any{
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedLogins: []string{"hubot", "monalisa"},
+		},
+		{
+			name: "API error",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserFollowers: mockResponse(t, http.StatusUnauthorized, map[string]string{"message": "Requires authentication"}),
+			}),
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "failed to list followers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response struct {
+				Items []string `json:"items"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedLogins, response.Items)
+		})
+	}
+}
+
+func Test_ListFollowing(t *testing.T) {
+	serverTool := ListFollowing(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "list_following", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUsersFollowingByUsername: mockResponse(t, http.StatusOK, []*github.User{
+			{Login: github.Ptr("octocat")},
+		}),
+	})
+	client := mustNewGHClient(t, mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"username": "monalisa"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response struct {
+		Items []string `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []string{"octocat"}, response.Items)
+}
+
+func Test_FollowUser(t *testing.T) {
+	serverTool := FollowUser(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "follow_user", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"username"})
+	assert.Equal(t, []string{"user:follow"}, serverTool.RequiredScopes)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "follows a user",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutUserFollowingByUsername: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			}),
+			requestArgs: map[string]any{"username": "octocat"},
+		},
+		{
+			name:           "missing username",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: username",
+		},
+		{
+			name: "user not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutUserFollowingByUsername: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			}),
+			requestArgs:    map[string]any{"username": "ghost"},
+			expectError:    true,
+			expectedErrMsg: "failed to follow user ghost",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "Successfully followed user octocat")
+		})
+	}
+}
+
+func Test_UnfollowUser(t *testing.T) {
+	serverTool := UnfollowUser(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "unfollow_user", tool.Name)
+	assert.Equal(t, []string{"user:follow"}, serverTool.RequiredScopes)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		DeleteUserFollowingByUsername: func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	client := mustNewGHClient(t, mockedClient)
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"username": "octocat"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "Successfully unfollowed user octocat")
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+)
+
+// ghesToolsetMinVersions lists toolsets that GitHub Enterprise Server lacks
+// entirely (an empty version) or only has from the given major.minor version.
+// Toolsets not listed are assumed to work on every supported GHES version.
+// The projects tools need the Projects v2 REST API, which arrived well after
+// the Projects v2 GraphQL schema.
+var ghesToolsetMinVersions = map[inventory.ToolsetID]string{
+	"copilot":                    "",
+	"copilot_issue_intents":      "",
+	"copilot_spaces":             "",
+	"code_quality":               "",
+	"github_support_docs_search": "",
+	"discussions":                "3.6",
+	"projects":                   "3.19",
+}
+
+// GHESToolsetUnsupportedReason explains why the toolset does not work on the
+// given GitHub Enterprise Server version. It returns "" when the toolset is
+// supported.
+func GHESToolsetUnsupportedReason(toolsetID inventory.ToolsetID, ghesVersion string) string {
+	minVersion, ok := ghesToolsetMinVersions[toolsetID]
+	switch {
+	case !ok:
+		return ""
+	case minVersion == "":
+		return "not available on GitHub Enterprise Server"
+	case CompareGHESVersions(ghesVersion, minVersion) < 0:
+		return fmt.Sprintf("requires GitHub Enterprise Server %s or later (%s is installed)", minVersion, ghesVersion)
+	default:
+		return ""
+	}
+}
+
+// CreateGHESToolFilter creates a ToolFilter that excludes tools whose toolset
+// does not work on the given GitHub Enterprise Server version, so they are
+// never advertised instead of failing with 404s at call time.
+func CreateGHESToolFilter(ghesVersion string) inventory.ToolFilter {
+	return func(_ context.Context, tool *inventory.ServerTool) (bool, error) {
+		return GHESToolsetUnsupportedReason(tool.Toolset.ID, ghesVersion) == "", nil
+	}
+}
+
+// CompareGHESVersions compares the major.minor parts of two GitHub Enterprise
+// Server versions, such as "3.14.2" and "3.6", returning -1, 0 or 1.
+// Unparseable parts count as zero.
+func CompareGHESVersions(a, b string) int {
+	pa, pb := ghesMajorMinor(a), ghesMajorMinor(b)
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1
+		case pa[i] > pb[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+func ghesMajorMinor(version string) [2]int {
+	var parts [2]int
+	fields := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	for i := 0; i < len(parts) && i < len(fields); i++ {
+		parts[i], _ = strconv.Atoi(fields[i])
+	}
+	return parts
+}
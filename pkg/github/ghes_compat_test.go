@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGHESToolsetUnsupportedReason(t *testing.T) {
+	assert.Empty(t, GHESToolsetUnsupportedReason("repos", "3.5.0"))
+	assert.Empty(t, GHESToolsetUnsupportedReason("discussions", "3.6.0"))
+	assert.Equal(t, "requires GitHub Enterprise Server 3.6 or later (3.5.4 is installed)",
+		GHESToolsetUnsupportedReason("discussions", "3.5.4"))
+	assert.Equal(t, "not available on GitHub Enterprise Server",
+		GHESToolsetUnsupportedReason("copilot", "3.14.0"))
+	assert.Empty(t, GHESToolsetUnsupportedReason("projects", "3.19.1"))
+	assert.Equal(t, "requires GitHub Enterprise Server 3.19 or later (3.18.3 is installed)",
+		GHESToolsetUnsupportedReason("projects", "3.18.3"))
+}
+
+func TestCreateGHESToolFilter(t *testing.T) {
+	filter := CreateGHESToolFilter("3.5.4")
+
+	for _, tc := range []struct {
+		toolset  inventory.ToolsetMetadata
+		expected bool
+	}{
+		{toolset: ToolsetMetadataRepos, expected: true},
+		{toolset: ToolsetMetadataDiscussions, expected: false},
+		{toolset: ToolsetMetadataProjects, expected: false},
+	} {
+		allowed, err := filter(context.Background(), &inventory.ServerTool{Toolset: tc.toolset})
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, allowed, string(tc.toolset.ID))
+	}
+}
+
+func TestCompareGHESVersions(t *testing.T) {
+	assert.Equal(t, 0, CompareGHESVersions("3.6.0", "3.6"))
+	assert.Equal(t, -1, CompareGHESVersions("3.5.12", "3.6"))
+	assert.Equal(t, 1, CompareGHESVersions("3.14", "3.6"))
+	assert.Equal(t, 1, CompareGHESVersions("4.0", "3.19"))
+	assert.Equal(t, -1, CompareGHESVersions("unknown", "3.6"))
+}
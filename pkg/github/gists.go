@@ -55,7 +55,7 @@ func ListGists(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
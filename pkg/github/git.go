@@ -2,8 +2,12 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -37,6 +41,51 @@ type TreeResponse struct {
 	Repo      string              `json:"repo"`
 	Recursive bool                `json:"recursive"`
 	Count     int                 `json:"count"`
+	// MaxEntriesReached reports that more entries matched the filters than
+	// max_entries allowed, so Tree holds only the first max_entries of them.
+	MaxEntriesReached bool `json:"max_entries_reached,omitempty"`
+	// Warning explains how to work around an API-truncated tree. It is only
+	// set when Truncated is true.
+	Warning string `json:"warning,omitempty"`
+}
+
+// truncatedTreeWarning is returned alongside trees the Git Trees API cut short.
+const truncatedTreeWarning = "The GitHub API truncated this tree because it exceeds the limit of 100,000 entries or 7 MB. " +
+	"The listing is incomplete: fetch subdirectories individually by passing their tree SHA as tree_sha, or use recursive=false to walk the tree one level at a time."
+
+// globToRegexp compiles a path glob into an anchored regular expression.
+// '*' and '?' match within a single path segment, and '**' matches across
+// segments, so "**/*.go" matches Go files at any depth including the root.
+func globToRegexp(glob string) (*regexp.Regexp, error) {
+	return regexp.Compile("^" + globRegexpSource(glob) + "$")
+}
+
+// globRegexpSource translates a path glob into unanchored regular expression
+// source, for callers such as CODEOWNERS matching that add their own anchors.
+// A backslash makes the next character literal.
+func globRegexpSource(glob string) string {
+	var b strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case c == '\\' && i+1 < len(glob):
+			i++
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case c == '*' && strings.HasPrefix(glob[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case c == '*' && strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String()
 }
 
 // GetRepositoryTree creates a tool to get the tree structure of a GitHub repository.
@@ -44,8 +93,10 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 	return NewTool(
 		ToolsetMetadataGit,
 		mcp.Tool{
-			Name:        "get_repository_tree",
-			Description: t("TOOL_GET_REPOSITORY_TREE_DESCRIPTION", "Get the tree structure (files and directories) of a GitHub repository at a specific ref or SHA"),
+			Name: "get_repository_tree",
+			Description: t("TOOL_GET_REPOSITORY_TREE_DESCRIPTION", "Get the tree structure (files and directories) of a GitHub repository at a specific ref or SHA. "+
+				"By default only the top level of the tree is returned; set recursive=true to include every nested entry. "+
+				"Recursive listings are limited by the GitHub API to 100,000 entries (7 MB); larger trees are cut short and reported with 'truncated: true' and a 'warning'."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_GET_REPOSITORY_TREE_USER_TITLE", "Get repository tree"),
 				ReadOnlyHint: true,
@@ -67,13 +118,30 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 					},
 					"recursive": {
 						Type:        "boolean",
-						Description: "Setting this parameter to true returns the objects or subtrees referenced by the tree. Default is false",
+						Description: "Setting this parameter to true returns the objects or subtrees referenced by the tree. Default is false, which returns only the top-level entries (a shallow listing)",
 						Default:     json.RawMessage(`false`),
 					},
 					"path_filter": {
 						Type:        "string",
 						Description: "Optional path prefix to filter the tree results (e.g., 'src/' to only show files in the src directory)",
 					},
+					"path_glob": {
+						Type:        "string",
+						Description: "Optional glob matched against each entry's full path. '*' and '?' match within a path segment and '**' matches any number of directories (e.g., '**/*.go' or 'cmd/*/main.go')",
+					},
+					"files_only": {
+						Type:        "boolean",
+						Description: "Only return files (blobs). Cannot be combined with directories_only",
+					},
+					"directories_only": {
+						Type:        "boolean",
+						Description: "Only return directories (trees). Cannot be combined with files_only",
+					},
+					"max_entries": {
+						Type:        "number",
+						Description: "Maximum number of entries to return after filtering. When more entries match, 'max_entries_reached' is set to true",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
 				},
 				Required: []string{"owner", "repo"},
 			},
@@ -100,6 +168,38 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			pathGlob, err := OptionalParam[string](args, "path_glob")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			filesOnly, err := OptionalParam[bool](args, "files_only")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			directoriesOnly, err := OptionalParam[bool](args, "directories_only")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if filesOnly && directoriesOnly {
+				return utils.NewToolResultError("files_only and directories_only cannot both be true"), nil, nil
+			}
+			maxEntries, err := OptionalIntParam(args, "max_entries")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			// OptionalIntParam reads an absent max_entries as 0, which means
+			// no limit; an explicit 0 is rejected rather than read that way.
+			if _, ok := args["max_entries"]; ok && maxEntries < 1 {
+				return utils.NewToolResultError("max_entries must be >= 1"), nil, nil
+			}
+
+			var globRe *regexp.Regexp
+			if pathGlob != "" {
+				globRe, err = globToRegexp(pathGlob)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("invalid path_glob: %s", err)), nil, nil
+				}
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
@@ -130,16 +230,27 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Filter tree entries if path_filter is provided
+			// Filter tree entries by the requested path prefix, glob, and type
 			var filteredEntries []*github.TreeEntry
-			if pathFilter != "" {
-				for _, entry := range tree.Entries {
-					if strings.HasPrefix(entry.GetPath(), pathFilter) {
-						filteredEntries = append(filteredEntries, entry)
-					}
+			maxEntriesReached := false
+			for _, entry := range tree.Entries {
+				if pathFilter != "" && !strings.HasPrefix(entry.GetPath(), pathFilter) {
+					continue
 				}
-			} else {
-				filteredEntries = tree.Entries
+				if globRe != nil && !globRe.MatchString(entry.GetPath()) {
+					continue
+				}
+				if filesOnly && entry.GetType() != "blob" {
+					continue
+				}
+				if directoriesOnly && entry.GetType() != "tree" {
+					continue
+				}
+				if maxEntries > 0 && len(filteredEntries) == maxEntries {
+					maxEntriesReached = true
+					break
+				}
+				filteredEntries = append(filteredEntries, entry)
 			}
 
 			treeEntries := make([]TreeEntryResponse, len(filteredEntries))
@@ -157,14 +268,18 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 			}
 
 			response := TreeResponse{
-				SHA:       *tree.SHA,
-				Truncated: *tree.Truncated,
-				Tree:      treeEntries,
-				TreeSHA:   treeSHA,
-				Owner:     owner,
-				Repo:      repo,
-				Recursive: recursive,
-				Count:     len(filteredEntries),
+				SHA:               tree.GetSHA(),
+				Truncated:         tree.GetTruncated(),
+				Tree:              treeEntries,
+				TreeSHA:           treeSHA,
+				Owner:             owner,
+				Repo:              repo,
+				Recursive:         recursive,
+				Count:             len(filteredEntries),
+				MaxEntriesReached: maxEntriesReached,
+			}
+			if response.Truncated {
+				response.Warning = truncatedTreeWarning
 			}
 
 			r, err := json.Marshal(response)
@@ -182,3 +297,801 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 		},
 	)
 }
+
+// CommitFileChange is a single file addition, update, or deletion applied by
+// the create_commit tool.
+type CommitFileChange struct {
+	Path     string
+	Content  string
+	Encoding string
+	Delete   bool
+}
+
+// CreateCommitResponse is the response payload returned by the create_commit tool.
+type CreateCommitResponse struct {
+	SHA       string `json:"sha"`
+	HTMLURL   string `json:"html_url,omitempty"`
+	Branch    string `json:"branch"`
+	ParentSHA string `json:"parent_sha"`
+	TreeSHA   string `json:"tree_sha"`
+}
+
+// parseCommitFileChanges validates the raw "files" argument of create_commit.
+func parseCommitFileChanges(raw any) ([]CommitFileChange, error) {
+	items, ok := raw.([]any)
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("files must be a non-empty array of file change objects")
+	}
+
+	changes := make([]CommitFileChange, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("each file change must be an object")
+		}
+		path, err := RequiredParam[string](m, "path")
+		if err != nil {
+			return nil, fmt.Errorf("each file change must have a path")
+		}
+		content, err := OptionalParam[string](m, "content")
+		if err != nil {
+			return nil, fmt.Errorf("file %s: %w", path, err)
+		}
+		encoding, err := OptionalParam[string](m, "encoding")
+		if err != nil {
+			return nil, fmt.Errorf("file %s: %w", path, err)
+		}
+		del, err := OptionalParam[bool](m, "delete")
+		if err != nil {
+			return nil, fmt.Errorf("file %s: %w", path, err)
+		}
+		_, hasContent := m["content"]
+		switch {
+		case del && hasContent:
+			return nil, fmt.Errorf("file %s: content cannot be set when delete is true", path)
+		case !del && !hasContent:
+			return nil, fmt.Errorf("file %s: content is required unless delete is true", path)
+		}
+		switch encoding {
+		case "":
+			encoding = "utf-8"
+		case "utf-8", "base64":
+		default:
+			return nil, fmt.Errorf("file %s: encoding must be \"utf-8\" or \"base64\"", path)
+		}
+		changes = append(changes, CommitFileChange{
+			Path:     path,
+			Content:  content,
+			Encoding: encoding,
+			Delete:   del,
+		})
+	}
+	return changes, nil
+}
+
+// CreateCommit creates a tool that commits several file changes at once
+// using the Git Data API (blobs, tree, commit, ref update).
+func CreateCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name: "create_commit",
+			Description: t("TOOL_CREATE_COMMIT_DESCRIPTION",
+				"Create a single commit that adds, updates, and deletes any number of files, then move a branch to it. "+
+					"The branch only moves if the commit is a fast-forward of its current head, so concurrent pushes are never overwritten. "+
+					"Use 'base_sha' to make the commit fail rather than apply on top of changes you have not seen."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_COMMIT_USER_TITLE", "Create commit"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"branch": {
+						Type:        "string",
+						Description: "Existing branch to commit to",
+					},
+					"base_sha": {
+						Type:        "string",
+						Description: "Commit SHA to use as the parent of the new commit. Defaults to the branch's current head. If the branch has since moved past this commit, the branch update is rejected",
+					},
+					"message": {
+						Type:        "string",
+						Description: "Commit message",
+					},
+					"files": {
+						Type:        "array",
+						Description: "File changes to apply. Each change either sets 'content' for the path or sets 'delete' to true",
+						Items: &jsonschema.Schema{
+							Type:                 "object",
+							AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+							Properties: map[string]*jsonschema.Schema{
+								"path": {
+									Type:        "string",
+									Description: "Path of the file relative to the repository root",
+								},
+								"content": {
+									Type:        "string",
+									Description: "New file content",
+								},
+								"encoding": {
+									Type:        "string",
+									Description: "Encoding of 'content'. Use 'base64' for binary files. Defaults to 'utf-8'",
+									Enum:        []any{"utf-8", "base64"},
+								},
+								"delete": {
+									Type:        "boolean",
+									Description: "Delete the file at 'path' instead of writing content",
+								},
+							},
+							Required: []string{"path"},
+						},
+					},
+					"author_name": {
+						Type:        "string",
+						Description: "Name of the commit author. Defaults to the authenticated user. Must be set together with author_email",
+					},
+					"author_email": {
+						Type:        "string",
+						Description: "Email of the commit author. Must be set together with author_name",
+					},
+				},
+				Required: []string{"owner", "repo", "branch", "message", "files"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			branch, err := RequiredParam[string](args, "branch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			message, err := RequiredParam[string](args, "message")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			baseSHA, err := OptionalParam[string](args, "base_sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			authorName, err := OptionalParam[string](args, "author_name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			authorEmail, err := OptionalParam[string](args, "author_email")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if (authorName == "") != (authorEmail == "") {
+				return utils.NewToolResultError("author_name and author_email must be provided together"), nil, nil
+			}
+			changes, err := parseCommitFileChanges(args["files"])
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			refName := "refs/heads/" + branch
+			if baseSHA == "" {
+				ref, resp, err := client.Git.GetRef(ctx, owner, repo, refName)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get branch reference",
+						resp,
+						err,
+					), nil, nil
+				}
+				_ = resp.Body.Close()
+				baseSHA = ref.GetObject().GetSHA()
+			}
+
+			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, baseSHA)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get base commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			entries := make([]*github.TreeEntry, 0, len(changes))
+			for _, change := range changes {
+				entry := &github.TreeEntry{
+					Path: github.Ptr(change.Path),
+					Mode: github.Ptr("100644"), // Regular file mode
+					Type: github.Ptr("blob"),
+				}
+				switch {
+				case change.Delete:
+					// Leaving both SHA and Content nil deletes the path
+				case change.Encoding == "base64":
+					blob, resp, err := client.Git.CreateBlob(ctx, owner, repo, github.Blob{
+						Content:  github.Ptr(change.Content),
+						Encoding: github.Ptr("base64"),
+					})
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to create blob for %s", change.Path),
+							resp,
+							err,
+						), nil, nil
+					}
+					_ = resp.Body.Close()
+					entry.SHA = blob.SHA
+				default:
+					entry.Content = github.Ptr(change.Content)
+				}
+				entries = append(entries, entry)
+			}
+
+			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, baseCommit.GetTree().GetSHA(), entries)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create tree",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			commit := github.Commit{
+				Message: github.Ptr(message),
+				Tree:    newTree,
+				Parents: []*github.Commit{{SHA: github.Ptr(baseSHA)}},
+			}
+			if authorName != "" {
+				commit.Author = &github.CommitAuthor{
+					Name:  github.Ptr(authorName),
+					Email: github.Ptr(authorEmail),
+				}
+			}
+			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			// A non-forced update is rejected unless it fast-forwards the
+			// branch, which is what keeps concurrent writers from clobbering
+			// each other.
+			_, resp, err = client.Git.UpdateRef(ctx, owner, repo, refName, github.UpdateRef{
+				SHA:   newCommit.GetSHA(),
+				Force: github.Ptr(false),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update branch %s (it may have moved since %s)", branch, baseSHA),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(CreateCommitResponse{
+				SHA:       newCommit.GetSHA(),
+				HTMLURL:   newCommit.GetHTMLURL(),
+				Branch:    branch,
+				ParentSHA: baseSHA,
+				TreeSHA:   newTree.GetSHA(),
+			}), nil, nil
+		},
+	)
+}
+
+// CherryPickResponse is the response payload returned by the cherry_pick tool.
+// On a conflict no commit is created: Conflict is true, SHA is empty, and
+// ConflictFiles lists the files the picked commit touches.
+type CherryPickResponse struct {
+	SHA              string   `json:"sha,omitempty"`
+	HTMLURL          string   `json:"html_url,omitempty"`
+	Branch           string   `json:"branch"`
+	CherryPickedFrom string   `json:"cherry_picked_from"`
+	Conflict         bool     `json:"conflict"`
+	ConflictFiles    []string `json:"conflict_files,omitempty"`
+	Message          string   `json:"message,omitempty"`
+}
+
+// CherryPick creates a tool that applies the changes of a single commit on top
+// of a branch using only the Git Data and merge APIs.
+//
+// The GitHub API has no cherry-pick endpoint, so the tool builds a temporary
+// commit holding the branch's tree on top of the picked commit's parent, merges
+// the picked commit into it to let GitHub perform the three-way merge, and then
+// commits the merged tree onto the branch. The temporary branch is deleted
+// afterwards.
+func CherryPick(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name: "cherry_pick",
+			Description: t("TOOL_CHERRY_PICK_DESCRIPTION",
+				"Apply the changes introduced by a single commit onto a branch as a new commit, like 'git cherry-pick -x'. "+
+					"Returns the new commit SHA, or 'conflict: true' with the files the commit touches if the changes do not apply cleanly; in that case the branch is left unchanged. "+
+					"Merge commits cannot be cherry-picked."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CHERRY_PICK_USER_TITLE", "Cherry-pick commit"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"commit_sha": {
+						Type:        "string",
+						Description: "SHA of the commit to cherry-pick",
+					},
+					"branch": {
+						Type:        "string",
+						Description: "Existing branch to apply the commit to",
+					},
+				},
+				Required: []string{"owner", "repo", "commit_sha", "branch"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commitSHA, err := RequiredParam[string](args, "commit_sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			branch, err := RequiredParam[string](args, "branch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			picked, resp, err := client.Repositories.GetCommit(ctx, owner, repo, commitSHA, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get commit: %s", commitSHA),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+			if len(picked.Parents) != 1 {
+				return utils.NewToolResultError(fmt.Sprintf("commit %s has %d parents; only commits with exactly one parent can be cherry-picked", commitSHA, len(picked.Parents))), nil, nil
+			}
+			// Resolve abbreviated SHAs so the trailer and response carry the full SHA.
+			commitSHA = picked.GetSHA()
+
+			refName := "refs/heads/" + branch
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, refName)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get branch reference",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+			headSHA := ref.GetObject().GetSHA()
+
+			head, resp, err := client.Git.GetCommit(ctx, owner, repo, headSHA)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get branch head commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			// The temporary commit has the branch's content but the picked
+			// commit's parent as its history, so merging the picked commit into
+			// it applies exactly that commit's diff to the branch's content.
+			tempCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, github.Commit{
+				Message: github.Ptr(fmt.Sprintf("Temporary commit for cherry-picking %s", commitSHA)),
+				Tree:    &github.Tree{SHA: head.GetTree().SHA},
+				Parents: []*github.Commit{{SHA: picked.Parents[0].SHA}},
+			}, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create temporary commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			tempBranch := "cherry-pick-" + tempCommit.GetSHA()
+			_, resp, err = client.Git.CreateRef(ctx, owner, repo, github.CreateRef{
+				Ref: "refs/heads/" + tempBranch,
+				SHA: tempCommit.GetSHA(),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create temporary branch",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+			defer func() {
+				if resp, err := client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+tempBranch); err == nil {
+					_ = resp.Body.Close()
+				}
+			}()
+
+			merge, resp, err := client.Repositories.Merge(ctx, owner, repo, &github.RepositoryMergeRequest{
+				Base: github.Ptr(tempBranch),
+				Head: github.Ptr(commitSHA),
+			})
+			if resp != nil && resp.StatusCode == http.StatusConflict {
+				_ = resp.Body.Close()
+				conflictFiles := make([]string, 0, len(picked.Files))
+				for _, f := range picked.Files {
+					conflictFiles = append(conflictFiles, f.GetFilename())
+				}
+				return MarshalledTextResult(CherryPickResponse{
+					Branch:           branch,
+					CherryPickedFrom: commitSHA,
+					Conflict:         true,
+					ConflictFiles:    conflictFiles,
+					Message:          fmt.Sprintf("commit %s does not apply cleanly to %s; the branch was not changed", commitSHA, branch),
+				}), nil, nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to apply commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, github.Commit{
+				Message: github.Ptr(fmt.Sprintf("%s\n\n(cherry picked from commit %s)", strings.TrimRight(picked.GetCommit().GetMessage(), "\n"), commitSHA)),
+				Tree:    &github.Tree{SHA: merge.GetCommit().GetTree().SHA},
+				Parents: []*github.Commit{{SHA: github.Ptr(headSHA)}},
+				Author:  picked.GetCommit().Author,
+			}, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			_, resp, err = client.Git.UpdateRef(ctx, owner, repo, refName, github.UpdateRef{
+				SHA:   newCommit.GetSHA(),
+				Force: github.Ptr(false),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update branch %s (it may have moved since %s)", branch, headSHA),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(CherryPickResponse{
+				SHA:              newCommit.GetSHA(),
+				HTMLURL:          newCommit.GetHTMLURL(),
+				Branch:           branch,
+				CherryPickedFrom: commitSHA,
+			}), nil, nil
+		},
+	)
+}
+
+// ResolvedRef is the output of resolve_ref.
+type ResolvedRef struct {
+	Ref string `json:"ref"`
+	// Type is "branch", "tag" or "commit".
+	Type string `json:"type"`
+	// FullRef is the fully qualified reference, e.g. refs/heads/main. It is
+	// empty when the ref was resolved as a commit.
+	FullRef string `json:"full_ref,omitempty"`
+	// SHA is the commit the ref points to. Annotated tags are peeled to
+	// their commit, and TagSHA holds the tag object itself.
+	SHA    string `json:"sha"`
+	TagSHA string `json:"tag_sha,omitempty"`
+}
+
+// maxTagDepth bounds how many annotated tags pointing at other tags are
+// peeled before giving up.
+const maxTagDepth = 5
+
+// resolveRef resolves a branch, tag or commit-ish to a commit SHA. Short names
+// are looked up as a branch first and then as a tag, as resolveGitReference
+// does; anything else is passed to the commits API, which also accepts
+// abbreviated SHAs. It returns a nil ResolvedRef when nothing matches.
+func resolveRef(ctx context.Context, client *github.Client, owner, repo, ref string) (*ResolvedRef, *github.Response, error) {
+	var candidates []string
+	switch {
+	case looksLikeSHA(ref):
+	case strings.HasPrefix(ref, "refs/"):
+		candidates = []string{ref}
+	case strings.HasPrefix(ref, "heads/") || strings.HasPrefix(ref, "tags/"):
+		candidates = []string{"refs/" + ref}
+	default:
+		candidates = []string{"refs/heads/" + ref, "refs/tags/" + ref}
+	}
+
+	for _, candidate := range candidates {
+		reference, resp, err := client.Git.GetRef(ctx, owner, repo, candidate)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		resolved := &ResolvedRef{Ref: ref, FullRef: reference.GetRef(), SHA: reference.GetObject().GetSHA()}
+		switch {
+		case strings.HasPrefix(resolved.FullRef, "refs/heads/"):
+			resolved.Type = "branch"
+		case strings.HasPrefix(resolved.FullRef, "refs/tags/"):
+			resolved.Type = "tag"
+		default:
+			resolved.Type = reference.GetObject().GetType()
+		}
+
+		objectType := reference.GetObject().GetType()
+		for depth := 0; objectType == "tag"; depth++ {
+			if depth == maxTagDepth {
+				return nil, nil, fmt.Errorf("tag %s is nested more than %d levels deep", ref, maxTagDepth)
+			}
+			if resolved.TagSHA == "" {
+				resolved.TagSHA = resolved.SHA
+			}
+			tag, resp, err := client.Git.GetTag(ctx, owner, repo, resolved.SHA)
+			if err != nil {
+				return nil, resp, err
+			}
+			_ = resp.Body.Close()
+			resolved.SHA = tag.GetObject().GetSHA()
+			objectType = tag.GetObject().GetType()
+		}
+		return resolved, nil, nil
+	}
+
+	sha, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, ref, "")
+	if err != nil {
+		// The commits API answers 422 rather than 404 for names that are not
+		// a commit at all.
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+			return nil, nil, nil
+		}
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+	return &ResolvedRef{Ref: ref, Type: "commit", SHA: sha}, nil, nil
+}
+
+// ResolveRef creates a tool to resolve a branch, tag or commit reference to a commit SHA.
+func ResolveRef(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name: "resolve_ref",
+			Description: t("TOOL_RESOLVE_REF_DESCRIPTION",
+				"Resolve a branch, tag or commit reference to the full SHA of the commit it points to, and report whether it is a branch, tag or commit. "+
+					"Short names are looked up as a branch first, then as a tag. Annotated tags are resolved to their commit. "+
+					"Use it before tools that need a commit SHA, such as create_commit or cherry_pick."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_RESOLVE_REF_USER_TITLE", "Resolve reference"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch or tag name, fully qualified reference (e.g. refs/heads/main, tags/v1.0.0), or full or abbreviated commit SHA",
+					},
+				},
+				Required: []string{"owner", "repo", "ref"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := RequiredParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			resolved, resp, err := resolveRef(ctx, client, owner, repo, ref)
+			if err != nil {
+				if resp == nil {
+					return utils.NewToolResultErrorFromErr("failed to resolve ref", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to resolve ref %s", ref), resp, err), nil, nil
+			}
+			if resolved == nil {
+				return utils.NewToolResultError(fmt.Sprintf("ref %q not found: it is not a branch, tag or commit in %s/%s", ref, owner, repo)), nil, nil
+			}
+
+			result := MarshalledTextResult(resolved)
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
+
+// defaultBlobMaxBytes is the default byte budget for get_blob content.
+const defaultBlobMaxBytes = 100 * 1024
+
+// BlobContent is the output of get_blob. Content is empty for binary blobs.
+type BlobContent struct {
+	SHA  string `json:"sha"`
+	Size int    `json:"size"`
+	// Content is the decoded blob. Truncated reports that the blob is larger
+	// than max_bytes and Content holds only its beginning.
+	Content   string `json:"content,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+	Binary    bool   `json:"binary,omitempty"`
+}
+
+// decodeBlobContent returns the raw bytes of a blob returned by the Git
+// Blobs API, which encodes content as base64 split across lines.
+func decodeBlobContent(blob *github.Blob) ([]byte, error) {
+	switch blob.GetEncoding() {
+	case "base64":
+		return base64.StdEncoding.DecodeString(strings.ReplaceAll(blob.GetContent(), "\n", ""))
+	case "utf-8", "":
+		return []byte(blob.GetContent()), nil
+	default:
+		return nil, fmt.Errorf("unsupported blob encoding %q", blob.GetEncoding())
+	}
+}
+
+// GetBlob creates a tool to get the content of a Git blob by its SHA.
+func GetBlob(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name: "get_blob",
+			Description: t("TOOL_GET_BLOB_DESCRIPTION",
+				"Get the content of a file by its blob SHA, as listed by get_repository_tree, without resolving its path. "+
+					"Content beyond 'max_bytes' is cut off and marked 'truncated'; binary blobs are marked 'binary' and their content is omitted."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_BLOB_USER_TITLE", "Get blob content"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"sha": {
+						Type:        "string",
+						Description: "SHA of the blob",
+					},
+					"max_bytes": {
+						Type:        "number",
+						Description: "Maximum number of bytes of content to return",
+						Default:     json.RawMessage(strconv.Itoa(defaultBlobMaxBytes)),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(1024 * 1024.0),
+					},
+				},
+				Required: []string{"owner", "repo", "sha"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxBytes, err := OptionalIntParamWithDefault(args, "max_bytes", defaultBlobMaxBytes)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxBytes < 1 || maxBytes > 1024*1024 {
+				return utils.NewToolResultError("max_bytes must be between 1 and 1048576"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			blob, resp, err := client.Git.GetBlob(ctx, owner, repo, sha)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get blob %s", sha), resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			data, err := decodeBlobContent(blob)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to decode blob content", err), nil, nil
+			}
+
+			content := BlobContent{
+				SHA:  blob.GetSHA(),
+				Size: blob.GetSize(),
+			}
+			data, content.Truncated, content.Binary = limitTextContent(data, maxBytes)
+			content.Content = string(data)
+
+			result := MarshalledTextResult(content)
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelGetFileContents)
+			return result, nil, nil
+		},
+	)
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"strings"
@@ -31,6 +32,10 @@ func Test_GetRepositoryTree(t *testing.T) {
 	assert.Contains(t, inputSchema.Properties, "tree_sha")
 	assert.Contains(t, inputSchema.Properties, "recursive")
 	assert.Contains(t, inputSchema.Properties, "path_filter")
+	assert.Contains(t, inputSchema.Properties, "path_glob")
+	assert.Contains(t, inputSchema.Properties, "files_only")
+	assert.Contains(t, inputSchema.Properties, "directories_only")
+	assert.Contains(t, inputSchema.Properties, "max_entries")
 	assert.ElementsMatch(t, inputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock data
@@ -175,3 +180,780 @@ func Test_GetRepositoryTree(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRepositoryTree_Filters(t *testing.T) {
+	toolDef := GetRepositoryTree(translations.NullTranslationHelper)
+
+	mockTree := &github.Tree{
+		SHA:       github.Ptr("abc123"),
+		Truncated: github.Ptr(false),
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("s1")},
+			{Path: github.Ptr("main.go"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("s2")},
+			{Path: github.Ptr("cmd"), Type: github.Ptr("tree"), Mode: github.Ptr("040000"), SHA: github.Ptr("s3")},
+			{Path: github.Ptr("cmd/server"), Type: github.Ptr("tree"), Mode: github.Ptr("040000"), SHA: github.Ptr("s4")},
+			{Path: github.Ptr("cmd/server/main.go"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("s5")},
+			{Path: github.Ptr("pkg/util/strings.go"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("s6")},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		requestArgs        map[string]any
+		expectedPaths      []string
+		expectedMaxReached bool
+		expectedErrMsg     string
+	}{
+		{
+			name:          "double star glob matches at any depth",
+			requestArgs:   map[string]any{"path_glob": "**/*.go"},
+			expectedPaths: []string{"main.go", "cmd/server/main.go", "pkg/util/strings.go"},
+		},
+		{
+			name:          "single star glob stays within a segment",
+			requestArgs:   map[string]any{"path_glob": "cmd/*/main.go"},
+			expectedPaths: []string{"cmd/server/main.go"},
+		},
+		{
+			name:          "directories only",
+			requestArgs:   map[string]any{"directories_only": true},
+			expectedPaths: []string{"cmd", "cmd/server"},
+		},
+		{
+			name:          "files only combined with path filter",
+			requestArgs:   map[string]any{"files_only": true, "path_filter": "cmd/"},
+			expectedPaths: []string{"cmd/server/main.go"},
+		},
+		{
+			name:               "max entries caps the result",
+			requestArgs:        map[string]any{"files_only": true, "max_entries": float64(2)},
+			expectedPaths:      []string{"README.md", "main.go"},
+			expectedMaxReached: true,
+		},
+		{
+			name:           "max entries of zero is rejected",
+			requestArgs:    map[string]any{"max_entries": float64(0)},
+			expectedErrMsg: "max_entries must be >= 1",
+		},
+		{
+			name:           "negative max entries is rejected",
+			requestArgs:    map[string]any{"max_entries": float64(-3)},
+			expectedErrMsg: "max_entries must be >= 1",
+		},
+		{
+			name:           "files only and directories only are exclusive",
+			requestArgs:    map[string]any{"files_only": true, "directories_only": true},
+			expectedErrMsg: "files_only and directories_only cannot both be true",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree: mockResponse(t, http.StatusOK, mockTree),
+			}))
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := toolDef.Handler(deps)
+
+			args := map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"tree_sha":  "main",
+				"recursive": true,
+			}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response TreeResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+
+			paths := make([]string, len(response.Tree))
+			for i, entry := range response.Tree {
+				paths[i] = entry.Path
+			}
+			assert.Equal(t, tc.expectedPaths, paths)
+			assert.Equal(t, len(tc.expectedPaths), response.Count)
+			assert.Equal(t, tc.expectedMaxReached, response.MaxEntriesReached)
+		})
+	}
+}
+
+func Test_GetRepositoryTree_Truncation(t *testing.T) {
+	toolDef := GetRepositoryTree(translations.NullTranslationHelper)
+
+	tests := []struct {
+		name            string
+		recursive       bool
+		truncated       bool
+		expectedQuery   map[string]string
+		expectedWarning bool
+	}{
+		{
+			name:            "truncated recursive tree includes warning",
+			recursive:       true,
+			truncated:       true,
+			expectedQuery:   map[string]string{"recursive": "1"},
+			expectedWarning: true,
+		},
+		{
+			name:          "complete shallow tree has no warning",
+			recursive:     false,
+			truncated:     false,
+			expectedQuery: map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTree := &github.Tree{
+				SHA:       github.Ptr("abc123"),
+				Truncated: github.Ptr(tc.truncated),
+				Entries: []*github.TreeEntry{
+					{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), Mode: github.Ptr("100644"), SHA: github.Ptr("s1")},
+				},
+			}
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree: expectQueryParams(t, tc.expectedQuery).andThen(
+					mockResponse(t, http.StatusOK, mockTree),
+				),
+			}))
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"tree_sha":  "main",
+				"recursive": tc.recursive,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var response TreeResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.truncated, response.Truncated)
+			assert.Equal(t, tc.recursive, response.Recursive)
+			if tc.expectedWarning {
+				assert.Contains(t, response.Warning, "100,000 entries")
+			} else {
+				assert.Empty(t, response.Warning)
+			}
+		})
+	}
+}
+
+func Test_CreateCommit(t *testing.T) {
+	serverTool := CreateCommit(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "create_commit", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "base_sha")
+	assert.Contains(t, schema.Properties, "author_name")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "branch", "message", "files"})
+
+	mockRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{SHA: github.Ptr("head-sha")},
+	}
+	mockBaseCommit := &github.Commit{
+		SHA:  github.Ptr("head-sha"),
+		Tree: &github.Tree{SHA: github.Ptr("base-tree-sha")},
+	}
+	mockTree := &github.Tree{SHA: github.Ptr("new-tree-sha")}
+	mockNewCommit := &github.Commit{
+		SHA:     github.Ptr("new-commit-sha"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/new-commit-sha"),
+	}
+	mockUpdatedRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{SHA: github.Ptr("new-commit-sha")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       CreateCommitResponse
+	}{
+		{
+			name: "commits updates, binary files and deletions together",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef:           mockResponse(t, http.StatusOK, mockRef),
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, mockBaseCommit),
+				PostReposGitBlobsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"content":  "iVBORw0KGgo=",
+					"encoding": "base64",
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Blob{SHA: github.Ptr("blob-sha")})),
+				PostReposGitTreesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"base_tree": "base-tree-sha",
+					"tree": []any{
+						map[string]any{"path": "README.md", "mode": "100644", "type": "blob", "content": "# Hello"},
+						map[string]any{"path": "logo.png", "mode": "100644", "type": "blob", "sha": "blob-sha"},
+						map[string]any{"path": "old.txt", "mode": "100644", "type": "blob", "sha": nil},
+					},
+				}).andThen(mockResponse(t, http.StatusCreated, mockTree)),
+				PostReposGitCommitsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"message": "Update docs",
+					"tree":    "new-tree-sha",
+					"parents": []any{"head-sha"},
+					"author": map[string]any{
+						"name":  "Octo Cat",
+						"email": "octocat@example.com",
+					},
+				}).andThen(mockResponse(t, http.StatusCreated, mockNewCommit)),
+				PatchReposGitRefsByOwnerByRepoByRef: expectRequestBody(t, map[string]any{
+					"sha":   "new-commit-sha",
+					"force": false,
+				}).andThen(mockResponse(t, http.StatusOK, mockUpdatedRef)),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"branch":  "main",
+				"message": "Update docs",
+				"files": []any{
+					map[string]any{"path": "README.md", "content": "# Hello"},
+					map[string]any{"path": "logo.png", "content": "iVBORw0KGgo=", "encoding": "base64"},
+					map[string]any{"path": "old.txt", "delete": true},
+				},
+				"author_name":  "Octo Cat",
+				"author_email": "octocat@example.com",
+			},
+			expected: CreateCommitResponse{
+				SHA:       "new-commit-sha",
+				HTMLURL:   "https://github.com/owner/repo/commit/new-commit-sha",
+				Branch:    "main",
+				ParentSHA: "head-sha",
+				TreeSHA:   "new-tree-sha",
+			},
+		},
+		{
+			name: "base_sha skips the ref lookup and surfaces a rejected update",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, &github.Commit{
+					SHA:  github.Ptr("stale-sha"),
+					Tree: &github.Tree{SHA: github.Ptr("base-tree-sha")},
+				}),
+				PostReposGitTreesByOwnerByRepo:   mockResponse(t, http.StatusCreated, mockTree),
+				PostReposGitCommitsByOwnerByRepo: mockResponse(t, http.StatusCreated, mockNewCommit),
+				PatchReposGitRefsByOwnerByRepoByRef: mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+					"message": "Update is not a fast forward",
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "main",
+				"base_sha": "stale-sha",
+				"message":  "Update docs",
+				"files": []any{
+					map[string]any{"path": "README.md", "content": "# Hello"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update branch main (it may have moved since stale-sha)",
+		},
+		{
+			name:         "file change without content or delete",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"branch":  "main",
+				"message": "Update docs",
+				"files": []any{
+					map[string]any{"path": "README.md"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "file README.md: content is required unless delete is true",
+		},
+		{
+			name:         "author name without email",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "main",
+				"message":     "Update docs",
+				"files":       []any{map[string]any{"path": "README.md", "content": "x"}},
+				"author_name": "Octo Cat",
+			},
+			expectError:    true,
+			expectedErrMsg: "author_name and author_email must be provided together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response CreateCommitResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_CherryPick(t *testing.T) {
+	serverTool := CherryPick(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "cherry_pick", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "commit_sha", "branch"})
+
+	mockPicked := &github.RepositoryCommit{
+		SHA:     github.Ptr("picked-sha"),
+		Parents: []*github.Commit{{SHA: github.Ptr("picked-parent-sha")}},
+		Commit: &github.Commit{
+			Message: github.Ptr("Fix the bug\n"),
+			Author: &github.CommitAuthor{
+				Name:  github.Ptr("Octo Cat"),
+				Email: github.Ptr("octocat@example.com"),
+			},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("src/bug.go")},
+			{Filename: github.Ptr("src/bug_test.go")},
+		},
+	}
+	mockRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/release"),
+		Object: &github.GitObject{SHA: github.Ptr("head-sha")},
+	}
+	mockHead := &github.Commit{
+		SHA:  github.Ptr("head-sha"),
+		Tree: &github.Tree{SHA: github.Ptr("head-tree-sha")},
+	}
+	mockMerge := &github.RepositoryCommit{
+		SHA:    github.Ptr("merge-sha"),
+		Commit: &github.Commit{Tree: &github.Tree{SHA: github.Ptr("merged-tree-sha")}},
+	}
+
+	// Both the temporary and the final commit are created through the same
+	// endpoint; the temporary one is recognised by its parent.
+	createCommitHandler := func(t *testing.T) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			parents, _ := body["parents"].([]any)
+			require.Len(t, parents, 1)
+			if parents[0] == "picked-parent-sha" {
+				assert.Equal(t, "head-tree-sha", body["tree"])
+				mockResponse(t, http.StatusCreated, &github.Commit{SHA: github.Ptr("temp-sha")})(w, r)
+				return
+			}
+			assert.Equal(t, "head-sha", parents[0])
+			assert.Equal(t, "merged-tree-sha", body["tree"])
+			assert.Equal(t, "Fix the bug\n\n(cherry picked from commit picked-sha)", body["message"])
+			assert.Equal(t, "Octo Cat", body["author"].(map[string]any)["name"])
+			mockResponse(t, http.StatusCreated, &github.Commit{
+				SHA:     github.Ptr("new-commit-sha"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/commit/new-commit-sha"),
+			})(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       CherryPickResponse
+	}{
+		{
+			name: "applies the commit onto the branch",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef:          mockResponse(t, http.StatusOK, mockPicked),
+				GetReposGitRefByOwnerByRepoByRef:           mockResponse(t, http.StatusOK, mockRef),
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, mockHead),
+				PostReposGitCommitsByOwnerByRepo:           createCommitHandler(t),
+				PostReposGitRefsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"ref": "refs/heads/cherry-pick-temp-sha",
+					"sha": "temp-sha",
+				}).andThen(mockResponse(t, http.StatusCreated, &github.Reference{})),
+				DeleteReposGitRefsByOwnerByRepoByRef: mockResponse(t, http.StatusNoContent, nil),
+				PostReposMergesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"base": "cherry-pick-temp-sha",
+					"head": "picked-sha",
+				}).andThen(mockResponse(t, http.StatusCreated, mockMerge)),
+				PatchReposGitRefsByOwnerByRepoByRef: expectRequestBody(t, map[string]any{
+					"sha":   "new-commit-sha",
+					"force": false,
+				}).andThen(mockResponse(t, http.StatusOK, &github.Reference{})),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "picked-sha",
+				"branch":     "release",
+			},
+			expected: CherryPickResponse{
+				SHA:              "new-commit-sha",
+				HTMLURL:          "https://github.com/owner/repo/commit/new-commit-sha",
+				Branch:           "release",
+				CherryPickedFrom: "picked-sha",
+			},
+		},
+		{
+			name: "reports a conflict without touching the branch",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef:          mockResponse(t, http.StatusOK, mockPicked),
+				GetReposGitRefByOwnerByRepoByRef:           mockResponse(t, http.StatusOK, mockRef),
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, mockHead),
+				PostReposGitCommitsByOwnerByRepo:           createCommitHandler(t),
+				PostReposGitRefsByOwnerByRepo:              mockResponse(t, http.StatusCreated, &github.Reference{}),
+				DeleteReposGitRefsByOwnerByRepoByRef:       mockResponse(t, http.StatusNoContent, nil),
+				PostReposMergesByOwnerByRepo: mockResponse(t, http.StatusConflict, map[string]string{
+					"message": "Merge conflict",
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "picked-sha",
+				"branch":     "release",
+			},
+			expected: CherryPickResponse{
+				Branch:           "release",
+				CherryPickedFrom: "picked-sha",
+				Conflict:         true,
+				ConflictFiles:    []string{"src/bug.go", "src/bug_test.go"},
+				Message:          "commit picked-sha does not apply cleanly to release; the branch was not changed",
+			},
+		},
+		{
+			name: "rejects merge commits",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: mockResponse(t, http.StatusOK, &github.RepositoryCommit{
+					SHA:     github.Ptr("merge-sha"),
+					Parents: []*github.Commit{{SHA: github.Ptr("a")}, {SHA: github.Ptr("b")}},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "merge-sha",
+				"branch":     "release",
+			},
+			expectError:    true,
+			expectedErrMsg: "commit merge-sha has 2 parents",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response CherryPickResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_ResolveRef(t *testing.T) {
+	serverTool := ResolveRef(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "resolve_ref", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "ref"})
+
+	notFound := mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)
+	// refs serves the given references and 404s for any other.
+	refs := func(refs map[string]*github.Reference) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ref, ok := refs[strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/git/ref/")]
+			if !ok {
+				notFound(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, ref)(w, r)
+		}
+	}
+	fullSHA := "0123456789abcdef0123456789abcdef01234567"
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		ref            string
+		expectError    bool
+		expectedErrMsg string
+		expected       ResolvedRef
+	}{
+		{
+			name: "branch",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: refs(map[string]*github.Reference{
+					"heads/main": {Ref: github.Ptr("refs/heads/main"), Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("branch-sha")}},
+					"tags/main":  {Ref: github.Ptr("refs/tags/main"), Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("tag-sha")}},
+				}),
+			},
+			ref:      "main",
+			expected: ResolvedRef{Ref: "main", Type: "branch", FullRef: "refs/heads/main", SHA: "branch-sha"},
+		},
+		{
+			name: "annotated tag is peeled to its commit",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: refs(map[string]*github.Reference{
+					"tags/v1.0.0": {Ref: github.Ptr("refs/tags/v1.0.0"), Object: &github.GitObject{Type: github.Ptr("tag"), SHA: github.Ptr("tag-object-sha")}},
+				}),
+				GetReposGitTagsByOwnerByRepoByTagSHA: mockResponse(t, http.StatusOK, &github.Tag{
+					SHA:    github.Ptr("tag-object-sha"),
+					Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("tagged-commit-sha")},
+				}),
+			},
+			ref:      "v1.0.0",
+			expected: ResolvedRef{Ref: "v1.0.0", Type: "tag", FullRef: "refs/tags/v1.0.0", SHA: "tagged-commit-sha", TagSHA: "tag-object-sha"},
+		},
+		{
+			name: "partially qualified ref is looked up as given",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: refs(map[string]*github.Reference{
+					"tags/release": {Ref: github.Ptr("refs/tags/release"), Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("release-sha")}},
+				}),
+			},
+			ref:      "tags/release",
+			expected: ResolvedRef{Ref: "tags/release", Type: "tag", FullRef: "refs/tags/release", SHA: "release-sha"},
+		},
+		{
+			name: "abbreviated SHA falls back to the commits API",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: notFound,
+				GetReposCommitsByOwnerByRepoByRef: func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/owner/repo/commits/0123456", r.URL.Path)
+					mockResponse(t, http.StatusOK, fullSHA)(w, r)
+				},
+			},
+			ref:      "0123456",
+			expected: ResolvedRef{Ref: "0123456", Type: "commit", SHA: fullSHA},
+		},
+		{
+			name: "full SHA skips the reference lookups",
+			handlers: map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: mockResponse(t, http.StatusOK, fullSHA),
+			},
+			ref:      fullSHA,
+			expected: ResolvedRef{Ref: fullSHA, Type: "commit", SHA: fullSHA},
+		},
+		{
+			name: "unknown ref",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef:  notFound,
+				GetReposCommitsByOwnerByRepoByRef: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "No commit found for SHA: nope"}`),
+			},
+			ref:            "nope",
+			expectError:    true,
+			expectedErrMsg: `ref "nope" not found`,
+		},
+		{
+			name: "API error",
+			handlers: map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+			},
+			ref:            "main",
+			expectError:    true,
+			expectedErrMsg: "failed to resolve ref main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   tc.ref,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response ResolvedRef
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_GetBlob(t *testing.T) {
+	serverTool := GetBlob(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "get_blob", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "sha"})
+
+	// blob serves a base64-encoded blob wrapped at 60 characters, as the API does.
+	blob := func(content []byte) http.HandlerFunc {
+		encoded := base64.StdEncoding.EncodeToString(content)
+		var wrapped strings.Builder
+		for len(encoded) > 60 {
+			wrapped.WriteString(encoded[:60] + "\n")
+			encoded = encoded[60:]
+		}
+		wrapped.WriteString(encoded + "\n")
+		return expectPath(t, "/repos/owner/repo/git/blobs/abc123").andThen(
+			mockResponse(t, http.StatusOK, &github.Blob{
+				SHA:      github.Ptr("abc123"),
+				Size:     github.Ptr(len(content)),
+				Encoding: github.Ptr("base64"),
+				Content:  github.Ptr(wrapped.String()),
+			}),
+		)
+	}
+	longText := []byte(strings.Repeat("line of text\n", 10))
+
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		args           map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       BlobContent
+	}{
+		{
+			name:     "text blob is decoded",
+			handler:  blob(longText),
+			expected: BlobContent{SHA: "abc123", Size: len(longText), Content: string(longText)},
+		},
+		{
+			name:     "content beyond max_bytes is truncated",
+			handler:  blob(longText),
+			args:     map[string]any{"max_bytes": float64(13)},
+			expected: BlobContent{SHA: "abc123", Size: len(longText), Content: "line of text\n", Truncated: true},
+		},
+		{
+			name:     "truncation does not split a multi-byte character",
+			handler:  blob([]byte("héllo")),
+			args:     map[string]any{"max_bytes": float64(2)},
+			expected: BlobContent{SHA: "abc123", Size: 6, Content: "h", Truncated: true},
+		},
+		{
+			name:     "binary blob omits content",
+			handler:  blob([]byte{0x89, 'P', 'N', 'G', 0x00, 0x01}),
+			expected: BlobContent{SHA: "abc123", Size: 6, Binary: true},
+		},
+		{
+			name:           "max_bytes out of range",
+			handler:        blob(longText),
+			args:           map[string]any{"max_bytes": float64(2 * 1024 * 1024)},
+			expectError:    true,
+			expectedErrMsg: "max_bytes must be between 1 and 1048576",
+		},
+		{
+			name:           "blob not found",
+			handler:        mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			expectError:    true,
+			expectedErrMsg: "failed to get blob abc123",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetReposGitBlobsByOwnerByRepoByFileSHA: tc.handler,
+				})),
+			}
+			handler := serverTool.Handler(deps)
+
+			args := map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response BlobContent
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
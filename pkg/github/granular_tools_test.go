@@ -58,6 +58,9 @@ func TestGranularToolSnaps(t *testing.T) {
 		GranularResolveReviewThread,
 		GranularUnresolveReviewThread,
 		GranularAddPullRequestReviewCommentReaction,
+		GranularCreateLabel,
+		GranularUpdateLabel,
+		GranularDeleteLabel,
 	}
 
 	for _, constructor := range toolConstructors {
@@ -142,6 +145,37 @@ func TestPullRequestsGranularToolset(t *testing.T) {
 	})
 }
 
+func TestLabelsGranularToolset(t *testing.T) {
+	t.Run("toolset contains expected granular tools", func(t *testing.T) {
+		tools := granularToolsForToolset(ToolsetLabels.ID, FeatureFlagLabelsGranular)
+
+		toolNames := make([]string, 0, len(tools))
+		for _, tool := range tools {
+			toolNames = append(toolNames, tool.Tool.Name)
+		}
+
+		expected := []string{
+			"create_label",
+			"update_label",
+			"delete_label",
+		}
+		for _, name := range expected {
+			assert.Contains(t, toolNames, name)
+		}
+		assert.Len(t, tools, len(expected))
+	})
+
+	t.Run("delete is marked destructive", func(t *testing.T) {
+		tool := GranularDeleteLabel(translations.NullTranslationHelper).Tool
+		require.NotNil(t, tool.Annotations.DestructiveHint)
+		assert.True(t, *tool.Annotations.DestructiveHint)
+	})
+
+	t.Run("label_write is replaced when the flag is enabled", func(t *testing.T) {
+		assert.Equal(t, []string{FeatureFlagLabelsGranular}, LabelWrite(translations.NullTranslationHelper).FeatureFlagDisable)
+	})
+}
+
 // --- Issue granular tool handler tests ---
 
 func TestGranularCreateIssue(t *testing.T) {
@@ -1745,6 +1779,150 @@ func TestGranularUnresolveReviewThread(t *testing.T) {
 	assert.False(t, result.IsError)
 }
 
+func TestGranularResolveReviewThreadByLocation(t *testing.T) {
+	thread := func(id, path string, line int, resolved bool) map[string]any {
+		return map[string]any{
+			"id":         id,
+			"path":       path,
+			"line":       line,
+			"isResolved": resolved,
+			"isOutdated": false,
+			"comments":   map[string]any{"totalCount": 0, "nodes": []map[string]any{}},
+		}
+	}
+	threadsQuery := func(threads ...map[string]any) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			reviewThreadListQuery{},
+			map[string]any{
+				"owner":             githubv4.String("owner"),
+				"repo":              githubv4.String("repo"),
+				"prNum":             githubv4.Int(1),
+				"first":             githubv4.Int(100),
+				"commentsPerThread": githubv4.Int(100),
+				"after":             (*githubv4.String)(nil),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"pullRequest": map[string]any{
+						"reviewThreads": map[string]any{
+							"nodes":      threads,
+							"pageInfo":   map[string]any{"hasNextPage": false},
+							"totalCount": len(threads),
+						},
+					},
+				},
+			}),
+		)
+	}
+	resolveMutation := githubv4mock.NewMutationMatcher(
+		struct {
+			ResolveReviewThread struct {
+				Thread struct {
+					ID         githubv4.ID
+					IsResolved githubv4.Boolean
+				}
+			} `graphql:"resolveReviewThread(input: $input)"`
+		}{},
+		githubv4.ResolveReviewThreadInput{
+			ThreadID: githubv4.ID("PRRT_2"),
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"resolveReviewThread": map[string]any{
+				"thread": map[string]any{"id": "PRRT_2", "isResolved": true},
+			},
+		}),
+	)
+	location := map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(1),
+		"path":       "src/main.go",
+		"line":       float64(42),
+	}
+
+	tests := []struct {
+		name           string
+		gqlHTTPClient  *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "resolves the unresolved thread at the location",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(
+				threadsQuery(
+					thread("PRRT_1", "src/main.go", 42, true),
+					thread("PRRT_2", "src/main.go", 42, false),
+					thread("PRRT_3", "src/main.go", 7, false),
+				),
+				resolveMutation,
+			),
+			requestArgs: location,
+		},
+		{
+			name: "several unresolved threads at the location",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(
+				threadsQuery(
+					thread("PRRT_1", "src/main.go", 42, false),
+					thread("PRRT_2", "src/main.go", 42, false),
+				),
+			),
+			requestArgs:    location,
+			expectError:    true,
+			expectedErrMsg: "pass threadID with one of: PRRT_1, PRRT_2",
+		},
+		{
+			name: "no thread at the location",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(
+				threadsQuery(thread("PRRT_3", "src/main.go", 7, false)),
+			),
+			requestArgs:    location,
+			expectError:    true,
+			expectedErrMsg: "no review thread found on src/main.go line 42 of pull request #1",
+		},
+		{
+			name:          "incomplete location",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(1),
+			},
+			expectError:    true,
+			expectedErrMsg: "either threadID or all of owner, repo, pullNumber, path and line are required",
+		},
+		{
+			name:           "GraphQL client not configured",
+			requestArgs:    map[string]any{"threadID": "PRRT_2"},
+			expectError:    true,
+			expectedErrMsg: "requires the GitHub GraphQL API",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{}
+			if tc.gqlHTTPClient != nil {
+				deps.GQLClient = githubv4.NewClient(tc.gqlHTTPClient)
+			}
+			serverTool := GranularResolveReviewThread(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+			assert.False(t, result.IsError, getTextResult(t, result).Text)
+		})
+	}
+}
+
 func TestGranularSetIssueFields(t *testing.T) {
 	t.Run("successful set with text value", func(t *testing.T) {
 		matchers := []githubv4mock.Matcher{
@@ -2623,3 +2801,200 @@ func TestGranularAddPullRequestReviewCommentReaction(t *testing.T) {
 		})
 	}
 }
+
+// --- Label granular tool handler tests ---
+
+func TestGranularCreateLabel(t *testing.T) {
+	mockLabel := &gogithub.Label{
+		Name:        gogithub.Ptr("bug"),
+		Color:       gogithub.Ptr("d73a4a"),
+		Description: gogithub.Ptr("Something isn't working"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "successful creation with normalized color",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposLabelsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"name":        "bug",
+					"color":       "d73a4a",
+					"description": "Something isn't working",
+				}).andThen(mockResponse(t, http.StatusCreated, mockLabel)),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "bug",
+				"color":       "#D73A4A",
+				"description": "Something isn't working",
+			},
+		},
+		{
+			name:         "invalid color",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "bug",
+				"color": "red",
+			},
+			expectedErrMsg: "color must be a 6-character hex code",
+		},
+		{
+			name:         "missing color",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "bug",
+			},
+			expectedErrMsg: "missing required parameter: color",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			serverTool := GranularCreateLabel(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError)
+			var label map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &label))
+			assert.Equal(t, "bug", label["name"])
+			assert.Equal(t, "d73a4a", label["color"])
+		})
+	}
+}
+
+func TestGranularUpdateLabel(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "rename and clear description",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposLabelsByOwnerByRepoByName: expectRequestBody(t, map[string]any{
+					"name":        "defect",
+					"description": "",
+				}).andThen(mockResponse(t, http.StatusOK, &gogithub.Label{
+					Name:  gogithub.Ptr("defect"),
+					Color: gogithub.Ptr("d73a4a"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "bug",
+				"new_name":    "defect",
+				"description": "",
+			},
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "bug",
+			},
+			expectedErrMsg: "at least one of new_name, color, or description must be provided",
+		},
+		{
+			name:         "invalid color",
+			mockedClient: MockHTTPClientWithHandlers(nil),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "bug",
+				"color": "12345g",
+			},
+			expectedErrMsg: "color must be a 6-character hex code",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			serverTool := GranularUpdateLabel(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			assert.False(t, result.IsError)
+		})
+	}
+}
+
+func TestGranularDeleteLabel(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expectErr    bool
+	}{
+		{
+			name: "successful deletion",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposLabelsByOwnerByRepoByName: mockResponse(t, http.StatusNoContent, nil),
+			}),
+		},
+		{
+			name: "label not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposLabelsByOwnerByRepoByName: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			serverTool := GranularDeleteLabel(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "bug",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			if tc.expectErr {
+				assert.True(t, result.IsError)
+				return
+			}
+			assert.False(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "label 'bug' deleted successfully")
+		})
+	}
+}
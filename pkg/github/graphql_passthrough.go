@@ -0,0 +1,282 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GraphQLQueryToolName is the name of the GraphQL passthrough tool. The tool is
+// not part of AllTools: the server only offers it when started with
+// --enable-graphql-passthrough.
+const GraphQLQueryToolName = "graphql_query"
+
+// graphQLOperation is a top-level operation definition in a GraphQL document.
+type graphQLOperation struct {
+	// Type is "query", "mutation" or "subscription".
+	Type string
+	// Name is empty for anonymous operations.
+	Name string
+}
+
+// parseGraphQLOperations returns the operation definitions of a GraphQL
+// document. It only tokenizes as far as needed to find them: strings and
+// comments are skipped, and anything nested in braces or parentheses is
+// ignored. Fragment definitions are not operations and are not returned.
+func parseGraphQLOperations(document string) ([]graphQLOperation, error) {
+	var operations []graphQLOperation
+	braces, parens := 0, 0
+	// inDefinition is set between a top-level keyword and the selection set
+	// that ends its header; afterKeyword while an operation name may follow.
+	inDefinition, afterKeyword := false, false
+
+	for i := 0; i < len(document); {
+		c := document[i]
+		topLevel := braces == 0 && parens == 0
+		switch {
+		case c == '#':
+			end := strings.IndexByte(document[i:], '\n')
+			if end < 0 {
+				return operations, checkGraphQLBalance(braces, parens)
+			}
+			i += end + 1
+			continue
+		case strings.HasPrefix(document[i:], `"""`):
+			end := i + 3
+			for {
+				next := strings.Index(document[end:], `"""`)
+				if next < 0 {
+					return nil, fmt.Errorf("unterminated block string")
+				}
+				end += next
+				if document[end-1] != '\\' {
+					break
+				}
+				end += 3
+			}
+			i = end + 3
+			afterKeyword = false
+			continue
+		case c == '"':
+			end := i + 1
+			for end < len(document) && document[end] != '"' {
+				if document[end] == '\n' {
+					return nil, fmt.Errorf("unterminated string")
+				}
+				if document[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(document) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			i = end + 1
+			afterKeyword = false
+			continue
+		case c == '_' || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z'):
+			end := i + 1
+			for end < len(document) && isGraphQLNameChar(document[end]) {
+				end++
+			}
+			name := document[i:end]
+			i = end
+			if !topLevel {
+				continue
+			}
+			switch {
+			case afterKeyword:
+				operations[len(operations)-1].Name = name
+				afterKeyword = false
+			case !inDefinition && (name == "query" || name == "mutation" || name == "subscription"):
+				operations = append(operations, graphQLOperation{Type: name})
+				inDefinition, afterKeyword = true, true
+			case !inDefinition && name == "fragment":
+				inDefinition = true
+			}
+			continue
+		case c == '{':
+			if topLevel {
+				if !inDefinition {
+					// A bare selection set is an anonymous query.
+					operations = append(operations, graphQLOperation{Type: "query"})
+				}
+				inDefinition = false
+			}
+			braces++
+		case c == '}':
+			braces--
+		case c == '(':
+			parens++
+		case c == ')':
+			parens--
+		}
+		if braces < 0 || parens < 0 {
+			return nil, fmt.Errorf("unbalanced braces or parentheses")
+		}
+		if c != ' ' && c != '\t' && c != '\n' && c != '\r' && c != ',' {
+			afterKeyword = false
+		}
+		i++
+	}
+	return operations, checkGraphQLBalance(braces, parens)
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
+
+func checkGraphQLBalance(braces, parens int) error {
+	if braces != 0 || parens != 0 {
+		return fmt.Errorf("unbalanced braces or parentheses")
+	}
+	return nil
+}
+
+// checkGraphQLPassthroughOperation returns the operation a passthrough query
+// runs, or an error if it may not run. Documents must hold exactly one named
+// operation so the allow-list check cannot be sidestepped by operationName.
+func checkGraphQLPassthroughOperation(query string, allowedOperations []string) (graphQLOperation, error) {
+	operations, err := parseGraphQLOperations(query)
+	if err != nil {
+		return graphQLOperation{}, fmt.Errorf("failed to parse query: %w", err)
+	}
+	switch {
+	case len(operations) == 0:
+		return graphQLOperation{}, fmt.Errorf("query does not define an operation")
+	case len(operations) > 1:
+		return graphQLOperation{}, fmt.Errorf("query defines %d operations; send one operation per call", len(operations))
+	}
+	operation := operations[0]
+	if operation.Name == "" {
+		return graphQLOperation{}, fmt.Errorf("the operation must be named, e.g. \"query ViewerLogin { viewer { login } }\", so it can be checked against the allowed operations")
+	}
+	if !slices.Contains(allowedOperations, operation.Name) {
+		if len(allowedOperations) == 0 {
+			return graphQLOperation{}, fmt.Errorf("operation %q is not allowed: the server allows no operations; start it with --graphql-passthrough-operations", operation.Name)
+		}
+		return graphQLOperation{}, fmt.Errorf("operation %q is not allowed; allowed operations: %s", operation.Name, strings.Join(allowedOperations, ", "))
+	}
+	return operation, nil
+}
+
+// graphQLEndpoint returns the GraphQL endpoint for a REST API base URL:
+// /api/graphql on GitHub Enterprise Server, and /graphql on the API host otherwise.
+func graphQLEndpoint(restBaseURL string) (string, error) {
+	endpoint, err := url.Parse(restBaseURL)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasSuffix(endpoint.Path, "/api/v3/") {
+		endpoint.Path = strings.TrimSuffix(endpoint.Path, "v3/") + "graphql"
+	} else {
+		endpoint.Path = strings.TrimSuffix(endpoint.Path, "/") + "/graphql"
+	}
+	return endpoint.String(), nil
+}
+
+// GraphQLQuery creates a tool that runs a GraphQL operation from the server's
+// allow-list and returns the API's response unchanged.
+func GraphQLQuery(t translations.TranslationHelperFunc, allowedOperations []string) inventory.ServerTool {
+	allowed := "None are allowed."
+	if len(allowedOperations) > 0 {
+		allowed = "Allowed operations: " + strings.Join(allowedOperations, ", ") + "."
+	}
+	return NewTool(
+		// Registration bypasses toolset selection; see GraphQLQueryToolName.
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: GraphQLQueryToolName,
+			Description: t("TOOL_GRAPHQL_QUERY_DESCRIPTION",
+				"Run a GraphQL operation against the GitHub GraphQL API and return the raw data and errors. "+
+					"Prefer the dedicated tools when one fits. The query must contain a single named operation "+
+					"whose name is on the server's allow-list.") + " " + allowed,
+			Annotations: &mcp.ToolAnnotations{
+				Title: t("TOOL_GRAPHQL_QUERY_USER_TITLE", "Run GraphQL query"),
+				// Allowed operations may include mutations.
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"query": {
+						Type:        "string",
+						Description: "GraphQL document containing one named operation, e.g. \"query ViewerLogin { viewer { login } }\"",
+					},
+					"variables": {
+						Type:        "object",
+						Description: "Values for the operation's variables",
+					},
+				},
+				Required: []string{"query"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			query, err := RequiredParam[string](args, "query")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			var variables map[string]any
+			if v, ok := args["variables"]; ok && v != nil {
+				variables, ok = v.(map[string]any)
+				if !ok {
+					return utils.NewToolResultError("variables must be an object"), nil, nil
+				}
+			}
+
+			operation, err := checkGraphQLPassthroughOperation(query, allowedOperations)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			// githubv4 only sends queries built from Go types, so the document is
+			// posted through the REST client, which shares its authentication.
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			endpoint, err := graphQLEndpoint(client.BaseURL())
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to resolve the GraphQL endpoint", err), nil, nil
+			}
+			req, err := client.NewRequest(ctx, http.MethodPost, endpoint, map[string]any{
+				"query":         query,
+				"variables":     variables,
+				"operationName": operation.Name,
+			})
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to create GraphQL request", err), nil, nil
+			}
+
+			var response bytes.Buffer
+			resp, err := client.Do(req, &response)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to run GraphQL query", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := utils.NewToolResultText(response.String())
+			// The operation can read anything the token can, so label the result
+			// with the most restrictive label.
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.PrivateUntrusted())
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,204 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseGraphQLOperations(t *testing.T) {
+	tests := []struct {
+		name        string
+		document    string
+		expected    []graphQLOperation
+		expectedErr string
+	}{
+		{
+			name:     "named query",
+			document: `query ViewerLogin { viewer { login } }`,
+			expected: []graphQLOperation{{Type: "query", Name: "ViewerLogin"}},
+		},
+		{
+			name:     "anonymous shorthand query",
+			document: `{ viewer { login } }`,
+			expected: []graphQLOperation{{Type: "query"}},
+		},
+		{
+			name: "mutation with variables, defaults and directives",
+			document: `mutation AddStar($id: ID!, $input: Opts = {nested: {a: 1}}) @dir(reason: "}") {
+				addStar(input: {starrableId: $id}) { clientMutationId }
+			}`,
+			expected: []graphQLOperation{{Type: "mutation", Name: "AddStar"}},
+		},
+		{
+			name: "fragments, comments and strings are not operations",
+			document: `# query Hidden { viewer { login } }
+			fragment query on User { login }
+			query Repo { repository(owner: "o", name: "{ mutation Fake") { description(format: """mutation X { } \""" """) ...query } }`,
+			expected: []graphQLOperation{{Type: "query", Name: "Repo"}},
+		},
+		{
+			name:     "several operations",
+			document: `query A { viewer { login } } mutation B { x }`,
+			expected: []graphQLOperation{{Type: "query", Name: "A"}, {Type: "mutation", Name: "B"}},
+		},
+		{
+			name:        "unbalanced braces",
+			document:    `query A { viewer { login }`,
+			expectedErr: "unbalanced braces or parentheses",
+		},
+		{
+			name:        "unterminated string",
+			document:    `query A { repository(owner: "o) { id } }`,
+			expectedErr: "unterminated string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			operations, err := parseGraphQLOperations(tc.document)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, operations)
+		})
+	}
+}
+
+func Test_GraphQLEndpoint(t *testing.T) {
+	for base, expected := range map[string]string{
+		"https://api.github.com/":            "https://api.github.com/graphql",
+		"https://api.octocorp.ghe.com/":      "https://api.octocorp.ghe.com/graphql",
+		"https://github.example.com/api/v3/": "https://github.example.com/api/graphql",
+	} {
+		endpoint, err := graphQLEndpoint(base)
+		require.NoError(t, err)
+		assert.Equal(t, expected, endpoint)
+	}
+}
+
+func Test_GraphQLQuery(t *testing.T) {
+	serverTool := GraphQLQuery(translations.NullTranslationHelper, []string{"ViewerLogin", "AddStar"})
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "graphql_query", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.Description, "Allowed operations: ViewerLogin, AddStar.")
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"query"})
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedResult  string
+	}{
+		{
+			name: "runs an allowed operation and returns the raw response",
+			handlers: map[string]http.HandlerFunc{
+				"POST /graphql": expectRequestBody(t, map[string]any{
+					"query":         "query ViewerLogin($n: Int) { viewer { login } }",
+					"variables":     map[string]any{"n": float64(1)},
+					"operationName": "ViewerLogin",
+				}).andThen(mockResponse(t, http.StatusOK, `{"data":{"viewer":{"login":"octocat"}}}`)),
+			},
+			requestArgs: map[string]any{
+				"query":     "query ViewerLogin($n: Int) { viewer { login } }",
+				"variables": map[string]any{"n": float64(1)},
+			},
+			expectedResult: `{"data":{"viewer":{"login":"octocat"}}}`,
+		},
+		{
+			name: "returns GraphQL errors as they are",
+			handlers: map[string]http.HandlerFunc{
+				"POST /graphql": mockResponse(t, http.StatusOK, `{"data":null,"errors":[{"message":"Could not resolve to a node"}]}`),
+			},
+			requestArgs: map[string]any{
+				"query": "mutation AddStar { addStar(input: {starrableId: \"x\"}) { clientMutationId } }",
+			},
+			expectedResult: `{"data":null,"errors":[{"message":"Could not resolve to a node"}]}`,
+		},
+		{
+			name: "rejects an operation that is not allowed",
+			requestArgs: map[string]any{
+				"query": "mutation DeleteRepo { deleteRepository(input: {repositoryId: \"x\"}) { clientMutationId } }",
+			},
+			expectToolError: true,
+			expectedErrMsg:  `operation "DeleteRepo" is not allowed; allowed operations: ViewerLogin, AddStar`,
+		},
+		{
+			name: "rejects an anonymous operation",
+			requestArgs: map[string]any{
+				"query": "{ viewer { login } }",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "the operation must be named",
+		},
+		{
+			name: "rejects several operations",
+			requestArgs: map[string]any{
+				"query": "query ViewerLogin { viewer { login } } mutation DeleteRepo { x }",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "query defines 2 operations",
+		},
+		{
+			name: "reports API errors",
+			handlers: map[string]http.HandlerFunc{
+				"POST /graphql": mockResponse(t, http.StatusUnauthorized, `{"message": "Bad credentials"}`),
+			},
+			requestArgs: map[string]any{
+				"query": "query ViewerLogin { viewer { login } }",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to run GraphQL query",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.JSONEq(t, tc.expectedResult, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_GraphQLQuery_NoAllowedOperations(t *testing.T) {
+	serverTool := GraphQLQuery(translations.NullTranslationHelper, nil)
+	assert.Contains(t, serverTool.Tool.Description, "None are allowed.")
+
+	deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(nil))}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{"query": "query ViewerLogin { viewer { login } }"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	assert.Contains(t, getErrorResult(t, result).Text, "start it with --graphql-passthrough-operations")
+}
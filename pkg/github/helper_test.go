@@ -20,6 +20,9 @@ import (
 // GitHub API endpoint patterns for testing
 // These constants define the URL patterns used in HTTP mocking for tests
 const (
+	// Rate limit endpoints
+	GetRateLimit = "GET /rate_limit"
+
 	// User endpoints
 	GetUser                        = "GET /user"
 	GetUsersByUsername             = "GET /users/{username}"
@@ -28,20 +31,48 @@ const (
 	GetUsersStarredByUsername      = "GET /users/{username}/starred"
 	PutUserStarredByOwnerByRepo    = "PUT /user/starred/{owner}/{repo}"
 	DeleteUserStarredByOwnerByRepo = "DELETE /user/starred/{owner}/{repo}"
+	GetUserMembershipsOrgs         = "GET /user/memberships/orgs"
+	GetUserFollowers               = "GET /user/followers"
+	GetUsersFollowingByUsername    = "GET /users/{username}/following"
+	PutUserFollowingByUsername     = "PUT /user/following/{username}"
+	DeleteUserFollowingByUsername  = "DELETE /user/following/{username}"
+	GetUsersEventsByUsername       = "GET /users/{username}/events"
+	GetUsersEventsPublicByUsername = "GET /users/{username}/events/public"
 
 	// Repository endpoints
-	GetReposByOwnerByRepo                = "GET /repos/{owner}/{repo}"
-	GetReposBranchesByOwnerByRepo        = "GET /repos/{owner}/{repo}/branches"
-	GetReposTagsByOwnerByRepo            = "GET /repos/{owner}/{repo}/tags"
-	GetReposCommitsByOwnerByRepo         = "GET /repos/{owner}/{repo}/commits"
-	GetReposCommitsByOwnerByRepoByRef    = "GET /repos/{owner}/{repo}/commits/{ref}"
-	GetReposContentsByOwnerByRepoByPath  = "GET /repos/{owner}/{repo}/contents/{path}"
-	PutReposContentsByOwnerByRepoByPath  = "PUT /repos/{owner}/{repo}/contents/{path}"
-	PostReposForksByOwnerByRepo          = "POST /repos/{owner}/{repo}/forks"
-	GetReposSubscriptionByOwnerByRepo    = "GET /repos/{owner}/{repo}/subscription"
-	PutReposSubscriptionByOwnerByRepo    = "PUT /repos/{owner}/{repo}/subscription"
-	DeleteReposSubscriptionByOwnerByRepo = "DELETE /repos/{owner}/{repo}/subscription"
-	ListCollaborators                    = "GET /repos/{owner}/{repo}/collaborators"
+	GetReposByOwnerByRepo                 = "GET /repos/{owner}/{repo}"
+	PatchReposByOwnerByRepo               = "PATCH /repos/{owner}/{repo}"
+	GetReposTrafficViewsByOwnerByRepo     = "GET /repos/{owner}/{repo}/traffic/views"
+	GetReposTrafficClonesByOwnerByRepo    = "GET /repos/{owner}/{repo}/traffic/clones"
+	GetReposTrafficPathsByOwnerByRepo     = "GET /repos/{owner}/{repo}/traffic/popular/paths"
+	GetReposTrafficReferrersByOwnerByRepo = "GET /repos/{owner}/{repo}/traffic/popular/referrers"
+	GetReposCommunityProfileByOwnerByRepo = "GET /repos/{owner}/{repo}/community/profile"
+	GetReposBranchesByOwnerByRepo         = "GET /repos/{owner}/{repo}/branches"
+	GetReposBranchesByOwnerByRepoByBranch = "GET /repos/{owner}/{repo}/branches/{branch}"
+	GetReposTagsByOwnerByRepo             = "GET /repos/{owner}/{repo}/tags"
+	GetReposCommitsByOwnerByRepo          = "GET /repos/{owner}/{repo}/commits"
+	GetReposCommitsByOwnerByRepoByRef     = "GET /repos/{owner}/{repo}/commits/{ref}"
+	GetReposContentsByOwnerByRepoByPath   = "GET /repos/{owner}/{repo}/contents/{path}"
+	PutReposContentsByOwnerByRepoByPath   = "PUT /repos/{owner}/{repo}/contents/{path}"
+	PostReposForksByOwnerByRepo           = "POST /repos/{owner}/{repo}/forks"
+	PostReposMergeUpstreamByOwnerByRepo   = "POST /repos/{owner}/{repo}/merge-upstream"
+	GetReposSubscriptionByOwnerByRepo     = "GET /repos/{owner}/{repo}/subscription"
+	PutReposSubscriptionByOwnerByRepo     = "PUT /repos/{owner}/{repo}/subscription"
+	DeleteReposSubscriptionByOwnerByRepo  = "DELETE /repos/{owner}/{repo}/subscription"
+	ListCollaborators                     = "GET /repos/{owner}/{repo}/collaborators"
+	GetReposStargazersByOwnerByRepo       = "GET /repos/{owner}/{repo}/stargazers"
+
+	// Branch protection and ruleset endpoints
+	GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch = "GET /repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks"
+	GetReposRulesBranchesByOwnerByRepoByBranch                          = "GET /repos/{owner}/{repo}/rules/branches/{branch}"
+	GetReposRulesetsByOwnerByRepo                                       = "GET /repos/{owner}/{repo}/rulesets"
+	GetReposRulesetsByOwnerByRepoByRulesetID                            = "GET /repos/{owner}/{repo}/rulesets/{ruleset_id}"
+
+	// Review request endpoints
+	GetReposCollaboratorsByOwnerByRepoByUsername                = "GET /repos/{owner}/{repo}/collaborators/{username}"
+	GetOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo               = "GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}"
+	GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber    = "GET /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
+	DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber = "DELETE /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
 
 	// Git endpoints
 	GetReposGitTreesByOwnerByRepoByTree        = "GET /repos/{owner}/{repo}/git/trees/{tree}"
@@ -52,6 +83,10 @@ const (
 	PostReposGitCommitsByOwnerByRepo           = "POST /repos/{owner}/{repo}/git/commits"
 	GetReposGitTagsByOwnerByRepoByTagSHA       = "GET /repos/{owner}/{repo}/git/tags/{tag_sha}"
 	PostReposGitTreesByOwnerByRepo             = "POST /repos/{owner}/{repo}/git/trees"
+	PostReposGitBlobsByOwnerByRepo             = "POST /repos/{owner}/{repo}/git/blobs"
+	GetReposGitBlobsByOwnerByRepoByFileSHA     = "GET /repos/{owner}/{repo}/git/blobs/{file_sha}"
+	DeleteReposGitRefsByOwnerByRepoByRef       = "DELETE /repos/{owner}/{repo}/git/refs/{ref:.*}"
+	PostReposMergesByOwnerByRepo               = "POST /repos/{owner}/{repo}/merges"
 	GetReposCommitsStatusByOwnerByRepoByRef    = "GET /repos/{owner}/{repo}/commits/{ref}/status"
 	GetReposCommitsStatusesByOwnerByRepoByRef  = "GET /repos/{owner}/{repo}/commits/{ref}/statuses"
 	GetReposCommitsCheckRunsByOwnerByRepoByRef = "GET /repos/{owner}/{repo}/commits/{ref}/check-runs"
@@ -60,8 +95,10 @@ const (
 	GetReposIssuesByOwnerByRepoByIssueNumber                    = "GET /repos/{owner}/{repo}/issues/{issue_number}"
 	GetReposIssuesCommentByOwnerByRepoByCommentID               = "GET /repos/{owner}/{repo}/issues/comments/{comment_id}"
 	GetReposIssuesCommentsByOwnerByRepoByIssueNumber            = "GET /repos/{owner}/{repo}/issues/{issue_number}/comments"
+	GetReposIssuesTimelineByOwnerByRepoByIssueNumber            = "GET /repos/{owner}/{repo}/issues/{issue_number}/timeline"
 	PostReposIssuesByOwnerByRepo                                = "POST /repos/{owner}/{repo}/issues"
 	PostReposIssuesCommentsByOwnerByRepoByIssueNumber           = "POST /repos/{owner}/{repo}/issues/{issue_number}/comments"
+	PostReposIssuesLabelsByOwnerByRepoByIssueNumber             = "POST /repos/{owner}/{repo}/issues/{issue_number}/labels"
 	PostReposIssuesReactionsByOwnerByRepoByIssueNumber          = "POST /repos/{owner}/{repo}/issues/{issue_number}/reactions"
 	PatchReposIssuesByOwnerByRepoByIssueNumber                  = "PATCH /repos/{owner}/{repo}/issues/{issue_number}"
 	GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber           = "GET /repos/{owner}/{repo}/issues/{issue_number}/sub_issues"
@@ -69,14 +106,22 @@ const (
 	DeleteReposIssuesSubIssueByOwnerByRepoByIssueNumber         = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/sub_issue"
 	PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber = "PATCH /repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority"
 	PostReposIssuesCommentsReactionsByOwnerByRepoByCommentID    = "POST /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions"
+	PatchReposIssuesCommentByOwnerByRepoByCommentID             = "PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}"
+	DeleteReposIssuesCommentByOwnerByRepoByCommentID            = "DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}"
 	DeleteReposIssuesIssueFieldValueByOwnerByRepoByIssueNumber  = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/issue-field-values/{issue_field_id}"
 
+	// Label endpoints
+	PostReposLabelsByOwnerByRepo         = "POST /repos/{owner}/{repo}/labels"
+	PatchReposLabelsByOwnerByRepoByName  = "PATCH /repos/{owner}/{repo}/labels/{name}"
+	DeleteReposLabelsByOwnerByRepoByName = "DELETE /repos/{owner}/{repo}/labels/{name}"
+
 	// Pull request endpoints
 	GetReposPullsByOwnerByRepo                                = "GET /repos/{owner}/{repo}/pulls"
 	GetReposPullsByOwnerByRepoByPullNumber                    = "GET /repos/{owner}/{repo}/pulls/{pull_number}"
 	GetReposPullsCommitsByOwnerByRepoByPullNumber             = "GET /repos/{owner}/{repo}/pulls/{pull_number}/commits"
 	GetReposPullsFilesByOwnerByRepoByPullNumber               = "GET /repos/{owner}/{repo}/pulls/{pull_number}/files"
 	GetReposPullsReviewsByOwnerByRepoByPullNumber             = "GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews"
+	PostReposPullsReviewsByOwnerByRepoByPullNumber            = "POST /repos/{owner}/{repo}/pulls/{pull_number}/reviews"
 	PostReposPullsByOwnerByRepo                               = "POST /repos/{owner}/{repo}/pulls"
 	PatchReposPullsByOwnerByRepoByPullNumber                  = "PATCH /repos/{owner}/{repo}/pulls/{pull_number}"
 	PutReposPullsMergeByOwnerByRepoByPullNumber               = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge"
@@ -132,19 +177,51 @@ const (
 	GetReposActionsWorkflowsByOwnerByRepo                        = "GET /repos/{owner}/{repo}/actions/workflows"
 	GetReposActionsWorkflowsByOwnerByRepoByWorkflowID            = "GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}"
 	PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID = "POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches"
+	PutReposActionsWorkflowsEnableByOwnerByRepoByWorkflowID      = "PUT /repos/{owner}/{repo}/actions/workflows/{workflow_id}/enable"
+	PutReposActionsWorkflowsDisableByOwnerByRepoByWorkflowID     = "PUT /repos/{owner}/{repo}/actions/workflows/{workflow_id}/disable"
+	GetReposActionsPermissionsByOwnerByRepo                      = "GET /repos/{owner}/{repo}/actions/permissions"
+	GetReposActionsPermissionsSelectedActionsByOwnerByRepo       = "GET /repos/{owner}/{repo}/actions/permissions/selected-actions"
+	GetReposActionsPermissionsWorkflowByOwnerByRepo              = "GET /repos/{owner}/{repo}/actions/permissions/workflow"
+	GetReposActionsOIDCCustomizationSubByOwnerByRepo             = "GET /repos/{owner}/{repo}/actions/oidc/customization/sub"
+	GetReposActionsCachesByOwnerByRepo                           = "GET /repos/{owner}/{repo}/actions/caches"
+	DeleteReposActionsCachesByOwnerByRepo                        = "DELETE /repos/{owner}/{repo}/actions/caches"
+	DeleteReposActionsCachesByOwnerByRepoByCacheID               = "DELETE /repos/{owner}/{repo}/actions/caches/{cache_id}"
 	GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowID        = "GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs"
 	GetReposActionsRunsByOwnerByRepo                             = "GET /repos/{owner}/{repo}/actions/runs"
 	GetReposActionsRunsByOwnerByRepoByRunID                      = "GET /repos/{owner}/{repo}/actions/runs/{run_id}"
 	GetReposActionsRunsLogsByOwnerByRepoByRunID                  = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
 	GetReposActionsRunsJobsByOwnerByRepoByRunID                  = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs"
 	GetReposActionsRunsArtifactsByOwnerByRepoByRunID             = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/artifacts"
+	GetReposActionsArtifactsByOwnerByRepoByArtifactID            = "GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}"
+	GetReposActionsArtifactsZipByOwnerByRepoByArtifactID         = "GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/zip"
 	GetReposActionsRunsTimingByOwnerByRepoByRunID                = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/timing"
 	PostReposActionsRunsRerunByOwnerByRepoByRunID                = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun"
 	PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunID      = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs"
 	PostReposActionsRunsCancelByOwnerByRepoByRunID               = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/cancel"
+	PostReposActionsJobsRerunByOwnerByRepoByJobID                = "POST /repos/{owner}/{repo}/actions/jobs/{job_id}/rerun"
 	GetReposActionsJobsLogsByOwnerByRepoByJobID                  = "GET /repos/{owner}/{repo}/actions/jobs/{job_id}/logs"
 	DeleteReposActionsRunsLogsByOwnerByRepoByRunID               = "DELETE /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
 
+	// Deployment endpoints
+	GetReposDeploymentsByOwnerByRepo                        = "GET /repos/{owner}/{repo}/deployments"
+	GetReposDeploymentsStatusesByOwnerByRepoByDeploymentID  = "GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses"
+	PostReposDeploymentsByOwnerByRepo                       = "POST /repos/{owner}/{repo}/deployments"
+	PostReposDeploymentsStatusesByOwnerByRepoByDeploymentID = "POST /repos/{owner}/{repo}/deployments/{deployment_id}/statuses"
+
+	// Organization endpoints
+	GetOrgsByOrg       = "GET /orgs/{org}"
+	GetOrgsEventsByOrg = "GET /orgs/{org}/events"
+
+	// Team endpoints
+	PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername    = "PUT /orgs/{org}/teams/{team_slug}/memberships/{username}"
+	DeleteOrgsTeamsMembershipsByOrgByTeamSlugByUsername = "DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}"
+
+	// Markdown endpoints
+	PostMarkdown = "POST /markdown"
+
+	// Emoji endpoints
+	GetEmojis = "GET /emojis"
+
 	// Search endpoints
 	GetSearchCode         = "GET /search/code"
 	GetSearchIssues       = "GET /search/issues"
@@ -207,6 +284,15 @@ func mustNewGHClient(t *testing.T, httpClient *http.Client) *gogithub.Client {
 	return client
 }
 
+// mustNewAuthenticatedGHClient is mustNewGHClient with a token, for tests
+// that check where credentials are sent.
+func mustNewAuthenticatedGHClient(t *testing.T, httpClient *http.Client) *gogithub.Client {
+	t.Helper()
+	client, err := gogithub.NewClient(gogithub.WithHTTPClient(httpClient), gogithub.WithAuthToken("test-token"))
+	require.NoError(t, err)
+	return client
+}
+
 // expect is a helper function to create a partial mock that expects various
 // request behaviors, such as path, query parameters, and request body.
 func expect(t *testing.T, e expectations) *partialMock {
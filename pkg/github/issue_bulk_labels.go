@@ -0,0 +1,196 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	defaultBulkLabelMaxIssues = 30
+	maxBulkLabelIssues        = 100
+)
+
+// BulkAddLabelsDetails describes a bulk_add_labels operation as a whole.
+type BulkAddLabelsDetails struct {
+	Labels []string `json:"labels"`
+	// TotalMatches is the number of issues matching the search query, which
+	// may exceed the number labeled when Capped is set.
+	TotalMatches int  `json:"total_matches,omitempty"`
+	Capped       bool `json:"capped,omitempty"`
+}
+
+// BulkAddLabels creates a tool to add labels to many issues of a repository at once.
+func BulkAddLabels(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "bulk_add_labels",
+			Description: t("TOOL_BULK_ADD_LABELS_DESCRIPTION",
+				"Add labels to many issues or pull requests of a repository in one call, selected either by an issue search query or by an explicit list of numbers. "+
+					"Existing labels are kept. At most 'max_issues' issues are labeled; the response reports success or failure for each issue, and whether more issues matched the query than were labeled. "+
+					"The query is always limited to the given repository and uses the same syntax as search_issues."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:          t("TOOL_BULK_ADD_LABELS_USER_TITLE", "Add labels to multiple issues"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"labels": {
+						Type:        "array",
+						Description: "Labels to add to each issue",
+						MinItems:    jsonschema.Ptr(1),
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"query": {
+						Type:        "string",
+						Description: "Issue search query selecting the issues to label, e.g. 'is:open no:label crash'. Cannot be combined with 'issue_numbers'.",
+					},
+					"issue_numbers": {
+						Type:        "array",
+						Description: "Numbers of the issues to label. Cannot be combined with 'query'.",
+						Items: &jsonschema.Schema{
+							Type: "number",
+						},
+					},
+					"max_issues": {
+						Type:        "number",
+						Description: "Maximum number of issues to label",
+						Default:     json.RawMessage(strconv.Itoa(defaultBulkLabelMaxIssues)),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(maxBulkLabelIssues)),
+					},
+				},
+				Required: []string{"owner", "repo", "labels"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			labels, err := OptionalStringArrayParam(args, "labels")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(labels) == 0 {
+				return utils.NewToolResultError("labels must contain at least one label"), nil, nil
+			}
+			query, err := OptionalParam[string](args, "query")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumbers, err := parseIssueNumbers(args["issue_numbers"])
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxIssues, err := OptionalIntParamWithDefault(args, "max_issues", defaultBulkLabelMaxIssues)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxIssues < 1 || maxIssues > maxBulkLabelIssues {
+				return utils.NewToolResultError(fmt.Sprintf("max_issues must be between 1 and %d", maxBulkLabelIssues)), nil, nil
+			}
+			if (query == "") == (len(issueNumbers) == 0) {
+				return utils.NewToolResultError("exactly one of 'query' or 'issue_numbers' must be provided"), nil, nil
+			}
+			if len(issueNumbers) > maxIssues {
+				return utils.NewToolResultError(fmt.Sprintf("%d issue numbers given, but max_issues is %d", len(issueNumbers), maxIssues)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			details := BulkAddLabelsDetails{Labels: labels}
+			if query != "" {
+				repoFilter := owner + "/" + repo
+				if hasRepoFilter(query) && !hasSpecificFilter(query, "repo", repoFilter) {
+					return utils.NewToolResultError(fmt.Sprintf("query may only search %s", repoFilter)), nil, nil
+				}
+				if !hasRepoFilter(query) {
+					query = "repo:" + repoFilter + " " + query
+				}
+				result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{
+					ListOptions: github.ListOptions{PerPage: maxIssues},
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to search issues", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+
+				for _, issue := range result.Issues {
+					issueNumbers = append(issueNumbers, issue.GetNumber())
+				}
+				details.TotalMatches = result.GetTotal()
+				details.Capped = result.GetTotal() > len(issueNumbers)
+			}
+
+			// Labels are added one issue at a time: GitHub's secondary rate
+			// limits penalize concurrent writes far more than serial ones.
+			items := make([]utils.PartialItem, 0, len(issueNumbers))
+			for _, number := range issueNumbers {
+				item := utils.PartialItem{Target: number, Success: true}
+				_, resp, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				if err != nil {
+					item.Success = false
+					item.Error = err.Error()
+				}
+				items = append(items, item)
+			}
+
+			return utils.NewToolResultPartial(items, details), nil, nil
+		},
+	)
+}
+
+// parseIssueNumbers validates an optional array of issue numbers.
+func parseIssueNumbers(raw any) ([]int, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("issue_numbers must be an array of numbers")
+	}
+	numbers := make([]int, 0, len(items))
+	for _, item := range items {
+		f, ok := item.(float64)
+		if !ok || f < 1 || f != float64(int(f)) {
+			return nil, fmt.Errorf("issue_numbers must contain positive whole numbers, got %v", item)
+		}
+		numbers = append(numbers, int(f))
+	}
+	return numbers, nil
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bulkAddLabelsResponse is the partial result of bulk_add_labels decoded
+// with its concrete target and details types.
+type bulkAddLabelsResponse struct {
+	Summary   string `json:"summary"`
+	Total     int    `json:"total"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+	Items     []struct {
+		Target  int    `json:"target"`
+		Success bool   `json:"success"`
+		Error   string `json:"error,omitempty"`
+	} `json:"items"`
+	Details BulkAddLabelsDetails `json:"details"`
+}
+
+func Test_BulkAddLabels(t *testing.T) {
+	serverTool := BulkAddLabels(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "bulk_add_labels", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "query")
+	assert.Contains(t, schema.Properties, "issue_numbers")
+	assert.Contains(t, schema.Properties, "max_issues")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "labels"})
+
+	// Issue 3 is locked, so adding labels to it fails.
+	addLabelsHandler := expectRequestBody(t, []any{"triage", "bug"}).andThen(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/issues/3/labels") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Issue is locked"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"name": "triage"}, {"name": "bug"}]`))
+	})
+
+	tests := []struct {
+		name             string
+		requestArgs      map[string]any
+		handlers         map[string]http.HandlerFunc
+		expectToolError  bool
+		expectedErrMsg   string
+		expectedResponse string
+	}{
+		{
+			name: "label explicit issue numbers",
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"labels":        []any{"triage", "bug"},
+				"issue_numbers": []any{float64(1), float64(3)},
+			},
+			handlers: map[string]http.HandlerFunc{
+				PostReposIssuesLabelsByOwnerByRepoByIssueNumber: addLabelsHandler,
+			},
+			expectedResponse: `{
+				"summary": "1 succeeded, 1 failed",
+				"total": 2,
+				"succeeded": 1,
+				"failed": 1,
+				"items": [
+					{"target": 1, "success": true},
+					{"target": 3, "success": false, "error": "403 Issue is locked"}
+				],
+				"details": {"labels": ["triage", "bug"]}
+			}`,
+		},
+		{
+			name: "label issues matching a search query",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"labels":     []any{"triage", "bug"},
+				"query":      "is:open no:label",
+				"max_issues": float64(2),
+			},
+			handlers: map[string]http.HandlerFunc{
+				GetSearchIssues: expectQueryParams(t, map[string]string{
+					"q":        "repo:owner/repo is:open no:label",
+					"per_page": "2",
+				}).andThen(mockResponse(t, http.StatusOK, &github.IssuesSearchResult{
+					Total: github.Ptr(5),
+					Issues: []*github.Issue{
+						{Number: github.Ptr(4)},
+						{Number: github.Ptr(7)},
+					},
+				})),
+				PostReposIssuesLabelsByOwnerByRepoByIssueNumber: addLabelsHandler,
+			},
+			expectedResponse: `{
+				"summary": "2 succeeded, 0 failed",
+				"total": 2,
+				"succeeded": 2,
+				"failed": 0,
+				"items": [
+					{"target": 4, "success": true},
+					{"target": 7, "success": true}
+				],
+				"details": {"labels": ["triage", "bug"], "total_matches": 5, "capped": true}
+			}`,
+		},
+		{
+			name: "query for another repository",
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"labels": []any{"bug"},
+				"query":  "repo:other/repo is:open",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "query may only search owner/repo",
+		},
+		{
+			name: "both query and issue numbers",
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"labels":        []any{"bug"},
+				"query":         "is:open",
+				"issue_numbers": []any{float64(1)},
+			},
+			expectToolError: true,
+			expectedErrMsg:  "exactly one of 'query' or 'issue_numbers' must be provided",
+		},
+		{
+			name: "more issue numbers than max_issues",
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"labels":        []any{"bug"},
+				"issue_numbers": []any{float64(1), float64(2)},
+				"max_issues":    float64(1),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "2 issue numbers given, but max_issues is 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var expected, response bulkAddLabelsResponse
+			require.NoError(t, json.Unmarshal([]byte(tc.expectedResponse), &expected))
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			// Errors embed the request URL; only their GitHub message matters here.
+			for i := range response.Items {
+				if i < len(expected.Items) && expected.Items[i].Error != "" {
+					assert.Contains(t, response.Items[i].Error, expected.Items[i].Error)
+					response.Items[i].Error = expected.Items[i].Error
+				}
+			}
+			assert.Equal(t, expected, response)
+		})
+	}
+}
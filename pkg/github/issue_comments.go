@@ -0,0 +1,396 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// defaultCommentBodyMaxBytes is the default per-comment body budget of
+// list_issue_comments.
+const defaultCommentBodyMaxBytes = 2000
+
+// IssueCommentSummary is one comment in the output of list_issue_comments.
+type IssueCommentSummary struct {
+	ID     int64  `json:"id"`
+	Author string `json:"author,omitempty"`
+	// AuthorAssociation is the author's relationship to the repository, such
+	// as OWNER, MEMBER, CONTRIBUTOR or NONE.
+	AuthorAssociation string `json:"author_association"`
+	Body              string `json:"body"`
+	BodyTruncated     bool   `json:"body_truncated,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+	IsMinimized       bool   `json:"is_minimized"`
+	// MinimizedReason is why the comment was hidden, such as spam, abuse,
+	// off-topic, outdated, duplicate or resolved.
+	MinimizedReason string `json:"minimized_reason,omitempty"`
+	URL             string `json:"url"`
+}
+
+// IssueCommentsResponse is the output of list_issue_comments.
+type IssueCommentsResponse struct {
+	Comments   []IssueCommentSummary `json:"comments"`
+	TotalCount int                   `json:"totalCount"`
+	PageInfo   MinimalPageInfo       `json:"pageInfo"`
+}
+
+type issueCommentNode struct {
+	DatabaseID int64
+	Author     struct {
+		Login githubv4.String
+	}
+	AuthorAssociation githubv4.String
+	Body              githubv4.String
+	CreatedAt         githubv4.DateTime
+	UpdatedAt         githubv4.DateTime
+	IsMinimized       githubv4.Boolean
+	MinimizedReason   githubv4.String
+	URL               githubv4.URI
+}
+
+type listIssueCommentsQuery struct {
+	Repository struct {
+		Issue struct {
+			Comments struct {
+				Nodes    []issueCommentNode
+				PageInfo struct {
+					HasNextPage     githubv4.Boolean
+					HasPreviousPage githubv4.Boolean
+					StartCursor     githubv4.String
+					EndCursor       githubv4.String
+				}
+				TotalCount int
+			} `graphql:"comments(first: $first, after: $after)"`
+		} `graphql:"issue(number: $number)"`
+		IsPrivate githubv4.Boolean
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ListIssueComments creates a tool to list the comments on an issue together
+// with each author's association and whether the comment was hidden.
+func ListIssueComments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "The owner of the repository",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "The name of the repository",
+			},
+			"issue_number": {
+				Type:        "number",
+				Description: "The number of the issue",
+			},
+			"max_body_bytes": {
+				Type:        "number",
+				Description: "Maximum bytes of each comment body to return. Longer bodies are cut off and flagged with body_truncated",
+				Minimum:     jsonschema.Ptr(1.0),
+				Default:     json.RawMessage(strconv.Itoa(defaultCommentBodyMaxBytes)),
+			},
+		},
+		Required: []string{"owner", "repo", "issue_number"},
+	}
+	WithCursorPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "list_issue_comments",
+			Description: t("TOOL_LIST_ISSUE_COMMENTS_DESCRIPTION",
+				"List the comments on an issue, oldest first, with each author's association to the repository (OWNER, MEMBER, CONTRIBUTOR, NONE, ...) "+
+					"and whether the comment was minimized (hidden as spam, off-topic, outdated and so on). Skip minimized comments unless they matter. "+
+					"For pagination, use the 'endCursor' from the previous response's 'pageInfo' in the 'after' parameter."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_COMMENTS_USER_TITLE", "List issue comments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxBodyBytes, err := OptionalIntParamWithDefault(args, "max_body_bytes", defaultCommentBodyMaxBytes)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxBodyBytes < 1 {
+				return utils.NewToolResultError("max_body_bytes must be at least 1"), nil, nil
+			}
+			pagination, err := OptionalCursorPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
+			}
+
+			vars := map[string]any{
+				"owner":  githubv4.String(owner),
+				"repo":   githubv4.String(repo),
+				"number": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+				"first":  githubv4.Int(*paginationParams.First),
+				"after":  (*githubv4.String)(nil),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.NewString(githubv4.String(*paginationParams.After))
+			}
+
+			var query listIssueCommentsQuery
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list issue comments", err), nil, nil
+			}
+
+			nodes := query.Repository.Issue.Comments.Nodes
+			if deps.GetFlags(ctx).LockdownMode {
+				cache, err := deps.GetRepoAccessCache(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+				}
+				if cache == nil {
+					return nil, nil, fmt.Errorf("lockdown cache is not configured")
+				}
+				safeNodes := make([]issueCommentNode, 0, len(nodes))
+				for _, node := range nodes {
+					login := string(node.Author.Login)
+					if login == "" {
+						continue
+					}
+					isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+					if err != nil {
+						return utils.NewToolResultError(fmt.Sprintf("failed to check lockdown mode: %v", err)), nil, nil
+					}
+					if isSafeContent {
+						safeNodes = append(safeNodes, node)
+					}
+				}
+				nodes = safeNodes
+			}
+
+			comments := query.Repository.Issue.Comments
+			response := IssueCommentsResponse{
+				Comments:   make([]IssueCommentSummary, 0, len(nodes)),
+				TotalCount: comments.TotalCount,
+				PageInfo: MinimalPageInfo{
+					HasNextPage:     bool(comments.PageInfo.HasNextPage),
+					HasPreviousPage: bool(comments.PageInfo.HasPreviousPage),
+					StartCursor:     string(comments.PageInfo.StartCursor),
+					EndCursor:       string(comments.PageInfo.EndCursor),
+				},
+			}
+			for _, node := range nodes {
+				summary := IssueCommentSummary{
+					ID:                node.DatabaseID,
+					Author:            string(node.Author.Login),
+					AuthorAssociation: string(node.AuthorAssociation),
+					CreatedAt:         node.CreatedAt.Format(time.RFC3339),
+					UpdatedAt:         node.UpdatedAt.Format(time.RFC3339),
+					IsMinimized:       bool(node.IsMinimized),
+					MinimizedReason:   string(node.MinimizedReason),
+					URL:               node.URL.String(),
+				}
+				summary.Body, summary.BodyTruncated = truncatePatch(sanitize.Sanitize(string(node.Body)), maxBodyBytes)
+				response.Comments = append(response.Comments, summary)
+			}
+
+			result := MarshalledTextResult(response)
+			return attachStaticIFCLabel(ctx, deps, result, ifc.LabelRepoUserContent(bool(query.Repository.IsPrivate))), nil, nil
+		},
+	)
+}
+
+// UpdateIssueComment creates a tool to replace the body of an issue comment.
+func UpdateIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "update_issue_comment",
+			Description: t("TOOL_UPDATE_ISSUE_COMMENT_DESCRIPTION", "Replace the body of an existing issue or pull request comment. Use the comment's numeric ID, as returned by list_issue_comments or add_issue_comment."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPDATE_ISSUE_COMMENT_USER_TITLE", "Update issue comment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"comment_id": {
+						Type:        "number",
+						Description: "The numeric ID of the comment to update",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"body": {
+						Type:        "string",
+						Description: "New comment content. Replaces the existing body",
+					},
+				},
+				Required: []string{"owner", "repo", "comment_id", "body"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentID, err := RequiredBigInt(args, "comment_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if commentID < 1 {
+				return utils.NewToolResultError("comment_id must be greater than 0"), nil, nil
+			}
+			body, err := RequiredParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			comment, resp, err := client.Issues.EditComment(ctx, owner, repo, commentID, &github.IssueComment{
+				Body: github.Ptr(body),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update comment", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				bodyBytes, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update comment", resp, bodyBytes), nil, nil
+			}
+
+			return MarshalledTextResult(MinimalResponse{
+				ID:  fmt.Sprintf("%d", comment.GetID()),
+				URL: comment.GetHTMLURL(),
+			}), nil, nil
+		},
+	)
+}
+
+// DeleteIssueComment creates a tool to delete an issue comment.
+func DeleteIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "delete_issue_comment",
+			Description: t("TOOL_DELETE_ISSUE_COMMENT_DESCRIPTION", "Permanently delete an issue or pull request comment. This cannot be undone; consider minimizing the comment instead if it only needs to be hidden."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DELETE_ISSUE_COMMENT_USER_TITLE", "Delete issue comment"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"comment_id": {
+						Type:        "number",
+						Description: "The numeric ID of the comment to delete",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"owner", "repo", "comment_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentID, err := RequiredBigInt(args, "comment_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if commentID < 1 {
+				return utils.NewToolResultError("comment_id must be greater than 0"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			resp, err := client.Issues.DeleteComment(ctx, owner, repo, commentID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete comment", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				bodyBytes, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to delete comment", resp, bodyBytes), nil, nil
+			}
+
+			return utils.NewToolResultText(fmt.Sprintf("Deleted comment %d", commentID)), nil, nil
+		},
+	)
+}
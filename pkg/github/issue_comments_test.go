@@ -0,0 +1,320 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListIssueComments(t *testing.T) {
+	serverTool := ListIssueComments(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_comments", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "max_body_bytes")
+	assert.Contains(t, schema.Properties, "after")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number"})
+
+	const query = "query($after:String$first:Int!$number:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){issue(number: $number){comments(first: $first, after: $after){nodes{databaseId,author{login},authorAssociation,body,createdAt,updatedAt,isMinimized,minimizedReason,url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}},isPrivate}}"
+	response := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"issue": map[string]any{
+				"comments": map[string]any{
+					"nodes": []map[string]any{
+						{
+							"databaseId":        101,
+							"author":            map[string]any{"login": "maintainer"},
+							"authorAssociation": "OWNER",
+							"body":              "Thanks, confirmed.",
+							"createdAt":         "2026-04-01T10:00:00Z",
+							"updatedAt":         "2026-04-01T10:00:00Z",
+							"isMinimized":       false,
+							"minimizedReason":   nil,
+							"url":               "https://github.com/owner/repo/issues/7#issuecomment-101",
+						},
+						{
+							"databaseId":        102,
+							"author":            map[string]any{"login": "spammer"},
+							"authorAssociation": "NONE",
+							"body":              "Buy now héhé",
+							"createdAt":         "2026-04-02T10:00:00Z",
+							"updatedAt":         "2026-04-03T10:00:00Z",
+							"isMinimized":       true,
+							"minimizedReason":   "spam",
+							"url":               "https://github.com/owner/repo/issues/7#issuecomment-102",
+						},
+					},
+					"pageInfo": map[string]any{
+						"hasNextPage":     true,
+						"hasPreviousPage": false,
+						"startCursor":     "c1",
+						"endCursor":       "c2",
+					},
+					"totalCount": 5,
+				},
+			},
+			"isPrivate": false,
+		},
+	})
+
+	tests := []struct {
+		name             string
+		requestArgs      map[string]any
+		vars             map[string]any
+		expectToolError  bool
+		expectedErrMsg   string
+		expectedComments []IssueCommentSummary
+	}{
+		{
+			name: "comments with association and minimized state",
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"issue_number":   float64(7),
+				"perPage":        float64(2),
+				"after":          "c0",
+				"max_body_bytes": float64(10),
+			},
+			vars: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"number": float64(7),
+				"first":  float64(2),
+				"after":  "c0",
+			},
+			expectedComments: []IssueCommentSummary{
+				{
+					ID: 101, Author: "maintainer", AuthorAssociation: "OWNER",
+					Body: "Thanks, co", BodyTruncated: true,
+					CreatedAt: "2026-04-01T10:00:00Z", UpdatedAt: "2026-04-01T10:00:00Z",
+					URL: "https://github.com/owner/repo/issues/7#issuecomment-101",
+				},
+				{
+					// "Buy now hé" would end inside the two-byte "é".
+					ID: 102, Author: "spammer", AuthorAssociation: "NONE",
+					Body: "Buy now h", BodyTruncated: true,
+					CreatedAt: "2026-04-02T10:00:00Z", UpdatedAt: "2026-04-03T10:00:00Z",
+					IsMinimized: true, MinimizedReason: "spam",
+					URL: "https://github.com/owner/repo/issues/7#issuecomment-102",
+				},
+			},
+		},
+		{
+			name: "rejects a negative body budget",
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"issue_number":   float64(7),
+				"max_body_bytes": float64(-1),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "max_body_bytes must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := githubv4mock.NewQueryMatcher(query, tc.vars, response)
+			deps := BaseDeps{
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher)),
+				Flags:     stubFeatureFlags(map[string]bool{}),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var got IssueCommentsResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, tc.expectedComments, got.Comments)
+			assert.Equal(t, 5, got.TotalCount)
+			assert.True(t, got.PageInfo.HasNextPage)
+			assert.Equal(t, "c2", got.PageInfo.EndCursor)
+		})
+	}
+}
+
+func Test_UpdateIssueComment(t *testing.T) {
+	serverTool := UpdateIssueComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_issue_comment", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "comment_id", "body"})
+
+	updated := &github.IssueComment{
+		ID:      github.Ptr(int64(123)),
+		Body:    github.Ptr("Edited"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/7#issuecomment-123"),
+	}
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "updates the comment body",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposIssuesCommentByOwnerByRepoByCommentID: expectRequestBody(t, map[string]any{
+					"body": "Edited",
+				}).andThen(mockResponse(t, http.StatusOK, updated)),
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+				"body":       "Edited",
+			},
+		},
+		{
+			name: "missing body",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: body",
+		},
+		{
+			name: "comment not found",
+			handlers: map[string]http.HandlerFunc{
+				PatchReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(999),
+				"body":       "Edited",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var got MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, "123", got.ID)
+			assert.Equal(t, updated.GetHTMLURL(), got.URL)
+		})
+	}
+}
+
+func Test_DeleteIssueComment(t *testing.T) {
+	serverTool := DeleteIssueComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_issue_comment", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "comment_id"})
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		commentID      any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "deletes the comment",
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposIssuesCommentByOwnerByRepoByCommentID: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			},
+			commentID: float64(123),
+		},
+		{
+			name:           "rejects a non-positive comment ID",
+			commentID:      float64(-1),
+			expectError:    true,
+			expectedErrMsg: "comment_id must be greater than 0",
+		},
+		{
+			name: "comment not found",
+			handlers: map[string]http.HandlerFunc{
+				DeleteReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			commentID:      float64(999),
+			expectError:    true,
+			expectedErrMsg: "failed to delete comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": tc.commentID,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, "Deleted comment 123", getTextResult(t, result).Text)
+		})
+	}
+}
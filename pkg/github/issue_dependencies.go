@@ -81,7 +81,7 @@ Options are:
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
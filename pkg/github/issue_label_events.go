@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxLabelEventTimelinePages bounds how much of an issue timeline
+// list_issue_label_events scans. Label events are usually a small fraction
+// of the timeline, so it is read in full up to this many pages of 100.
+const maxLabelEventTimelinePages = 10
+
+// LabelEvent is a label being added to or removed from an issue.
+type LabelEvent struct {
+	// Event is "labeled" or "unlabeled".
+	Event     string `json:"event"`
+	Label     string `json:"label"`
+	Actor     string `json:"actor,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// LabelEventsResponse is the output of list_issue_label_events.
+type LabelEventsResponse struct {
+	Events []LabelEvent `json:"events"`
+	// Truncated reports that the timeline was longer than the part that was
+	// scanned, so older label changes may be missing.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ListIssueLabelEvents creates a tool to list the label changes of an issue.
+func ListIssueLabelEvents(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "list_issue_label_events",
+			Description: t("TOOL_LIST_ISSUE_LABEL_EVENTS_DESCRIPTION",
+				"List when labels were added to or removed from an issue or pull request, and by whom, oldest first. "+
+					"Only labeled/unlabeled events are returned, which makes this much smaller than the full issue timeline."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_LABEL_EVENTS_USER_TITLE", "List issue label events"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue or pull request",
+					},
+					"label": {
+						Type:        "string",
+						Description: "Only return events for this label name (case-insensitive)",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			label, err := OptionalParam[string](args, "label")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			response := LabelEventsResponse{Events: []LabelEvent{}}
+			opts := &github.ListOptions{PerPage: 100}
+			for page := 0; ; page++ {
+				if page == maxLabelEventTimelinePages {
+					response.Truncated = true
+					break
+				}
+				timeline, resp, err := client.Issues.ListIssueTimeline(ctx, owner, repo, issueNumber, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue timeline", resp, err), nil, nil
+				}
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					_ = resp.Body.Close()
+					if err != nil {
+						return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list issue timeline", resp, body), nil, nil
+				}
+				_ = resp.Body.Close()
+
+				for _, item := range timeline {
+					event := item.GetEvent()
+					if event != "labeled" && event != "unlabeled" {
+						continue
+					}
+					if label != "" && !strings.EqualFold(item.GetLabel().GetName(), label) {
+						continue
+					}
+					labelEvent := LabelEvent{
+						Event: event,
+						Label: item.GetLabel().GetName(),
+						Actor: item.GetActor().GetLogin(),
+					}
+					if item.CreatedAt != nil {
+						labelEvent.CreatedAt = item.CreatedAt.Format(time.RFC3339)
+					}
+					response.Events = append(response.Events, labelEvent)
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			result := MarshalledTextResult(response)
+			// Only users with triage access can change labels, so label events
+			// reflect maintainer decisions rather than outsider input.
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListIssueLabelEvents(t *testing.T) {
+	serverTool := ListIssueLabelEvents(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_label_events", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "label")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number"})
+
+	createdAt := &github.Timestamp{Time: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	firstPage := []*github.Timeline{
+		{Event: github.Ptr("labeled"), Label: &github.Label{Name: github.Ptr("bug")}, Actor: &github.User{Login: github.Ptr("alice")}, CreatedAt: createdAt},
+		{Event: github.Ptr("commented"), Body: github.Ptr("looks like a bug")},
+		{Event: github.Ptr("labeled"), Label: &github.Label{Name: github.Ptr("p1")}, Actor: &github.User{Login: github.Ptr("bob")}, CreatedAt: createdAt},
+	}
+	secondPage := []*github.Timeline{
+		{Event: github.Ptr("unlabeled"), Label: &github.Label{Name: github.Ptr("Bug")}, Actor: &github.User{Login: github.Ptr("carol")}, CreatedAt: createdAt},
+		{Event: github.Ptr("closed"), Actor: &github.User{Login: github.Ptr("carol")}},
+	}
+
+	timelineHandler := func(w http.ResponseWriter, r *http.Request) {
+		page := firstPage
+		if r.URL.Query().Get("page") == "2" {
+			page = secondPage
+		} else {
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/issues/1/timeline?page=2>; rel="next"`)
+		}
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		b, err := json.Marshal(page)
+		require.NoError(t, err)
+		_, _ = w.Write(b)
+	}
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		handlers       map[string]http.HandlerFunc
+		expectError    bool
+		expectedErrMsg string
+		expectedEvents []LabelEvent
+	}{
+		{
+			name: "label events across pages",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(1),
+			},
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesTimelineByOwnerByRepoByIssueNumber: timelineHandler,
+			},
+			expectedEvents: []LabelEvent{
+				{Event: "labeled", Label: "bug", Actor: "alice", CreatedAt: "2026-03-01T12:00:00Z"},
+				{Event: "labeled", Label: "p1", Actor: "bob", CreatedAt: "2026-03-01T12:00:00Z"},
+				{Event: "unlabeled", Label: "Bug", Actor: "carol", CreatedAt: "2026-03-01T12:00:00Z"},
+			},
+		},
+		{
+			name: "filter by label name",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(1),
+				"label":        "BUG",
+			},
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesTimelineByOwnerByRepoByIssueNumber: timelineHandler,
+			},
+			expectedEvents: []LabelEvent{
+				{Event: "labeled", Label: "bug", Actor: "alice", CreatedAt: "2026-03-01T12:00:00Z"},
+				{Event: "unlabeled", Label: "Bug", Actor: "carol", CreatedAt: "2026-03-01T12:00:00Z"},
+			},
+		},
+		{
+			name: "issue not found",
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesTimelineByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list issue timeline",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response LabelEventsResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedEvents, response.Events)
+			assert.False(t, response.Truncated)
+		})
+	}
+}
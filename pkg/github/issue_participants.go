@@ -0,0 +1,184 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxParticipantCommentPages bounds how many pages of 100 comments
+// list_issue_participants reads.
+const maxParticipantCommentPages = 10
+
+// IssueParticipant is one person involved in an issue.
+type IssueParticipant struct {
+	Login string `json:"login"`
+	// Roles holds "author", "assignee" and "commenter", in that order, for
+	// each that applies.
+	Roles        []string `json:"roles"`
+	CommentCount int      `json:"comment_count"`
+}
+
+// IssueParticipantsResponse is the output of list_issue_participants.
+type IssueParticipantsResponse struct {
+	Participants []IssueParticipant `json:"participants"`
+	// Truncated reports that the issue had more comments than were read, so
+	// comment counts may be low and later commenters missing.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ListIssueParticipants creates a tool to list who is involved in an issue.
+func ListIssueParticipants(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "list_issue_participants",
+			Description: t("TOOL_LIST_ISSUE_PARTICIPANTS_DESCRIPTION",
+				"List the people involved in an issue or pull request: its author, its assignees and everyone who commented, with how many comments each wrote. "+
+					"The author comes first, then assignees, then other commenters in the order they first commented. "+
+					"Use this to find out who is involved without reading every comment."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_PARTICIPANTS_USER_TITLE", "List issue participants"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue or pull request",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			participants := newIssueParticipantList()
+			participants.add(issue.GetUser().GetLogin(), "author")
+			for _, assignee := range issue.Assignees {
+				participants.add(assignee.GetLogin(), "assignee")
+			}
+
+			truncated := false
+			opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+			for page := 0; ; page++ {
+				if page == maxParticipantCommentPages {
+					truncated = true
+					break
+				}
+				comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue comments", resp, err), nil, nil
+				}
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					_ = resp.Body.Close()
+					if err != nil {
+						return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list issue comments", resp, body), nil, nil
+				}
+				_ = resp.Body.Close()
+
+				for _, comment := range comments {
+					if participant := participants.add(comment.GetUser().GetLogin(), "commenter"); participant != nil {
+						participant.CommentCount++
+					}
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			result := MarshalledTextResult(IssueParticipantsResponse{
+				Participants: participants.list(),
+				Truncated:    truncated,
+			})
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
+
+// issueParticipantList collects participants in the order they are first
+// seen, merging the roles of a login that appears more than once.
+type issueParticipantList struct {
+	byLogin map[string]*IssueParticipant
+	order   []string
+}
+
+func newIssueParticipantList() *issueParticipantList {
+	return &issueParticipantList{byLogin: map[string]*IssueParticipant{}}
+}
+
+// add records login in role and returns its participant, or nil for an empty
+// login, such as a deleted user.
+func (l *issueParticipantList) add(login, role string) *IssueParticipant {
+	if login == "" {
+		return nil
+	}
+	participant, ok := l.byLogin[login]
+	if !ok {
+		participant = &IssueParticipant{Login: login, Roles: []string{}}
+		l.byLogin[login] = participant
+		l.order = append(l.order, login)
+	}
+	if n := len(participant.Roles); n == 0 || participant.Roles[n-1] != role {
+		participant.Roles = append(participant.Roles, role)
+	}
+	return participant
+}
+
+func (l *issueParticipantList) list() []IssueParticipant {
+	participants := make([]IssueParticipant, 0, len(l.order))
+	for _, login := range l.order {
+		participants = append(participants, *l.byLogin[login])
+	}
+	return participants
+}
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListIssueParticipants(t *testing.T) {
+	serverTool := ListIssueParticipants(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_participants", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number"})
+
+	issue := &github.Issue{
+		Number: github.Ptr(1),
+		User:   &github.User{Login: github.Ptr("alice")},
+		Assignees: []*github.User{
+			{Login: github.Ptr("bob")},
+			{Login: github.Ptr("alice")},
+		},
+	}
+	commentBy := func(login string) *github.IssueComment {
+		return &github.IssueComment{User: &github.User{Login: github.Ptr(login)}}
+	}
+	firstPage := []*github.IssueComment{commentBy("carol"), commentBy("alice"), commentBy("carol")}
+	secondPage := []*github.IssueComment{commentBy("bob"), {User: nil}, commentBy("dave")}
+
+	commentsHandler := func(w http.ResponseWriter, r *http.Request) {
+		page := firstPage
+		if r.URL.Query().Get("page") == "2" {
+			page = secondPage
+		} else {
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/issues/1/comments?page=2>; rel="next"`)
+		}
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		b, err := json.Marshal(page)
+		require.NoError(t, err)
+		_, _ = w.Write(b)
+	}
+
+	tests := []struct {
+		name                 string
+		handlers             map[string]http.HandlerFunc
+		expectError          bool
+		expectedErrMsg       string
+		expectedParticipants []IssueParticipant
+	}{
+		{
+			name: "author, assignees and commenters across pages",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber:         mockResponse(t, http.StatusOK, issue),
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: commentsHandler,
+			},
+			expectedParticipants: []IssueParticipant{
+				{Login: "alice", Roles: []string{"author", "assignee", "commenter"}, CommentCount: 1},
+				{Login: "bob", Roles: []string{"assignee", "commenter"}, CommentCount: 1},
+				{Login: "carol", Roles: []string{"commenter"}, CommentCount: 2},
+				{Login: "dave", Roles: []string{"commenter"}, CommentCount: 1},
+			},
+		},
+		{
+			name: "issue not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get issue",
+		},
+		{
+			name: "comments fail",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber:         mockResponse(t, http.StatusOK, issue),
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusInternalServerError, `{"message": "boom"}`),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list issue comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(1),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response IssueParticipantsResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedParticipants, response.Participants)
+			assert.False(t, response.Truncated)
+		})
+	}
+}
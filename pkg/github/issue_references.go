@@ -0,0 +1,238 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// IssueReference is an issue or pull request mentioned in the body of another
+// issue or pull request.
+type IssueReference struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	// Relationship is "closes" when the reference follows a closing keyword
+	// such as "Fixes", and "mentions" otherwise.
+	Relationship string `json:"relationship"`
+	// Form is how the reference was written: "same_repo" (#123 or GH-123),
+	// "cross_repo" (owner/repo#123), or "url".
+	Form string `json:"form"`
+	// Type is "issue" or "pull_request" when the form makes it known, which is
+	// only the case for URLs.
+	Type string `json:"type,omitempty"`
+}
+
+// LinkedReferencesResponse is the output of list_linked_references.
+type LinkedReferencesResponse struct {
+	Owner      string           `json:"owner"`
+	Repo       string           `json:"repo"`
+	Number     int              `json:"number"`
+	References []IssueReference `json:"references"`
+}
+
+var (
+	fencedCodePattern = regexp.MustCompile("(?s)(```|~~~).*?(```|~~~)")
+	inlineCodePattern = regexp.MustCompile("`[^`\n]*`")
+	issueRefPattern   = regexp.MustCompile(
+		`(?i)(?:\b(close[sd]?|fix(?:e[sd])?|resolve[sd]?)\s*:?\s+)?` +
+			`(?:https?://[^\s/]+/([\w.-]+)/([\w.-]+)/(issues|pull)/(\d+)\b` +
+			`|(?:([\w.-]+)/([\w.-]+))?#(\d+)\b` +
+			`|\bGH-(\d+)\b)`)
+)
+
+// extractIssueReferences finds the issues and pull requests referenced in body,
+// resolving same-repository references against owner/repo. References inside
+// code spans and HTML comments are ignored, as GitHub does not link them.
+// Each referenced item is returned once, in order of first appearance; a
+// closing reference anywhere wins over plain mentions of the same item.
+func extractIssueReferences(body, owner, repo string) []IssueReference {
+	body = htmlCommentPattern.ReplaceAllString(body, "")
+	body = fencedCodePattern.ReplaceAllString(body, "")
+	body = inlineCodePattern.ReplaceAllString(body, "")
+
+	refs := []IssueReference{}
+	seen := make(map[string]int)
+	for _, m := range issueRefPattern.FindAllStringSubmatchIndex(body, -1) {
+		group := func(i int) string {
+			if m[2*i] < 0 {
+				return ""
+			}
+			return body[m[2*i]:m[2*i+1]]
+		}
+
+		ref := IssueReference{Relationship: "mentions"}
+		refStart := m[0]
+		if group(1) != "" {
+			ref.Relationship = "closes"
+			refStart = m[3]
+			for refStart < len(body) && strings.ContainsRune(" \t\r\n:", rune(body[refStart])) {
+				refStart++
+			}
+		}
+
+		var number string
+		switch {
+		case group(5) != "":
+			ref.Owner, ref.Repo, number = group(2), group(3), group(5)
+			ref.Form = "url"
+			ref.Type = "issue"
+			if strings.EqualFold(group(4), "pull") {
+				ref.Type = "pull_request"
+			}
+		case group(8) != "":
+			ref.Owner, ref.Repo, number = group(6), group(7), group(8)
+			ref.Form = "cross_repo"
+			if ref.Owner == "" {
+				ref.Owner, ref.Repo = owner, repo
+				ref.Form = "same_repo"
+			}
+			// A # glued to a word, path, or HTML entity (&#39;) is not a reference.
+			if refStart > 0 && (isWordByte(body[refStart-1]) || strings.ContainsRune("&/.-", rune(body[refStart-1]))) {
+				continue
+			}
+		default:
+			ref.Owner, ref.Repo, number = owner, repo, group(9)
+			ref.Form = "same_repo"
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil || n <= 0 {
+			continue
+		}
+		ref.Number = n
+
+		key := strings.ToLower(fmt.Sprintf("%s/%s#%d", ref.Owner, ref.Repo, ref.Number))
+		if i, ok := seen[key]; ok {
+			if ref.Relationship == "closes" {
+				refs[i].Relationship = "closes"
+			}
+			if refs[i].Type == "" {
+				refs[i].Type = ref.Type
+			}
+			continue
+		}
+		seen[key] = len(refs)
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || ('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
+
+// ListLinkedReferences creates a tool to list the issues and pull requests
+// referenced from the body of an issue or pull request.
+func ListLinkedReferences(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "list_linked_references",
+			Description: t("TOOL_LIST_LINKED_REFERENCES_DESCRIPTION",
+				"List the issues and pull requests referenced in the body of an issue or pull request. "+
+					"Recognizes same-repository (#123, GH-123), cross-repository (owner/repo#123) and URL references, "+
+					"and reports whether each one is a plain mention or follows a closing keyword (closes, fixes, resolves). "+
+					"The referenced items are not fetched, so this is much cheaper than reading each one."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_LINKED_REFERENCES_USER_TITLE", "List linked references"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue or pull request",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			cache, err := deps.GetRepoAccessCache(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+			}
+
+			// Pull requests are issues too, so one endpoint serves both.
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get issue", resp, body), nil, nil
+			}
+
+			if deps.GetFlags(ctx).LockdownMode {
+				if restricted, err := authorLockdownResult(ctx, cache, owner, repo, issue.GetUser().GetLogin(), lockdownIssueRestrictedMessage); restricted != nil || err != nil {
+					return restricted, nil, err
+				}
+			}
+
+			refs := extractIssueReferences(issue.GetBody(), owner, repo)
+			// Drop references back to the item itself, e.g. from a template.
+			filtered := refs[:0]
+			for _, ref := range refs {
+				if ref.Number == issueNumber && strings.EqualFold(ref.Owner, owner) && strings.EqualFold(ref.Repo, repo) {
+					continue
+				}
+				filtered = append(filtered, ref)
+			}
+
+			result := MarshalledTextResult(LinkedReferencesResponse{
+				Owner:      owner,
+				Repo:       repo,
+				Number:     issueNumber,
+				References: filtered,
+			})
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoUserContent)
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListLinkedReferences(t *testing.T) {
+	serverTool := ListLinkedReferences(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_linked_references", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number"})
+
+	mockIssue := &github.Issue{
+		Number: github.Ptr(42),
+		Body: github.Ptr("Fixes #12 and closes: other/lib#3.\n" +
+			"Related to #7, GH-8 and https://github.com/owner/repo/pull/9#issuecomment-1.\n" +
+			"See also #12 and #42.\n" +
+			"```\nfixes #99\n```\n" +
+			"Not refs: `#98`, <!-- #97 -->, foo#96, it&#39;s, https://example.com/page#95."),
+		User: &github.User{Login: github.Ptr("testuser")},
+	}
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		expectError    bool
+		expectedErrMsg string
+		expectedRefs   []IssueReference
+	}{
+		{
+			name: "extracts references from the body",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockIssue),
+			},
+			expectedRefs: []IssueReference{
+				{Owner: "owner", Repo: "repo", Number: 12, Relationship: "closes", Form: "same_repo"},
+				{Owner: "other", Repo: "lib", Number: 3, Relationship: "closes", Form: "cross_repo"},
+				{Owner: "owner", Repo: "repo", Number: 7, Relationship: "mentions", Form: "same_repo"},
+				{Owner: "owner", Repo: "repo", Number: 8, Relationship: "mentions", Form: "same_repo"},
+				{Owner: "owner", Repo: "repo", Number: 9, Relationship: "mentions", Form: "url", Type: "pull_request"},
+			},
+		},
+		{
+			name: "issue without references",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, &github.Issue{
+					Number: github.Ptr(42),
+					Body:   github.Ptr("Nothing to see here."),
+					User:   &github.User{Login: github.Ptr("testuser")},
+				}),
+			},
+			expectedRefs: []IssueReference{},
+		},
+		{
+			name: "issue not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:          mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+				RepoAccessCache: stubRepoAccessCache(nil, 15*time.Minute),
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": false}),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response LinkedReferencesResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, 42, response.Number)
+			assert.Equal(t, tc.expectedRefs, response.References)
+		})
+	}
+}
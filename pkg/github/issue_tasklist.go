@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxTasklistReferenceLookups bounds how many referenced issues
+// get_tasklist_progress resolves, so a long checklist cannot turn one tool
+// call into hundreds of API requests.
+const maxTasklistReferenceLookups = 25
+
+var tasklistItemPattern = regexp.MustCompile(`(?m)^[ \t]*(?:[-*+]|\d+[.)])[ \t]+\[([ xX])\][ \t]+(.*?)[ \t]*$`)
+
+// TasklistReference is an issue or pull request referenced from a tasklist
+// item, with its current state when it could be resolved.
+type TasklistReference struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	// State is "open" or "closed", or empty when the reference could not be
+	// resolved (it does not exist, is not accessible, or the lookup limit was
+	// reached).
+	State       string `json:"state,omitempty"`
+	PullRequest bool   `json:"pull_request,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+// TasklistItem is one markdown checkbox of an issue body.
+type TasklistItem struct {
+	Text       string              `json:"text"`
+	Checked    bool                `json:"checked"`
+	References []TasklistReference `json:"references,omitempty"`
+}
+
+// TasklistProgress is the output of get_tasklist_progress.
+type TasklistProgress struct {
+	Completed int            `json:"completed"`
+	Total     int            `json:"total"`
+	Items     []TasklistItem `json:"items"`
+}
+
+// extractTasklistItems returns the markdown checkbox items of body in order.
+// Checkboxes inside fenced code blocks are not rendered by GitHub and are
+// skipped.
+func extractTasklistItems(body string) []TasklistItem {
+	body = fencedCodePattern.ReplaceAllString(body, "")
+	items := []TasklistItem{}
+	for _, m := range tasklistItemPattern.FindAllStringSubmatch(body, -1) {
+		items = append(items, TasklistItem{
+			Text:    m[2],
+			Checked: m[1] != " ",
+		})
+	}
+	return items
+}
+
+// GetTasklistProgress creates a tool to report the checkbox progress of an issue.
+func GetTasklistProgress(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "get_tasklist_progress",
+			Description: t("TOOL_GET_TASKLIST_PROGRESS_DESCRIPTION",
+				"Get the tasklist of an issue or pull request: its markdown checkbox items (- [ ] / - [x]) with completed and total counts. "+
+					"Issues and pull requests referenced from an item are resolved to their current open/closed state, "+
+					"which shows when an unchecked item is in fact done."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_TASKLIST_PROGRESS_USER_TITLE", "Get tasklist progress"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "The number of the issue or pull request",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			cache, err := deps.GetRepoAccessCache(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+			}
+
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get issue", resp, body), nil, nil
+			}
+
+			if deps.GetFlags(ctx).LockdownMode {
+				if restricted, err := authorLockdownResult(ctx, cache, owner, repo, issue.GetUser().GetLogin(), lockdownIssueRestrictedMessage); restricted != nil || err != nil {
+					return restricted, nil, err
+				}
+			}
+
+			progress := TasklistProgress{Items: extractTasklistItems(issue.GetBody())}
+			resolved := make(map[string]*TasklistReference)
+			for i := range progress.Items {
+				item := &progress.Items[i]
+				progress.Total++
+				if item.Checked {
+					progress.Completed++
+				}
+				for _, ref := range extractIssueReferences(item.Text, owner, repo) {
+					key := strings.ToLower(fmt.Sprintf("%s/%s#%d", ref.Owner, ref.Repo, ref.Number))
+					if _, ok := resolved[key]; !ok {
+						resolved[key] = resolveTasklistReference(ctx, client, ref, len(resolved) < maxTasklistReferenceLookups)
+					}
+					item.References = append(item.References, *resolved[key])
+				}
+			}
+
+			result := MarshalledTextResult(progress)
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoUserContent)
+			return result, nil, nil
+		},
+	)
+}
+
+// resolveTasklistReference looks up the current state of a referenced issue or
+// pull request. Lookup failures are not errors for the tool as a whole: the
+// reference is returned without a state.
+func resolveTasklistReference(ctx context.Context, client *github.Client, ref IssueReference, lookup bool) *TasklistReference {
+	resolved := &TasklistReference{
+		Owner:       ref.Owner,
+		Repo:        ref.Repo,
+		Number:      ref.Number,
+		PullRequest: ref.Type == "pull_request",
+	}
+	if !lookup {
+		return resolved
+	}
+	issue, resp, err := client.Issues.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return resolved
+	}
+	resolved.State = issue.GetState()
+	resolved.PullRequest = issue.IsPullRequest()
+	resolved.URL = issue.GetHTMLURL()
+	return resolved
+}
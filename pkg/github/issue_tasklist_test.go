@@ -0,0 +1,117 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetTasklistProgress(t *testing.T) {
+	serverTool := GetTasklistProgress(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_tasklist_progress", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number"})
+
+	issues := map[string]*github.Issue{
+		"/repos/owner/repo/issues/1": {
+			Number: github.Ptr(1),
+			Body: github.Ptr("## Plan\n" +
+				"- [x] Design #2\n" +
+				"- [ ] Implement other/lib#3\n" +
+				"* [X] Write docs\n" +
+				"1. [ ] Release, see #2 and #404\n" +
+				"```\n- [ ] not a task\n```\n" +
+				"- not a task either"),
+			User: &github.User{Login: github.Ptr("testuser")},
+		},
+		"/repos/owner/repo/issues/2": {
+			Number:  github.Ptr(2),
+			State:   github.Ptr("closed"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/issues/2"),
+		},
+		"/repos/other/lib/issues/3": {
+			Number:           github.Ptr(3),
+			State:            github.Ptr("open"),
+			HTMLURL:          github.Ptr("https://github.com/other/lib/pull/3"),
+			PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/other/lib/pulls/3")},
+		},
+	}
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposIssuesByOwnerByRepoByIssueNumber: func(w http.ResponseWriter, r *http.Request) {
+			issue, ok := issues[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				return
+			}
+			b, err := json.Marshal(issue)
+			require.NoError(t, err)
+			_, _ = w.Write(b)
+		},
+	})
+
+	deps := BaseDeps{
+		Client:          mustNewGHClient(t, mockedClient),
+		RepoAccessCache: stubRepoAccessCache(nil, 15*time.Minute),
+		Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": false}),
+	}
+	handler := serverTool.Handler(deps)
+
+	t.Run("computes progress and resolves references", func(t *testing.T) {
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(1),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var progress TasklistProgress
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &progress))
+
+		closedTwo := TasklistReference{Owner: "owner", Repo: "repo", Number: 2, State: "closed", URL: "https://github.com/owner/repo/issues/2"}
+		assert.Equal(t, TasklistProgress{
+			Completed: 2,
+			Total:     4,
+			Items: []TasklistItem{
+				{Text: "Design #2", Checked: true, References: []TasklistReference{closedTwo}},
+				{Text: "Implement other/lib#3", References: []TasklistReference{
+					{Owner: "other", Repo: "lib", Number: 3, State: "open", PullRequest: true, URL: "https://github.com/other/lib/pull/3"},
+				}},
+				{Text: "Write docs", Checked: true},
+				{Text: "Release, see #2 and #404", References: []TasklistReference{
+					closedTwo,
+					{Owner: "owner", Repo: "repo", Number: 404},
+				}},
+			},
+		}, progress)
+	})
+
+	t.Run("issue not found", func(t *testing.T) {
+		request := createMCPRequest(map[string]any{
+			"owner":        "owner",
+			"repo":         "repo",
+			"issue_number": float64(999),
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "failed to get issue")
+	})
+}
@@ -0,0 +1,281 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.yaml.in/yaml/v3"
+)
+
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// IssueTemplateField is one input of an issue form.
+type IssueTemplateField struct {
+	ID          string   `json:"id,omitempty"`
+	Type        string   `json:"type"`
+	Label       string   `json:"label,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required,omitempty"`
+	Options     []string `json:"options,omitempty"`
+	// Value is the text of a markdown element, or the default value of an
+	// input or textarea.
+	Value string `json:"value,omitempty"`
+}
+
+// IssueTemplate is an issue template of a repository, either a markdown
+// template with front matter or a YAML issue form.
+type IssueTemplate struct {
+	File string `json:"file"`
+	// Type is "markdown" or "form".
+	Type      string   `json:"type"`
+	Name      string   `json:"name,omitempty"`
+	About     string   `json:"about,omitempty"`
+	Title     string   `json:"title,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	// Body is the issue body prefilled by a markdown template.
+	Body string `json:"body,omitempty"`
+	// Fields are the inputs of an issue form.
+	Fields []IssueTemplateField `json:"fields,omitempty"`
+	// Error reports why the template could not be read or parsed.
+	Error string `json:"error,omitempty"`
+}
+
+// stringList decodes a YAML value that may be either a list of strings or a
+// single comma-separated string, as template labels and assignees can be.
+type stringList []string
+
+func (l *stringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		var s string
+		if err := value.Decode(&s); err != nil {
+			return err
+		}
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*l = append(*l, item)
+			}
+		}
+		return nil
+	}
+	var items []string
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+type issueTemplateHeader struct {
+	Name        string     `yaml:"name"`
+	About       string     `yaml:"about"`
+	Description string     `yaml:"description"`
+	Title       string     `yaml:"title"`
+	Labels      stringList `yaml:"labels"`
+	Assignees   stringList `yaml:"assignees"`
+}
+
+type issueFormElement struct {
+	Type       string `yaml:"type"`
+	ID         string `yaml:"id"`
+	Attributes struct {
+		Label       string `yaml:"label"`
+		Description string `yaml:"description"`
+		Value       string `yaml:"value"`
+		Options     []any  `yaml:"options"`
+	} `yaml:"attributes"`
+	Validations struct {
+		Required bool `yaml:"required"`
+	} `yaml:"validations"`
+}
+
+// parseIssueTemplate parses the content of a template file. Markdown
+// templates carry their metadata in YAML front matter; issue forms are YAML
+// documents whose body is a list of form elements.
+func parseIssueTemplate(file, content string) IssueTemplate {
+	template := IssueTemplate{File: file}
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var header issueTemplateHeader
+	switch strings.ToLower(path.Ext(file)) {
+	case ".md":
+		template.Type = "markdown"
+		body := content
+		if rest, ok := strings.CutPrefix(content, "---\n"); ok {
+			frontMatter, after, found := strings.Cut(rest, "\n---")
+			if !found {
+				template.Error = "front matter is not terminated by '---'"
+				return template
+			}
+			if err := yaml.Unmarshal([]byte(frontMatter), &header); err != nil {
+				template.Error = fmt.Sprintf("failed to parse front matter: %v", err)
+				return template
+			}
+			// Drop the rest of the closing delimiter line.
+			_, body, _ = strings.Cut(after, "\n")
+		}
+		template.Body = strings.TrimSpace(body)
+	default:
+		template.Type = "form"
+		var form struct {
+			issueTemplateHeader `yaml:",inline"`
+			Body                []issueFormElement `yaml:"body"`
+		}
+		if err := yaml.Unmarshal([]byte(content), &form); err != nil {
+			template.Error = fmt.Sprintf("failed to parse issue form: %v", err)
+			return template
+		}
+		header = form.issueTemplateHeader
+		for _, element := range form.Body {
+			field := IssueTemplateField{
+				ID:          element.ID,
+				Type:        element.Type,
+				Label:       element.Attributes.Label,
+				Description: element.Attributes.Description,
+				Required:    element.Validations.Required,
+				Value:       strings.TrimSpace(element.Attributes.Value),
+			}
+			for _, option := range element.Attributes.Options {
+				// Checkbox options are objects with a label; dropdown options
+				// are plain strings.
+				if m, ok := option.(map[string]any); ok {
+					option = m["label"]
+				}
+				if s, ok := option.(string); ok {
+					field.Options = append(field.Options, s)
+				}
+			}
+			template.Fields = append(template.Fields, field)
+		}
+	}
+
+	template.Name = header.Name
+	template.About = header.About
+	if template.About == "" {
+		template.About = header.Description
+	}
+	template.Title = header.Title
+	template.Labels = header.Labels
+	template.Assignees = header.Assignees
+	return template
+}
+
+// isIssueTemplateFile reports whether a file in the template directory is a
+// template. config.yml configures the template chooser and is not one.
+func isIssueTemplateFile(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md", ".yml", ".yaml":
+	default:
+		return false
+	}
+	base := strings.ToLower(strings.TrimSuffix(name, path.Ext(name)))
+	return base != "config"
+}
+
+// ListIssueTemplates creates a tool to list the issue templates of a repository.
+func ListIssueTemplates(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "list_issue_templates",
+			Description: t("TOOL_LIST_ISSUE_TEMPLATES_DESCRIPTION",
+				"List the issue templates of a repository from "+issueTemplateDir+", with each template's name, description, default title, labels and assignees. "+
+					"Markdown templates include the body they prefill; issue forms include their fields. "+
+					"Use this before creating an issue so that its title, labels and body follow the repository's templates."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_TEMPLATES_USER_TITLE", "List issue templates"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag or commit SHA to read the templates at. Defaults to the repository's default branch",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
+			_, entries, resp, err := client.Repositories.GetContents(ctx, owner, repo, issueTemplateDir, opts)
+			if err != nil {
+				// A repository without templates simply has no template directory.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					result := MarshalledTextResult(map[string]any{"templates": []IssueTemplate{}})
+					return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelGetFileContents), nil, nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue templates", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			var files []string
+			for _, entry := range entries {
+				if entry.GetType() == "file" && isIssueTemplateFile(entry.GetName()) {
+					files = append(files, entry.GetPath())
+				}
+			}
+
+			// Templates that cannot be read are reported individually, so the
+			// pool never returns an error of its own.
+			templates, _ := runBounded(ctx, maxGetFilesConcurrency, len(files), func(ctx context.Context, i int) (IssueTemplate, error) {
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, files[i], opts)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				if err != nil {
+					return IssueTemplate{File: files[i], Error: fmt.Sprintf("failed to get template: %v", err)}, nil
+				}
+				content, err := file.GetContent()
+				if err != nil {
+					return IssueTemplate{File: files[i], Error: fmt.Sprintf("failed to decode template: %v", err)}, nil
+				}
+				return parseIssueTemplate(files[i], content), nil
+			})
+
+			result := MarshalledTextResult(map[string]any{"templates": templates})
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelGetFileContents)
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,176 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListIssueTemplates(t *testing.T) {
+	serverTool := ListIssueTemplates(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_templates", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "ref")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	bugReport := "---\r\n" +
+		"name: Bug report\r\n" +
+		"about: Report something that is broken\r\n" +
+		"title: '[Bug] '\r\n" +
+		"labels: bug, triage\r\n" +
+		"assignees:\r\n" +
+		"  - octocat\r\n" +
+		"---\r\n" +
+		"\r\n" +
+		"**Describe the bug**\r\n"
+	featureForm := `name: Feature request
+description: Suggest an idea
+title: "[Feature]: "
+labels: ["enhancement"]
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for the suggestion!
+  - type: textarea
+    id: problem
+    attributes:
+      label: Problem
+      description: What problem does this solve?
+    validations:
+      required: true
+  - type: dropdown
+    id: area
+    attributes:
+      label: Area
+      options:
+        - API
+        - CLI
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow the Code of Conduct
+          required: true
+`
+
+	fileContent := func(path, content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type:     github.Ptr("file"),
+			Path:     github.Ptr(path),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		handlers          map[string]http.HandlerFunc
+		expectError       bool
+		expectedErrMsg    string
+		expectedTemplates []IssueTemplate
+	}{
+		{
+			name: "markdown template and issue form",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/contents/.github/ISSUE_TEMPLATE": expectQueryParams(t, map[string]string{
+					"ref": "main",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+					{Type: github.Ptr("file"), Name: github.Ptr("bug_report.md"), Path: github.Ptr(".github/ISSUE_TEMPLATE/bug_report.md")},
+					{Type: github.Ptr("file"), Name: github.Ptr("config.yml"), Path: github.Ptr(".github/ISSUE_TEMPLATE/config.yml")},
+					{Type: github.Ptr("file"), Name: github.Ptr("feature.yml"), Path: github.Ptr(".github/ISSUE_TEMPLATE/feature.yml")},
+					{Type: github.Ptr("dir"), Name: github.Ptr("old"), Path: github.Ptr(".github/ISSUE_TEMPLATE/old")},
+				})),
+				"GET /repos/owner/repo/contents/.github/ISSUE_TEMPLATE/bug_report.md": mockResponse(t, http.StatusOK,
+					fileContent(".github/ISSUE_TEMPLATE/bug_report.md", bugReport)),
+				"GET /repos/owner/repo/contents/.github/ISSUE_TEMPLATE/feature.yml": mockResponse(t, http.StatusOK,
+					fileContent(".github/ISSUE_TEMPLATE/feature.yml", featureForm)),
+			},
+			expectedTemplates: []IssueTemplate{
+				{
+					File:      ".github/ISSUE_TEMPLATE/bug_report.md",
+					Type:      "markdown",
+					Name:      "Bug report",
+					About:     "Report something that is broken",
+					Title:     "[Bug] ",
+					Labels:    []string{"bug", "triage"},
+					Assignees: []string{"octocat"},
+					Body:      "**Describe the bug**",
+				},
+				{
+					File:   ".github/ISSUE_TEMPLATE/feature.yml",
+					Type:   "form",
+					Name:   "Feature request",
+					About:  "Suggest an idea",
+					Title:  "[Feature]: ",
+					Labels: []string{"enhancement"},
+					Fields: []IssueTemplateField{
+						{Type: "markdown", Value: "Thanks for the suggestion!"},
+						{ID: "problem", Type: "textarea", Label: "Problem", Description: "What problem does this solve?", Required: true},
+						{ID: "area", Type: "dropdown", Label: "Area", Options: []string{"API", "CLI"}},
+						{ID: "terms", Type: "checkboxes", Label: "Code of Conduct", Options: []string{"I agree to follow the Code of Conduct"}},
+					},
+				},
+			},
+		},
+		{
+			name: "repository without templates",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/contents/.github/ISSUE_TEMPLATE": mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectedTemplates: []IssueTemplate{},
+		},
+		{
+			name: "template directory not accessible",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/contents/.github/ISSUE_TEMPLATE": mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list issue templates",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response struct {
+				Templates []IssueTemplate `json:"templates"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedTemplates, response.Templates)
+		})
+	}
+}
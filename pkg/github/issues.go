@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -593,6 +595,91 @@ func (q *ListIssuesQueryTypeWithLabelsWithSince) GetIsPrivate() bool {
 	return bool(q.Repository.IsPrivate)
 }
 
+// ListIssuesSearchQuery is the query structure for label_match "all" and for
+// assignee filtering. The issues connection matches any of the given labels,
+// so issues carrying every label are found through search instead; assignee
+// filters take the same route so the connection queries keep their four
+// fixed shapes.
+type ListIssuesSearchQuery struct {
+	Search struct {
+		Nodes []struct {
+			Issue IssueFragment `graphql:"... on Issue"`
+		}
+		PageInfo struct {
+			HasNextPage     githubv4.Boolean
+			HasPreviousPage githubv4.Boolean
+			StartCursor     githubv4.String
+			EndCursor       githubv4.String
+		}
+		IssueCount int
+	} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+	Repository struct {
+		IsPrivate githubv4.Boolean
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+func (q *ListIssuesSearchQuery) GetIssueFragment() IssueQueryFragment {
+	fragment := IssueQueryFragment{
+		Nodes:      make([]IssueFragment, 0, len(q.Search.Nodes)),
+		PageInfo:   q.Search.PageInfo,
+		TotalCount: q.Search.IssueCount,
+	}
+	for _, node := range q.Search.Nodes {
+		fragment.Nodes = append(fragment.Nodes, node.Issue)
+	}
+	return fragment
+}
+
+func (q *ListIssuesSearchQuery) GetIsPrivate() bool { return bool(q.Repository.IsPrivate) }
+
+// listIssuesSearchString builds the search query that stands in for the
+// issues connection when every label must match or an assignee is given.
+// state, orderBy and direction are the normalized list_issues values; labels
+// and assignee must already have passed validateSearchQualifierValue.
+func listIssuesSearchString(owner, repo string, labels []string, matchAllLabels bool, assignee, state, orderBy, direction string, since time.Time) string {
+	terms := []string{fmt.Sprintf("repo:%s/%s", owner, repo), "is:issue"}
+	if matchAllLabels {
+		for _, label := range labels {
+			terms = append(terms, `label:"`+label+`"`)
+		}
+	} else if len(labels) > 0 {
+		// Comma-separated values in one qualifier match any of them.
+		quoted := make([]string, len(labels))
+		for i, label := range labels {
+			quoted[i] = `"` + label + `"`
+		}
+		terms = append(terms, "label:"+strings.Join(quoted, ","))
+	}
+	switch assignee {
+	case "":
+	case "none":
+		terms = append(terms, "no:assignee")
+	default:
+		terms = append(terms, "assignee:"+assignee)
+	}
+	switch state {
+	case "OPEN":
+		terms = append(terms, "is:open")
+	case "CLOSED":
+		terms = append(terms, "is:closed")
+	}
+	if !since.IsZero() {
+		terms = append(terms, "updated:>="+since.UTC().Format(time.RFC3339))
+	}
+	sortField := map[string]string{"CREATED_AT": "created", "UPDATED_AT": "updated", "COMMENTS": "comments"}[orderBy]
+	terms = append(terms, fmt.Sprintf("sort:%s-%s", sortField, strings.ToLower(direction)))
+	return strings.Join(terms, " ")
+}
+
+// validateSearchQualifierValue rejects values that cannot be placed inside a
+// quoted search qualifier: search syntax has no escape for a double quote.
+func validateSearchQualifierValue(name, value string) error {
+	if strings.Contains(value, `"`) {
+		return fmt.Errorf("%s %q cannot contain a double quote", name, value)
+	}
+	return nil
+}
+
 func getIssueQueryType(hasLabels bool, hasSince bool) any {
 	switch {
 	case hasLabels && hasSince:
@@ -619,8 +706,9 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 					"2. get_comments - Get issue comments.\n" +
 					"3. get_sub_issues - Get sub-issues (children) of the issue.\n" +
 					"4. get_parent - Get the parent issue, if this issue is a sub-issue of another.\n" +
-					"5. get_labels - Get labels assigned to the issue.\n",
-				Enum: []any{"get", "get_comments", "get_sub_issues", "get_parent", "get_labels"},
+					"5. get_labels - Get labels assigned to the issue.\n" +
+					"6. get_body - Get only the issue title and its body as plain text, without metadata. HTML comments are removed and images are replaced by their alt text. Useful for summarization.\n",
+				Enum: []any{"get", "get_comments", "get_sub_issues", "get_parent", "get_labels", "get_body"},
 			},
 			"owner": {
 				Type:        "string",
@@ -637,6 +725,7 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 		Required: []string{"method", "owner", "repo", "issue_number"},
 	}
+	schema.Properties["fields"] = fieldPathsSchemaProperty()
 	WithPagination(schema)
 
 	return NewTool(
@@ -669,8 +758,12 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			fields, err := OptionalStringArrayParam(args, "fields")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -685,10 +778,14 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub graphql client", err), nil, nil
 			}
 
-			// attachIFC adds the IFC label to a successful tool result when
-			// IFC labels are enabled. If the visibility lookup fails the
-			// label is omitted rather than misclassifying the result.
-			attachIFC := newRepoVisibilityIFCLabeler(ctx, deps, client, owner, repo, ifc.LabelRepoUserContent)
+			// attachIFC trims the result to the requested fields and adds the
+			// IFC label to a successful tool result when IFC labels are
+			// enabled. If the visibility lookup fails the label is omitted
+			// rather than misclassifying the result.
+			labelIFC := newRepoVisibilityIFCLabeler(ctx, deps, client, owner, repo, ifc.LabelRepoUserContent)
+			attachIFC := func(r *mcp.CallToolResult) *mcp.CallToolResult {
+				return labelIFC(projectResultFields(r, fields))
+			}
 
 			switch method {
 			case "get":
@@ -706,6 +803,9 @@ func IssueRead(t translations.TranslationHelperFunc) inventory.ServerTool {
 			case "get_labels":
 				result, err := GetIssueLabels(ctx, gqlClient, owner, repo, issueNumber)
 				return attachIFC(result), nil, err
+			case "get_body":
+				result, err := GetIssueBody(ctx, client, deps, owner, repo, issueNumber)
+				return attachIFC(result), nil, err
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
@@ -721,7 +821,7 @@ func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies,
 
 	issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get issue: %w", err)
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -767,6 +867,86 @@ func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies,
 	return MarshalledTextResult(minimalIssue), nil
 }
 
+// IssueBody is the output of the issue_read get_body method.
+type IssueBody struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+var (
+	htmlCommentPattern   = regexp.MustCompile(`(?s)<!--.*?-->`)
+	markdownImagePattern = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	htmlImagePattern     = regexp.MustCompile(`(?i)<img\b[^>]*>`)
+	htmlImageAltPattern  = regexp.MustCompile(`(?i)\balt\s*=\s*"([^"]*)"`)
+	htmlTagPattern       = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	extraBlankLines      = regexp.MustCompile(`\n{3,}`)
+)
+
+// markdownToText reduces markdown such as an issue body to its narrative: HTML
+// comments (often issue template instructions) are dropped, images collapse to
+// their alt text, and any remaining HTML markup is removed after the usual
+// sanitization.
+func markdownToText(body string) string {
+	body = htmlCommentPattern.ReplaceAllString(body, "")
+	body = markdownImagePattern.ReplaceAllStringFunc(body, func(m string) string {
+		return imagePlaceholder(markdownImagePattern.FindStringSubmatch(m)[1])
+	})
+	body = htmlImagePattern.ReplaceAllStringFunc(body, func(m string) string {
+		var alt string
+		if sub := htmlImageAltPattern.FindStringSubmatch(m); sub != nil {
+			alt = sub[1]
+		}
+		return imagePlaceholder(alt)
+	})
+	body = sanitize.Sanitize(body)
+	body = html.UnescapeString(htmlTagPattern.ReplaceAllString(body, ""))
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	body = extraBlankLines.ReplaceAllString(body, "\n\n")
+	return strings.TrimSpace(body)
+}
+
+func imagePlaceholder(alt string) string {
+	alt = strings.TrimSpace(alt)
+	if alt == "" {
+		return "[image]"
+	}
+	return "[image: " + alt + "]"
+}
+
+// GetIssueBody returns the title and plain-text body of an issue.
+func GetIssueBody(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int) (*mcp.CallToolResult, error) {
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
+	}
+	flags := deps.GetFlags(ctx)
+
+	issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get issue", resp, body), nil
+	}
+
+	if flags.LockdownMode {
+		if restricted, err := authorLockdownResult(ctx, cache, owner, repo, issue.GetUser().GetLogin(), lockdownIssueRestrictedMessage); restricted != nil || err != nil {
+			return restricted, err
+		}
+	}
+
+	return MarshalledTextResult(IssueBody{
+		Title: markdownToText(issue.GetTitle()),
+		Body:  markdownToText(issue.GetBody()),
+	}), nil
+}
+
 // applyIssueReadEnrichment populates the hierarchy relationship signals (has_parent/has_children,
 // parent, sub_issues_summary) and field_values onto the minimal issue. In lockdown mode the parent
 // reference is omitted unless the parent content can be verified as safe; has_parent and the numeric
@@ -831,7 +1011,7 @@ func GetIssueComments(ctx context.Context, client *github.Client, deps ToolDepen
 
 	comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get issue comments: %w", err)
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue comments", resp, err), nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -1663,6 +1843,8 @@ func searchIssuesTool(t translations.TranslationHelperFunc, includeFields bool)
 		},
 		Required: []string{"query"},
 	}
+	schema.Properties["group_by_repo"] = groupByRepoSchemaProperty()
+	schema.Properties["count_only"] = countOnlySchemaProperty()
 	if includeFields {
 		schema.Properties["fields"] = fieldsSchemaProperty(
 			"Subset of fields to return for each issue result. If omitted, all fields are returned. Use this to reduce response size when you only need specific fields; omitting 'body', 'reactions', and 'labels' in particular drops the largest per-result data.",
@@ -1684,7 +1866,15 @@ func searchIssuesTool(t translations.TranslationHelperFunc, includeFields bool)
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
-			options := []searchOption{ifcSearchPostProcessOption(ctx, deps)}
+			groupByRepo, err := OptionalParam[bool](args, "group_by_repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			countOnly, err := OptionalParam[bool](args, "count_only")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			options := []searchOption{ifcSearchPostProcessOption(ctx, deps), withGroupByRepo(groupByRepo), withCountOnly(deps, countOnly)}
 			if includeFields {
 				fields, err := OptionalStringArrayParam(args, "fields")
 				if err != nil {
@@ -1963,10 +2153,18 @@ func fetchIssueReadEnrichment(ctx context.Context, gqlClient *githubv4.Client, n
 func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[string]any, options ...searchOption) (*mcp.CallToolResult, error) {
 	const errorPrefix = "failed to search issues"
 
-	query, opts, err := prepareSearchArgs(args, "issue")
+	cfg := searchConfig{}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	query, opts, err := prepareSearchArgs(args, "issue", deps.GetDefaultPerPage())
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil
 	}
+	if cfg.countOnly {
+		opts.ListOptions = countOnlyListOptions
+	}
 
 	client, err := deps.GetClient(ctx)
 	if err != nil {
@@ -1986,6 +2184,11 @@ func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[st
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, errorPrefix, resp, body), nil
 	}
 
+	if cfg.countOnly {
+		// Issue counts can include private repositories' issues.
+		return searchCountResult(ctx, deps, result.Total, result.IncompleteResults, ifc.PrivateTrusted()), nil
+	}
+
 	var fieldValuesByID map[string][]MinimalFieldValue
 	if len(result.Issues) > 0 {
 		gqlClient, err := deps.GetGQLClient(ctx)
@@ -2013,24 +2216,18 @@ func searchIssuesHandler(ctx context.Context, deps ToolDependencies, args map[st
 		Items:             items,
 	}
 
-	cfg := searchConfig{}
-	for _, opt := range options {
-		opt(&cfg)
-	}
-
 	filtered := false
 	var payload any = response
-	if len(cfg.fields) > 0 {
+	switch {
+	case len(cfg.fields) > 0:
 		filteredItems, err := filterEachField(response.Items, cfg.fields)
 		if err != nil {
 			return utils.NewToolResultErrorFromErr(errorPrefix+": failed to filter results", err), nil
 		}
-		payload = map[string]any{
-			"total_count":        response.Total,
-			"incomplete_results": response.IncompleteResults,
-			"items":              filteredItems,
-		}
+		payload = searchResultPayload(response.Total, response.IncompleteResults, result.Issues, filteredItems, cfg.groupByRepo)
 		filtered = true
+	case cfg.groupByRepo:
+		payload = searchResultPayload(response.Total, response.IncompleteResults, result.Issues, response.Items, true)
 	}
 
 	r, err := json.Marshal(payload)
@@ -2698,11 +2895,21 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 			},
 			"labels": {
 				Type:        "array",
-				Description: "Filter by labels",
+				Description: "Filter by labels. See label_match for how several labels combine",
 				Items: &jsonschema.Schema{
 					Type: "string",
 				},
 			},
+			"label_match": {
+				Type:        "string",
+				Description: "How multiple labels combine: 'any' returns issues with at least one of the labels, 'all' only issues with every label. 'all' runs through the search API, which has a much lower rate limit, may lag recent changes by a few seconds, and cannot be combined with field_filters.",
+				Enum:        []any{"any", "all"},
+				Default:     json.RawMessage(`"any"`),
+			},
+			"assignee": {
+				Type:        "string",
+				Description: "Filter by assignee username, or 'none' for issues without an assignee. Like label_match 'all', this runs through the search API and cannot be combined with field_filters.",
+			},
 			"orderBy": {
 				Type:        "string",
 				Description: "Order issues by field. If provided, the 'direction' also needs to be provided.",
@@ -2715,7 +2922,7 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 			},
 			"since": {
 				Type:        "string",
-				Description: "Filter by date (ISO 8601 timestamp)",
+				Description: "Only return issues updated at or after this time (ISO 8601 timestamp). To poll for changes, pass the previous response's newestUpdatedAt, ideally with orderBy UPDATED_AT and direction ASC; issues updated at exactly that time are returned again.",
 			},
 			"field_filters": {
 				Type:        "array",
@@ -2745,6 +2952,7 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 		)
 	}
 	WithCursorPagination(schema)
+	WithOutputFormat(schema)
 
 	st := NewTool(
 		ToolsetMetadataIssues,
@@ -2776,6 +2984,11 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 				}
 			}
 
+			outputFormat, err := OptionalOutputFormat(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
 			// Set optional parameters if provided
 			state, err := OptionalParam[string](args, "state")
 			if err != nil {
@@ -2798,6 +3011,28 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			labelMatch, err := OptionalParam[string](args, "label_match")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			switch labelMatch {
+			case "", "any", "all":
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid label_match %q: must be 'any' or 'all'", labelMatch)), nil, nil
+			}
+			for _, label := range labels {
+				if err := validateSearchQualifierValue("label", label); err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			assignee, err := OptionalParam[string](args, "assignee")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			assignee = strings.TrimPrefix(strings.TrimSpace(assignee), "@")
+			if strings.ContainsAny(assignee, " \t\"") {
+				return utils.NewToolResultError(fmt.Sprintf("invalid assignee %q: must be a username or 'none'", assignee)), nil, nil
+			}
 
 			orderBy, err := OptionalParam[string](args, "orderBy")
 			if err != nil {
@@ -2848,9 +3083,20 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			// A single label matches the same issues either way, so only
+			// several labels need the search-backed query. Assignee filters
+			// use it too.
+			matchAllLabels := labelMatch == "all" && len(labels) > 1
+			if matchAllLabels && len(rawFilters) > 0 {
+				return utils.NewToolResultError("label_match 'all' cannot be combined with field_filters"), nil, nil
+			}
+			if assignee != "" && len(rawFilters) > 0 {
+				return utils.NewToolResultError("assignee cannot be combined with field_filters"), nil, nil
+			}
+			useSearch := matchAllLabels || assignee != ""
 
 			// Get pagination parameters and convert to GraphQL format
-			pagination, err := OptionalCursorPaginationParams(args)
+			pagination, err := OptionalCursorPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return nil, nil, err
 			}
@@ -2926,6 +3172,16 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 			}
 
 			issueQuery := getIssueQueryType(hasLabels, hasSince)
+			if useSearch {
+				issueQuery = &ListIssuesSearchQuery{}
+				vars = map[string]any{
+					"owner": vars["owner"],
+					"repo":  vars["repo"],
+					"first": vars["first"],
+					"after": vars["after"],
+					"query": githubv4.String(listIssuesSearchString(owner, repo, labels, matchAllLabels, assignee, state, orderBy, direction, sinceTime)),
+				}
+			}
 			// The list_issues query references the issue_fields-gated IssueFieldValueFilter
 			// input type unconditionally, so we always opt into the feature via header. This
 			// is a no-op once the flags are globally rolled out.
@@ -2945,6 +3201,11 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 				isPrivate = queryResult.GetIsPrivate()
 			}
 
+			if outputFormat == OutputFormatMarkdown {
+				result := utils.NewToolResultText(issuesMarkdown(resp))
+				return attachStaticIFCLabel(ctx, deps, result, ifc.LabelListIssues(isPrivate)), nil, nil
+			}
+
 			filtered := false
 			var payload any = resp
 			if includeFields && len(fields) > 0 {
@@ -2952,11 +3213,15 @@ func listIssuesTool(t translations.TranslationHelperFunc, includeFields bool) in
 				if err != nil {
 					return utils.NewToolResultErrorFromErr("failed to filter issues", err), nil, nil
 				}
-				payload = map[string]any{
+				filteredPayload := map[string]any{
 					"issues":     filteredIssues,
 					"totalCount": resp.TotalCount,
 					"pageInfo":   resp.PageInfo,
 				}
+				if resp.NewestUpdatedAt != "" {
+					filteredPayload["newestUpdatedAt"] = resp.NewestUpdatedAt
+				}
+				payload = filteredPayload
 				filtered = true
 			}
 
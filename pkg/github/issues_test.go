@@ -202,8 +202,8 @@ func Test_GetIssue(t *testing.T) {
 				"repo":         "repo",
 				"issue_number": float64(999),
 			},
-			expectHandlerError: true,
-			expectedErrMsg:     "failed to get issue",
+			expectResultError: true,
+			expectedErrMsg:    "failed to get issue: GET",
 		},
 		{
 			name: "lockdown enabled - private repository",
@@ -290,6 +290,71 @@ func Test_GetIssue(t *testing.T) {
 	}
 }
 
+func Test_IssueRead_Fields(t *testing.T) {
+	serverTool := IssueRead(translations.NullTranslationHelper)
+
+	mockIssue := &github.Issue{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Test Issue"),
+		Body:    github.Ptr("A long body"),
+		State:   github.Ptr("open"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42"),
+		User:    &github.User{Login: github.Ptr("testuser"), ID: github.Ptr(int64(7))},
+	}
+	mockComments := []*github.IssueComment{
+		{ID: github.Ptr(int64(1)), Body: github.Ptr("first"), User: &github.User{Login: github.Ptr("a")}},
+		{ID: github.Ptr(int64(2)), Body: github.Ptr("second"), User: &github.User{Login: github.Ptr("b")}},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		fields   []any
+		expected string
+	}{
+		{
+			name:     "get projects the issue",
+			method:   "get",
+			fields:   []any{"number", "title", "user.login"},
+			expected: `{"number": 42, "title": "Test Issue", "user": {"login": "testuser"}}`,
+		},
+		{
+			name:     "get_comments projects each comment",
+			method:   "get_comments",
+			fields:   []any{"body", "user.login"},
+			expected: `[{"body": "first", "user": {"login": "a"}}, {"body": "second", "user": {"login": "b"}}]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetReposIssuesByOwnerByRepoByIssueNumber:         mockResponse(t, http.StatusOK, mockIssue),
+					GetReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockComments),
+				})),
+				GQLClient: defaultGQLClient,
+				Flags:     stubFeatureFlags(nil),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":       tc.method,
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"fields":       tc.fields,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			assert.JSONEq(t, tc.expected, textContent.Text)
+		})
+	}
+}
+
 func Test_IssueRead_IFC_InsidersMode(t *testing.T) {
 	t.Parallel()
 
@@ -406,6 +471,78 @@ func Test_IssueRead_IFC_InsidersMode(t *testing.T) {
 	})
 }
 
+func Test_GetIssueBody(t *testing.T) {
+	serverTool := IssueRead(translations.NullTranslationHelper)
+
+	mockIssue := &github.Issue{
+		Number: github.Ptr(7),
+		Title:  github.Ptr("Crash on startup & exit"),
+		Body: github.Ptr("<!-- Please fill in the template below -->\r\n" +
+			"## Steps\r\n\r\n\r\n\r\n" +
+			"Run the app.\r\n" +
+			"![stack trace](https://example.com/trace.png)\r\n" +
+			"<img src=\"https://example.com/ui.png\" alt=\"broken UI\" width=\"400\">\r\n" +
+			"<img src=\"https://example.com/x.png\">\r\n" +
+			"Expected <b>no</b> crash when a < b."),
+		State: github.Ptr("open"),
+		User: &github.User{
+			Login: github.Ptr("testuser"),
+		},
+	}
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockIssue),
+	})
+
+	deps := BaseDeps{
+		Client:          mustNewGHClient(t, mockedClient),
+		GQLClient:       defaultGQLClient,
+		RepoAccessCache: stubRepoAccessCache(nil, 15*time.Minute),
+		Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": false}),
+	}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"method":       "get_body",
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(7),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var body IssueBody
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &body))
+	assert.Equal(t, IssueBody{
+		Title: "Crash on startup & exit",
+		Body: "## Steps\n\n" +
+			"Run the app.\n" +
+			"[image: stack trace]\n" +
+			"[image: broken UI]\n" +
+			"[image]\n" +
+			"Expected no crash when a < b.",
+	}, body)
+
+	t.Run("issue not found", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			})),
+			GQLClient:       defaultGQLClient,
+			RepoAccessCache: stubRepoAccessCache(nil, 15*time.Minute),
+			Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": false}),
+		}
+		handler := serverTool.Handler(deps)
+
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "failed to get issue: GET")
+		assert.Contains(t, errorContent.Text, "status: 404")
+	})
+}
+
 func Test_GetIssue_FieldValues(t *testing.T) {
 	// The raw REST issue_field_values are always cleared. Enriched field_values are
 	// only populated via GraphQL when the issue has a node ID; this issue has none,
@@ -1420,6 +1557,54 @@ func Test_SearchIssues_FieldValuesEnrichment(t *testing.T) {
 	assert.Empty(t, response.Items[1].FieldValues)
 }
 
+func mockGroupedSearchResult() *github.IssuesSearchResult {
+	return &github.IssuesSearchResult{
+		Total:             github.Ptr(4),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{ID: github.Ptr(int64(1)), Number: github.Ptr(1), Title: github.Ptr("first"), RepositoryURL: github.Ptr("https://api.github.com/repos/owner/alpha")},
+			{ID: github.Ptr(int64(2)), Number: github.Ptr(2), Title: github.Ptr("second"), RepositoryURL: github.Ptr("https://api.github.com/repos/owner/beta")},
+			{ID: github.Ptr(int64(3)), Number: github.Ptr(3), Title: github.Ptr("third"), RepositoryURL: github.Ptr("https://api.github.com/repos/owner/alpha")},
+			{ID: github.Ptr(int64(1)), Number: github.Ptr(1), Title: github.Ptr("first"), RepositoryURL: github.Ptr("https://api.github.com/repos/owner/alpha")},
+		},
+	}
+}
+
+func Test_SearchIssues_GroupByRepo(t *testing.T) {
+	serverTool := SearchIssues(translations.NullTranslationHelper)
+	schema, ok := serverTool.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "group_by_repo")
+
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetSearchIssues: mockResponse(t, http.StatusOK, mockGroupedSearchResult()),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"query":         "bug",
+		"group_by_repo": true,
+		"fields":        []any{"number"},
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response struct {
+		TotalCount   int                               `json:"total_count"`
+		Items        []map[string]any                  `json:"items"`
+		Repositories []SearchRepoGroup[map[string]any] `json:"repositories"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, 4, response.TotalCount)
+	assert.Nil(t, response.Items, "grouped output has no flat items list")
+	assert.Equal(t, []SearchRepoGroup[map[string]any]{
+		{Repository: "owner/alpha", Count: 2, Items: []map[string]any{{"number": float64(1)}, {"number": float64(3)}}},
+		{Repository: "owner/beta", Count: 1, Items: []map[string]any{{"number": float64(2)}}},
+	}, response.Repositories)
+}
+
 func Test_CreateIssue(t *testing.T) {
 	// Verify tool definition once
 	serverTool := IssueWrite(translations.NullTranslationHelper)
@@ -2107,6 +2292,56 @@ func Test_ListIssues(t *testing.T) {
 		"issueFieldValues": []any{},
 	}
 
+	varsUpdatedSince := map[string]any{
+		"owner":            "owner",
+		"repo":             "repo",
+		"states":           []any{"OPEN", "CLOSED"},
+		"orderBy":          "UPDATED_AT",
+		"direction":        "ASC",
+		"since":            "2023-01-15T00:00:00Z",
+		"first":            float64(30),
+		"after":            (*string)(nil),
+		"issueFieldValues": []any{},
+	}
+
+	varsAllLabels := map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"query": `repo:owner/repo is:issue label:"bug" label:"enhancement" is:open sort:created-desc`,
+		"first": float64(30),
+		"after": (*string)(nil),
+	}
+
+	varsAssignee := map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"query": `repo:owner/repo is:issue label:"bug","enhancement" assignee:octocat sort:created-desc`,
+		"first": float64(30),
+		"after": (*string)(nil),
+	}
+
+	varsUnassigned := map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"query": `repo:owner/repo is:issue no:assignee is:closed sort:created-desc`,
+		"first": float64(30),
+		"after": (*string)(nil),
+	}
+
+	mockResponseSearchAllLabels := githubv4mock.DataResponse(map[string]any{
+		"search": map[string]any{
+			"nodes": []map[string]any{mockIssuesAll[1]},
+			"pageInfo": map[string]any{
+				"hasNextPage":     false,
+				"hasPreviousPage": false,
+				"startCursor":     "",
+				"endCursor":       "",
+			},
+			"issueCount": 1,
+		},
+		"repository": map[string]any{"isPrivate": false},
+	})
+
 	varsRepoNotFound := map[string]any{
 		"owner":            "owner",
 		"repo":             "nonexistent-repo",
@@ -2119,11 +2354,12 @@ func Test_ListIssues(t *testing.T) {
 	}
 
 	tests := []struct {
-		name          string
-		reqParams     map[string]any
-		expectError   bool
-		errContains   string
-		expectedCount int
+		name                    string
+		reqParams               map[string]any
+		expectError             bool
+		errContains             string
+		expectedCount           int
+		expectedNewestUpdatedAt string
 	}{
 		{
 			name: "list all issues",
@@ -2131,8 +2367,9 @@ func Test_ListIssues(t *testing.T) {
 				"owner": "owner",
 				"repo":  "repo",
 			},
-			expectError:   false,
-			expectedCount: 2,
+			expectError:             false,
+			expectedCount:           2,
+			expectedNewestUpdatedAt: "2023-02-01T00:00:00Z",
 		},
 		{
 			name: "filter by open state",
@@ -2141,8 +2378,9 @@ func Test_ListIssues(t *testing.T) {
 				"repo":  "repo",
 				"state": "OPEN",
 			},
-			expectError:   false,
-			expectedCount: 2,
+			expectError:             false,
+			expectedCount:           2,
+			expectedNewestUpdatedAt: "2023-02-01T00:00:00Z",
 		},
 		{
 			name: "filter by open state - lc",
@@ -2151,8 +2389,9 @@ func Test_ListIssues(t *testing.T) {
 				"repo":  "repo",
 				"state": "open",
 			},
-			expectError:   false,
-			expectedCount: 2,
+			expectError:             false,
+			expectedCount:           2,
+			expectedNewestUpdatedAt: "2023-02-01T00:00:00Z",
 		},
 		{
 			name: "filter by closed state",
@@ -2161,8 +2400,9 @@ func Test_ListIssues(t *testing.T) {
 				"repo":  "repo",
 				"state": "CLOSED",
 			},
-			expectError:   false,
-			expectedCount: 1,
+			expectError:             false,
+			expectedCount:           1,
+			expectedNewestUpdatedAt: "2023-03-01T00:00:00Z",
 		},
 		{
 			name: "filter by labels",
@@ -2171,8 +2411,93 @@ func Test_ListIssues(t *testing.T) {
 				"repo":   "repo",
 				"labels": []any{"bug", "enhancement"},
 			},
-			expectError:   false,
-			expectedCount: 2,
+			expectError:             false,
+			expectedCount:           2,
+			expectedNewestUpdatedAt: "2023-02-01T00:00:00Z",
+		},
+		{
+			name: "issues updated since",
+			reqParams: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"since":     "2023-01-15T00:00:00Z",
+				"orderBy":   "UPDATED_AT",
+				"direction": "ASC",
+			},
+			expectError:             false,
+			expectedCount:           1,
+			expectedNewestUpdatedAt: "2023-03-01T00:00:00Z",
+		},
+		{
+			name: "issues with all labels via search",
+			reqParams: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"state":       "OPEN",
+				"labels":      []any{"bug", "enhancement"},
+				"label_match": "all",
+			},
+			expectError:             false,
+			expectedCount:           1,
+			expectedNewestUpdatedAt: "2023-02-01T00:00:00Z",
+		},
+		{
+			name: "issues with any label assigned to a user via search",
+			reqParams: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"labels":   []any{"bug", "enhancement"},
+				"assignee": "@octocat",
+			},
+			expectError:             false,
+			expectedCount:           1,
+			expectedNewestUpdatedAt: "2023-02-01T00:00:00Z",
+		},
+		{
+			name: "unassigned issues via search",
+			reqParams: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"state":    "CLOSED",
+				"assignee": "none",
+			},
+			expectError:             false,
+			expectedCount:           1,
+			expectedNewestUpdatedAt: "2023-02-01T00:00:00Z",
+		},
+		{
+			name: "label with a double quote",
+			reqParams: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"labels":      []any{`say "hi"`, "bug"},
+				"label_match": "all",
+			},
+			expectError: true,
+			errContains: "cannot contain a double quote",
+		},
+		{
+			name: "assignee with field_filters",
+			reqParams: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"assignee":      "octocat",
+				"field_filters": []any{map[string]any{"field_name": "priority", "value": "P1"}},
+			},
+			expectError: true,
+			errContains: "assignee cannot be combined with field_filters",
+		},
+		{
+			name: "label_match all with field_filters",
+			reqParams: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"labels":        []any{"bug", "enhancement"},
+				"label_match":   "all",
+				"field_filters": []any{map[string]any{"field_name": "priority", "value": "P1"}},
+			},
+			expectError: true,
+			errContains: "label_match 'all' cannot be combined with field_filters",
 		},
 		{
 			name: "repository not found error",
@@ -2190,6 +2515,11 @@ func Test_ListIssues(t *testing.T) {
 	qBasicNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
 	qWithLabels := "query($after:String$direction:OrderDirection!$first:Int!$issueFieldValues:[IssueFieldValueFilter!]!$labels:[String!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: {issueFieldValues: $issueFieldValues}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}," + issueFieldValuesSelection + "},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount},isPrivate}}"
 
+	qSearchAllLabels := "query($after:String$first:Int!$owner:String!$query:String!$repo:String!){search(query: $query, type: ISSUE, first: $first, after: $after){nodes{... on Issue{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}," + issueFieldValuesSelection + "}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},issueCount},repository(owner: $owner, name: $repo){isPrivate}}"
+	qBasicNoLabelsWithSince := strings.Replace(
+		strings.Replace(qBasicNoLabels, "$repo:String!", "$repo:String!$since:DateTime!", 1),
+		"filterBy: {issueFieldValues: $issueFieldValues}", "filterBy: {since: $since, issueFieldValues: $issueFieldValues}", 1)
+
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			var httpClient *http.Client
@@ -2210,6 +2540,20 @@ func Test_ListIssues(t *testing.T) {
 			case "filter by labels":
 				matcher := githubv4mock.NewQueryMatcher(qWithLabels, varsWithLabels, mockResponseListAll)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "issues updated since":
+				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabelsWithSince, varsUpdatedSince, mockResponseClosedOnly)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "issues with all labels via search":
+				matcher := githubv4mock.NewQueryMatcher(qSearchAllLabels, varsAllLabels, mockResponseSearchAllLabels)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "issues with any label assigned to a user via search":
+				matcher := githubv4mock.NewQueryMatcher(qSearchAllLabels, varsAssignee, mockResponseSearchAllLabels)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "unassigned issues via search":
+				matcher := githubv4mock.NewQueryMatcher(qSearchAllLabels, varsUnassigned, mockResponseSearchAllLabels)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "label_match all with field_filters", "label with a double quote", "assignee with field_filters":
+				httpClient = githubv4mock.NewMockedHTTPClient()
 			case "repository not found error":
 				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, varsRepoNotFound, mockErrorRepoNotFound)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
@@ -2241,6 +2585,7 @@ func Test_ListIssues(t *testing.T) {
 
 			// Verify pagination metadata
 			assert.Equal(t, tc.expectedCount, response.TotalCount)
+			assert.Equal(t, tc.expectedNewestUpdatedAt, response.NewestUpdatedAt)
 			assert.False(t, response.PageInfo.HasNextPage)
 			assert.False(t, response.PageInfo.HasPreviousPage)
 
@@ -3581,7 +3926,7 @@ func Test_GetIssueComments(t *testing.T) {
 				"issue_number": float64(999),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get issue comments",
+			expectedErrMsg: "failed to get issue comments: GET",
 		},
 		{
 			name: "lockdown enabled filters comments without push access",
@@ -3645,13 +3990,14 @@ func Test_GetIssueComments(t *testing.T) {
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 			// Verify results
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				assert.Contains(t, errorContent.Text, "status: 404")
 				return
 			}
 
-			require.NoError(t, err)
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
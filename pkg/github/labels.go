@@ -220,7 +220,7 @@ func ListLabels(t translations.TranslationHelperFunc) inventory.ServerTool {
 
 // LabelWrite handles create, update, and delete operations for GitHub labels
 func LabelWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
-	return NewTool(
+	st := NewTool(
 		ToolsetLabels,
 		mcp.Tool{
 			Name:        "label_write",
@@ -409,6 +409,8 @@ func LabelWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 			}
 		},
 	)
+	st.FeatureFlagDisable = []string{FeatureFlagLabelsGranular}
+	return st
 }
 
 // Helper function to get repository ID
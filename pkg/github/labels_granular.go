@@ -0,0 +1,293 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var labelColorPattern = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// normalizeLabelColor validates a label color given as 6 hex digits, with or
+// without a leading '#', and returns it in the lower-case form GitHub stores.
+func normalizeLabelColor(color string) (string, error) {
+	color = strings.TrimPrefix(strings.TrimSpace(color), "#")
+	if !labelColorPattern.MatchString(color) {
+		return "", fmt.Errorf("color must be a 6-character hex code such as 'f29513', got %q", color)
+	}
+	return strings.ToLower(color), nil
+}
+
+func labelResult(label *github.Label) *mcp.CallToolResult {
+	return MarshalledTextResult(map[string]any{
+		"name":        label.GetName(),
+		"color":       label.GetColor(),
+		"description": label.GetDescription(),
+	})
+}
+
+func labelRepoProperties() map[string]*jsonschema.Schema {
+	return map[string]*jsonschema.Schema{
+		"owner": {
+			Type:        "string",
+			Description: "Repository owner (username or organization)",
+		},
+		"repo": {
+			Type:        "string",
+			Description: "Repository name",
+		},
+	}
+}
+
+// GranularCreateLabel creates a tool to create a repository label.
+func GranularCreateLabel(t translations.TranslationHelperFunc) inventory.ServerTool {
+	props := labelRepoProperties()
+	props["name"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Label name",
+	}
+	props["color"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Label color as a 6-character hex code, e.g. 'f29513'",
+	}
+	props["description"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Label description (optional)",
+	}
+
+	st := NewTool(
+		ToolsetLabels,
+		mcp.Tool{
+			Name:        "create_label",
+			Description: t("TOOL_CREATE_LABEL_DESCRIPTION", "Create a new label in a GitHub repository with a name, color and optional description."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_CREATE_LABEL_USER_TITLE", "Create Label"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: props,
+				Required:   []string{"owner", "repo", "name", "color"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			name, err := RequiredParam[string](args, "name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			color, err := RequiredParam[string](args, "color")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if color, err = normalizeLabelColor(color); err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			description, err := OptionalParam[string](args, "description")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			labelReq := &github.Label{
+				Name:  &name,
+				Color: &color,
+			}
+			if description != "" {
+				labelReq.Description = &description
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			label, resp, err := client.Issues.CreateLabel(ctx, owner, repo, labelReq)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create label", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return labelResult(label), nil, nil
+		},
+	)
+	st.FeatureFlagEnable = FeatureFlagLabelsGranular
+	return st
+}
+
+// GranularUpdateLabel creates a tool to rename a repository label or change
+// its color or description.
+func GranularUpdateLabel(t translations.TranslationHelperFunc) inventory.ServerTool {
+	props := labelRepoProperties()
+	props["name"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Current label name",
+	}
+	props["new_name"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "New label name",
+	}
+	props["color"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "New label color as a 6-character hex code, e.g. 'f29513'",
+	}
+	props["description"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "New label description. An empty string clears it.",
+	}
+
+	st := NewTool(
+		ToolsetLabels,
+		mcp.Tool{
+			Name:        "update_label",
+			Description: t("TOOL_UPDATE_LABEL_DESCRIPTION", "Update an existing label in a GitHub repository: rename it or change its color or description. Issues keep the label when it is renamed."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_UPDATE_LABEL_USER_TITLE", "Update Label"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: props,
+				Required:   []string{"owner", "repo", "name"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			name, err := RequiredParam[string](args, "name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			newName, err := OptionalParam[string](args, "new_name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			color, err := OptionalParam[string](args, "color")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			description, hasDescription, err := OptionalParamOK[string](args, "description")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			labelReq := &github.Label{}
+			if newName != "" {
+				labelReq.Name = &newName
+			}
+			if color != "" {
+				if color, err = normalizeLabelColor(color); err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				labelReq.Color = &color
+			}
+			if hasDescription {
+				labelReq.Description = &description
+			}
+			if labelReq.Name == nil && labelReq.Color == nil && labelReq.Description == nil {
+				return utils.NewToolResultError("at least one of new_name, color, or description must be provided"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			label, resp, err := client.Issues.EditLabel(ctx, owner, repo, name, labelReq)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update label", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return labelResult(label), nil, nil
+		},
+	)
+	st.FeatureFlagEnable = FeatureFlagLabelsGranular
+	return st
+}
+
+// GranularDeleteLabel creates a tool to delete a repository label.
+func GranularDeleteLabel(t translations.TranslationHelperFunc) inventory.ServerTool {
+	props := labelRepoProperties()
+	props["name"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Name of the label to delete",
+	}
+
+	st := NewTool(
+		ToolsetLabels,
+		mcp.Tool{
+			Name:        "delete_label",
+			Description: t("TOOL_DELETE_LABEL_DESCRIPTION", "Delete a label from a GitHub repository. The label is also removed from every issue and pull request that has it."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DELETE_LABEL_USER_TITLE", "Delete Label"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: props,
+				Required:   []string{"owner", "repo", "name"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			name, err := RequiredParam[string](args, "name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			resp, err := client.Issues.DeleteLabel(ctx, owner, repo, name)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete label", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return utils.NewToolResultText(fmt.Sprintf("label '%s' deleted successfully", name)), nil, nil
+		},
+	)
+	st.FeatureFlagEnable = FeatureFlagLabelsGranular
+	return st
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ToolSummary is one tool in the output of list_tools.
+type ToolSummary struct {
+	Name        string `json:"name"`
+	Toolset     string `json:"toolset"`
+	Description string `json:"description"`
+	ReadOnly    bool   `json:"read_only"`
+	InputSchema any    `json:"input_schema,omitempty"`
+}
+
+// ListToolsTool creates a tool that lists the tools enabled in this session
+// with a one-line description of each. It is registered by the local server,
+// which owns the inventory, rather than through AllTools.
+func ListToolsTool(t translations.TranslationHelperFunc, catalog *ToolsetCatalog) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "list_tools",
+			Description: t("TOOL_LIST_TOOLS_DESCRIPTION",
+				"List every tool enabled in this session, with its toolset, whether it is read-only and a one-line description. "+
+					"Use this to plan which tools to use. Set with_schemas to also get each tool's input schema, which makes the response much larger."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_TOOLS_USER_TITLE", "List enabled tools"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"with_schemas": {
+						Type:        "boolean",
+						Description: "Include each tool's input schema",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			withSchemas, err := OptionalParam[bool](args, "with_schemas")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if catalog.Inventory == nil {
+				return utils.NewToolResultError("tool information is not available yet"), nil, nil
+			}
+
+			tools := catalog.Inventory.ToolsForRegistration(ctx)
+			summaries := make([]ToolSummary, 0, len(tools))
+			for _, tool := range tools {
+				summary := ToolSummary{
+					Name:        tool.Tool.Name,
+					Toolset:     string(tool.Toolset.ID),
+					Description: firstLine(tool.Tool.Description),
+					ReadOnly:    tool.IsReadOnly(),
+				}
+				if withSchemas {
+					summary.InputSchema = tool.Tool.InputSchema
+				}
+				summaries = append(summaries, summary)
+			}
+
+			result := MarshalledTextResult(map[string]any{
+				"total_count": len(summaries),
+				"tools":       summaries,
+			})
+			return attachStaticIFCLabel(ctx, deps, result, ifc.PublicTrusted()), nil, nil
+		},
+	)
+}
+
+// firstLine returns the first non-empty line of s, trimmed.
+func firstLine(s string) string {
+	for line := range strings.SplitSeq(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListTools(t *testing.T) {
+	serverTool := ListToolsTool(translations.NullTranslationHelper, &ToolsetCatalog{})
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_tools", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, ToolsetMetadataContext.ID, serverTool.Toolset.ID)
+
+	type listToolsResponse struct {
+		TotalCount int `json:"total_count"`
+		Tools      []struct {
+			Name        string         `json:"name"`
+			Toolset     string         `json:"toolset"`
+			Description string         `json:"description"`
+			ReadOnly    bool           `json:"read_only"`
+			InputSchema map[string]any `json:"input_schema"`
+		} `json:"tools"`
+	}
+	listTools := func(t *testing.T, catalog *ToolsetCatalog, args map[string]any) listToolsResponse {
+		t.Helper()
+		st := ListToolsTool(translations.NullTranslationHelper, catalog)
+		deps := BaseDeps{}
+		request := createMCPRequest(args)
+		result, err := st.Handler(deps)(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response listToolsResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		return response
+	}
+
+	t.Run("lists enabled tools without schemas", func(t *testing.T) {
+		inv, err := NewInventory(translations.NullTranslationHelper).
+			WithToolsets([]string{"issues"}).
+			WithReadOnly(true).
+			Build()
+		require.NoError(t, err)
+
+		response := listTools(t, &ToolsetCatalog{Inventory: inv}, map[string]any{})
+		require.NotEmpty(t, response.Tools)
+		assert.Equal(t, len(response.Tools), response.TotalCount)
+		names := make([]string, 0, len(response.Tools))
+		for _, tool := range response.Tools {
+			names = append(names, tool.Name)
+			assert.Equal(t, "issues", tool.Toolset)
+			assert.True(t, tool.ReadOnly)
+			assert.NotEmpty(t, tool.Description)
+			assert.NotContains(t, tool.Description, "\n")
+			assert.Nil(t, tool.InputSchema)
+		}
+		assert.Contains(t, names, "list_issues")
+		assert.NotContains(t, names, "issue_write")
+		assert.NotContains(t, names, "get_me")
+	})
+
+	t.Run("with_schemas includes input schemas and names carry the prefix", func(t *testing.T) {
+		inv, err := NewInventory(translations.NullTranslationHelper).
+			WithToolsets([]string{"context"}).
+			WithToolNamePrefix("gh_").
+			Build()
+		require.NoError(t, err)
+
+		response := listTools(t, &ToolsetCatalog{Inventory: inv}, map[string]any{"with_schemas": true})
+		require.NotEmpty(t, response.Tools)
+		for _, tool := range response.Tools {
+			assert.Contains(t, tool.Name, "gh_")
+			assert.Equal(t, "object", tool.InputSchema["type"])
+		}
+	})
+}
+
+func Test_FirstLine(t *testing.T) {
+	assert.Equal(t, "Get a file.", firstLine("\n  Get a file.\nMore detail."))
+	assert.Empty(t, firstLine(""))
+}
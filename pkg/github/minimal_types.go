@@ -333,6 +333,37 @@ type MinimalCollaborator struct {
 	RoleName string `json:"role_name"`
 }
 
+// MinimalEvent is the trimmed output type for activity feed events.
+type MinimalEvent struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Actor     string `json:"actor,omitempty"`
+	Repo      string `json:"repo,omitempty"`
+	Public    bool   `json:"public"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+func convertToMinimalEvent(event *github.Event) MinimalEvent {
+	m := MinimalEvent{
+		ID:     event.GetID(),
+		Type:   event.GetType(),
+		Actor:  event.GetActor().GetLogin(),
+		Repo:   event.GetRepo().GetName(),
+		Public: event.GetPublic(),
+	}
+	if event.CreatedAt != nil {
+		m.CreatedAt = event.CreatedAt.Format(time.RFC3339)
+	}
+	return m
+}
+
+// MinimalStargazer is the trimmed output type for repository stargazers.
+type MinimalStargazer struct {
+	Login     string `json:"login"`
+	ID        int64  `json:"id"`
+	StarredAt string `json:"starred_at,omitempty"`
+}
+
 type MinimalProject struct {
 	ID               *int64            `json:"id,omitempty"`
 	NodeID           *string           `json:"node_id,omitempty"`
@@ -508,6 +539,9 @@ type MinimalIssuesResponse struct {
 	Issues     []MinimalIssue  `json:"issues"`
 	TotalCount int             `json:"totalCount"`
 	PageInfo   MinimalPageInfo `json:"pageInfo"`
+	// NewestUpdatedAt is the latest updated_at among Issues. Passing it as
+	// since on the next call polls for issues changed after this page.
+	NewestUpdatedAt string `json:"newestUpdatedAt,omitempty"`
 }
 
 // MinimalIssueComment is the trimmed output type for issue comment objects to reduce verbosity.
@@ -579,6 +613,9 @@ type MinimalPullRequest struct {
 	ClosedAt           string           `json:"closed_at,omitempty"`
 	MergedAt           string           `json:"merged_at,omitempty"`
 	Milestone          string           `json:"milestone,omitempty"`
+	// Reactions is only set when pull_request_read is asked to include them,
+	// since the pull request endpoint does not return them.
+	Reactions *MinimalReactions `json:"reactions,omitempty"`
 }
 
 // MinimalPRBranch is the trimmed output type for pull request branch references.
@@ -705,19 +742,7 @@ func convertToMinimalIssue(issue *github.Issue) MinimalIssue {
 		m.IssueFieldValues = append(m.IssueFieldValues, mfv)
 	}
 
-	if r := issue.Reactions; r != nil {
-		m.Reactions = &MinimalReactions{
-			TotalCount: r.GetTotalCount(),
-			PlusOne:    r.GetPlusOne(),
-			MinusOne:   r.GetMinusOne(),
-			Laugh:      r.GetLaugh(),
-			Confused:   r.GetConfused(),
-			Heart:      r.GetHeart(),
-			Hooray:     r.GetHooray(),
-			Rocket:     r.GetRocket(),
-			Eyes:       r.GetEyes(),
-		}
-	}
+	m.Reactions = convertToMinimalReactions(issue.Reactions)
 
 	return m
 }
@@ -779,11 +804,15 @@ func fragmentToMinimalFieldValue(fv IssueFieldValueFragment) (MinimalFieldValue,
 
 func convertToMinimalIssuesResponse(fragment IssueQueryFragment) MinimalIssuesResponse {
 	minimalIssues := make([]MinimalIssue, 0, len(fragment.Nodes))
+	var newestUpdatedAt time.Time
 	for _, issue := range fragment.Nodes {
 		minimalIssues = append(minimalIssues, fragmentToMinimalIssue(issue))
+		if issue.UpdatedAt.After(newestUpdatedAt) {
+			newestUpdatedAt = issue.UpdatedAt.Time
+		}
 	}
 
-	return MinimalIssuesResponse{
+	resp := MinimalIssuesResponse{
 		Issues:     minimalIssues,
 		TotalCount: fragment.TotalCount,
 		PageInfo: MinimalPageInfo{
@@ -793,6 +822,10 @@ func convertToMinimalIssuesResponse(fragment IssueQueryFragment) MinimalIssuesRe
 			EndCursor:       string(fragment.PageInfo.EndCursor),
 		},
 	}
+	if !newestUpdatedAt.IsZero() {
+		resp.NewestUpdatedAt = newestUpdatedAt.Format(time.RFC3339)
+	}
+	return resp
 }
 
 func convertToMinimalIssueComment(comment *github.IssueComment) MinimalIssueComment {
@@ -811,23 +844,30 @@ func convertToMinimalIssueComment(comment *github.IssueComment) MinimalIssueComm
 		m.UpdatedAt = comment.UpdatedAt.Format(time.RFC3339)
 	}
 
-	if r := comment.Reactions; r != nil {
-		m.Reactions = &MinimalReactions{
-			TotalCount: r.GetTotalCount(),
-			PlusOne:    r.GetPlusOne(),
-			MinusOne:   r.GetMinusOne(),
-			Laugh:      r.GetLaugh(),
-			Confused:   r.GetConfused(),
-			Heart:      r.GetHeart(),
-			Hooray:     r.GetHooray(),
-			Rocket:     r.GetRocket(),
-			Eyes:       r.GetEyes(),
-		}
-	}
+	m.Reactions = convertToMinimalReactions(comment.Reactions)
 
 	return m
 }
 
+// convertToMinimalReactions returns nil when r is nil, so the reactions
+// field is omitted rather than reported as all zeros.
+func convertToMinimalReactions(r *github.Reactions) *MinimalReactions {
+	if r == nil {
+		return nil
+	}
+	return &MinimalReactions{
+		TotalCount: r.GetTotalCount(),
+		PlusOne:    r.GetPlusOne(),
+		MinusOne:   r.GetMinusOne(),
+		Laugh:      r.GetLaugh(),
+		Confused:   r.GetConfused(),
+		Heart:      r.GetHeart(),
+		Hooray:     r.GetHooray(),
+		Rocket:     r.GetRocket(),
+		Eyes:       r.GetEyes(),
+	}
+}
+
 func convertToMinimalFileContentResponse(resp *github.RepositoryContentResponse) MinimalFileContentResponse {
 	m := MinimalFileContentResponse{}
 
@@ -1848,6 +1888,47 @@ func convertToMinimalCheckRun(checkRun *github.CheckRun) MinimalCheckRun {
 	return minimalCheckRun
 }
 
+// MinimalCommitStatus is the trimmed output type for a single commit status context.
+type MinimalCommitStatus struct {
+	Context     string `json:"context"`
+	State       string `json:"state"`
+	Description string `json:"description,omitempty"`
+	TargetURL   string `json:"target_url,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// MinimalCombinedStatus is the trimmed output type for combined commit status results.
+type MinimalCombinedStatus struct {
+	State      string                `json:"state"`
+	SHA        string                `json:"sha"`
+	TotalCount int                   `json:"total_count"`
+	Statuses   []MinimalCommitStatus `json:"statuses"`
+}
+
+// convertToMinimalCombinedStatus converts a GitHub API CombinedStatus to MinimalCombinedStatus
+func convertToMinimalCombinedStatus(combined *github.CombinedStatus) MinimalCombinedStatus {
+	statuses := make([]MinimalCommitStatus, 0, len(combined.Statuses))
+	for _, status := range combined.Statuses {
+		m := MinimalCommitStatus{
+			Context:     status.GetContext(),
+			State:       status.GetState(),
+			Description: status.GetDescription(),
+			TargetURL:   status.GetTargetURL(),
+		}
+		if status.UpdatedAt != nil {
+			m.UpdatedAt = status.UpdatedAt.Format("2006-01-02T15:04:05Z")
+		}
+		statuses = append(statuses, m)
+	}
+
+	return MinimalCombinedStatus{
+		State:      combined.GetState(),
+		SHA:        combined.GetSHA(),
+		TotalCount: combined.GetTotalCount(),
+		Statuses:   statuses,
+	}
+}
+
 func convertToMinimalReviewThreadsResponse(query reviewThreadsQuery) MinimalReviewThreadsResponse {
 	threads := query.Repository.PullRequest.ReviewThreads
 
@@ -1906,3 +1987,64 @@ func convertToMinimalReviewComment(c reviewCommentNode) MinimalReviewComment {
 
 	return m
 }
+
+// MinimalDeployment is the trimmed output type for deployment objects.
+// State is taken from the deployment's most recent status and is empty when
+// no status has been reported yet.
+type MinimalDeployment struct {
+	ID          int64  `json:"id"`
+	SHA         string `json:"sha"`
+	Ref         string `json:"ref"`
+	Task        string `json:"task,omitempty"`
+	Environment string `json:"environment"`
+	Description string `json:"description,omitempty"`
+	State       string `json:"state,omitempty"`
+	Creator     string `json:"creator,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+// MinimalDeploymentStatus is the trimmed output type for deployment status objects.
+type MinimalDeploymentStatus struct {
+	ID             int64  `json:"id"`
+	State          string `json:"state"`
+	Description    string `json:"description,omitempty"`
+	Environment    string `json:"environment,omitempty"`
+	EnvironmentURL string `json:"environment_url,omitempty"`
+	LogURL         string `json:"log_url,omitempty"`
+	Creator        string `json:"creator,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+}
+
+// convertToMinimalDeployment converts a GitHub API Deployment to MinimalDeployment
+func convertToMinimalDeployment(deployment *github.Deployment) MinimalDeployment {
+	m := MinimalDeployment{
+		ID:          deployment.GetID(),
+		SHA:         deployment.GetSHA(),
+		Ref:         deployment.GetRef(),
+		Task:        deployment.GetTask(),
+		Environment: deployment.GetEnvironment(),
+		Description: deployment.GetDescription(),
+		Creator:     deployment.GetCreator().GetLogin(),
+	}
+	if deployment.CreatedAt != nil {
+		m.CreatedAt = deployment.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
+
+// convertToMinimalDeploymentStatus converts a GitHub API DeploymentStatus to MinimalDeploymentStatus
+func convertToMinimalDeploymentStatus(status *github.DeploymentStatus) MinimalDeploymentStatus {
+	m := MinimalDeploymentStatus{
+		ID:             status.GetID(),
+		State:          status.GetState(),
+		Description:    status.GetDescription(),
+		Environment:    status.GetEnvironment(),
+		EnvironmentURL: status.GetEnvironmentURL(),
+		LogURL:         status.GetLogURL(),
+		Creator:        status.GetCreator().GetLogin(),
+	}
+	if status.CreatedAt != nil {
+		m.CreatedAt = status.CreatedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
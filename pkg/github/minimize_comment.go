@@ -0,0 +1,223 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MinimizedCommentResult is the output of minimize_comment and
+// unminimize_comment.
+type MinimizedCommentResult struct {
+	NodeID      string `json:"node_id"`
+	IsMinimized bool   `json:"is_minimized"`
+	// MinimizedReason is the lower-cased classifier the comment was hidden
+	// with, such as spam or off-topic.
+	MinimizedReason string `json:"minimized_reason,omitempty"`
+}
+
+// minimizedComment is the Minimizable selection shared by the minimize and
+// unminimize mutations.
+type minimizedComment struct {
+	IsMinimized     githubv4.Boolean
+	MinimizedReason githubv4.String
+}
+
+// commentTargetProperties returns the schema properties identifying the
+// comment to minimize or unminimize.
+func commentTargetProperties() map[string]*jsonschema.Schema {
+	return map[string]*jsonschema.Schema{
+		"node_id": {
+			Type:        "string",
+			Description: "The GraphQL node ID of the comment. Works for issue, pull request, review, commit and discussion comments. Provide either this or owner, repo and comment_id",
+		},
+		"owner": {
+			Type:        "string",
+			Description: "Repository owner. Required with comment_id",
+		},
+		"repo": {
+			Type:        "string",
+			Description: "Repository name. Required with comment_id",
+		},
+		"comment_id": {
+			Type:        "number",
+			Description: "The numeric ID of an issue or pull request comment, resolved to its node ID",
+			Minimum:     jsonschema.Ptr(1.0),
+		},
+	}
+}
+
+// resolveCommentNodeID returns the node ID of the comment identified by
+// either node_id or owner, repo and comment_id. A non-nil result reports why
+// the comment could not be identified.
+func resolveCommentNodeID(ctx context.Context, deps ToolDependencies, args map[string]any) (string, *mcp.CallToolResult) {
+	nodeID, err := OptionalParam[string](args, "node_id")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	_, hasCommentID := args["comment_id"]
+	nodeID = strings.TrimSpace(nodeID)
+	switch {
+	case nodeID != "" && hasCommentID:
+		return "", utils.NewToolResultError("provide either node_id or comment_id, not both")
+	case nodeID != "":
+		return nodeID, nil
+	case !hasCommentID:
+		return "", utils.NewToolResultError("one of node_id or comment_id is required")
+	}
+
+	owner, err := RequiredParam[string](args, "owner")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	repo, err := RequiredParam[string](args, "repo")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	commentID, err := RequiredBigInt(args, "comment_id")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	if commentID < 1 {
+		return "", utils.NewToolResultError("comment_id must be greater than 0")
+	}
+
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return "", utils.NewToolResultErrorFromErr("failed to get GitHub client", err)
+	}
+	comment, resp, err := client.Issues.GetComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return "", ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue comment", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	if comment.GetNodeID() == "" {
+		return "", utils.NewToolResultError(fmt.Sprintf("comment %d has no node ID", commentID))
+	}
+	return comment.GetNodeID(), nil
+}
+
+// MinimizeComment creates a tool to hide a comment with a classifier.
+func MinimizeComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	properties := commentTargetProperties()
+	properties["classifier"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Why the comment is hidden",
+		Enum:        []any{"SPAM", "ABUSE", "OFF_TOPIC", "OUTDATED", "DUPLICATE", "RESOLVED"},
+	}
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name: "minimize_comment",
+			Description: t("TOOL_MINIMIZE_COMMENT_DESCRIPTION",
+				"Minimize (hide) a comment as spam, abuse, off-topic, outdated, duplicate or resolved. The comment stays on the thread, collapsed, and can be restored with unminimize_comment. "+
+					"Prefer this to deleting comments when moderating."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MINIMIZE_COMMENT_USER_TITLE", "Minimize comment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: properties,
+				Required:   []string{"classifier"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			classifier, err := RequiredParam[string](args, "classifier")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			nodeID, errResult := resolveCommentNodeID(ctx, deps, args)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
+			}
+
+			var mutation struct {
+				MinimizeComment struct {
+					MinimizedComment minimizedComment
+				} `graphql:"minimizeComment(input: $input)"`
+			}
+			input := githubv4.MinimizeCommentInput{
+				SubjectID:  githubv4.ID(nodeID),
+				Classifier: githubv4.ReportedContentClassifiers(classifier),
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to minimize comment", err), nil, nil
+			}
+
+			comment := mutation.MinimizeComment.MinimizedComment
+			return MarshalledTextResult(MinimizedCommentResult{
+				NodeID:          nodeID,
+				IsMinimized:     bool(comment.IsMinimized),
+				MinimizedReason: string(comment.MinimizedReason),
+			}), nil, nil
+		},
+	)
+}
+
+// UnminimizeComment creates a tool to restore a minimized comment.
+func UnminimizeComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "unminimize_comment",
+			Description: t("TOOL_UNMINIMIZE_COMMENT_DESCRIPTION", "Unminimize (unhide) a comment that was previously minimized, showing it on the thread again."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UNMINIMIZE_COMMENT_USER_TITLE", "Unminimize comment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: commentTargetProperties(),
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			nodeID, errResult := resolveCommentNodeID(ctx, deps, args)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+
+			client, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
+			}
+
+			var mutation struct {
+				UnminimizeComment struct {
+					UnminimizedComment minimizedComment
+				} `graphql:"unminimizeComment(input: $input)"`
+			}
+			input := githubv4.UnminimizeCommentInput{
+				SubjectID: githubv4.ID(nodeID),
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unminimize comment", err), nil, nil
+			}
+
+			comment := mutation.UnminimizeComment.UnminimizedComment
+			return MarshalledTextResult(MinimizedCommentResult{
+				NodeID:          nodeID,
+				IsMinimized:     bool(comment.IsMinimized),
+				MinimizedReason: string(comment.MinimizedReason),
+			}), nil, nil
+		},
+	)
+}
@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MinimizeComment(t *testing.T) {
+	serverTool := MinimizeComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "minimize_comment", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "node_id")
+	assert.Contains(t, schema.Properties, "comment_id")
+	assert.ElementsMatch(t, schema.Required, []string{"classifier"})
+
+	mutationMatcher := githubv4mock.NewMutationMatcher(
+		struct {
+			MinimizeComment struct {
+				MinimizedComment minimizedComment
+			} `graphql:"minimizeComment(input: $input)"`
+		}{},
+		githubv4.MinimizeCommentInput{
+			SubjectID:  githubv4.ID("IC_kwDOComment123"),
+			Classifier: githubv4.ReportedContentClassifiersSpam,
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"minimizeComment": map[string]any{
+				"minimizedComment": map[string]any{
+					"isMinimized":     true,
+					"minimizedReason": "spam",
+				},
+			},
+		}),
+	)
+
+	tests := []struct {
+		name           string
+		handlers       map[string]http.HandlerFunc
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "minimizes by node ID",
+			requestArgs: map[string]any{
+				"node_id":    "IC_kwDOComment123",
+				"classifier": "SPAM",
+			},
+		},
+		{
+			name: "resolves the node ID from the comment ID",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, &github.IssueComment{
+					ID:     github.Ptr(int64(123)),
+					NodeID: github.Ptr("IC_kwDOComment123"),
+				}),
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+				"classifier": "SPAM",
+			},
+		},
+		{
+			name: "comment ID not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(999),
+				"classifier": "SPAM",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get issue comment",
+		},
+		{
+			name: "both node ID and comment ID",
+			requestArgs: map[string]any{
+				"node_id":    "IC_kwDOComment123",
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+				"classifier": "SPAM",
+			},
+			expectError:    true,
+			expectedErrMsg: "provide either node_id or comment_id, not both",
+		},
+		{
+			name: "no comment identified",
+			requestArgs: map[string]any{
+				"classifier": "SPAM",
+			},
+			expectError:    true,
+			expectedErrMsg: "one of node_id or comment_id is required",
+		},
+		{
+			name: "comment ID without repository",
+			requestArgs: map[string]any{
+				"comment_id": float64(123),
+				"classifier": "SPAM",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: owner",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(mutationMatcher)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var got MinimizedCommentResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, MinimizedCommentResult{
+				NodeID:          "IC_kwDOComment123",
+				IsMinimized:     true,
+				MinimizedReason: "spam",
+			}, got)
+		})
+	}
+}
+
+func Test_UnminimizeComment(t *testing.T) {
+	serverTool := UnminimizeComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unminimize_comment", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+
+	mutation := struct {
+		UnminimizeComment struct {
+			UnminimizedComment minimizedComment
+		} `graphql:"unminimizeComment(input: $input)"`
+	}{}
+	input := githubv4.UnminimizeCommentInput{SubjectID: githubv4.ID("IC_kwDOComment123")}
+
+	tests := []struct {
+		name           string
+		response       githubv4mock.GQLResponse
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "unminimizes the comment",
+			response: githubv4mock.DataResponse(map[string]any{
+				"unminimizeComment": map[string]any{
+					"unminimizedComment": map[string]any{
+						"isMinimized":     false,
+						"minimizedReason": nil,
+					},
+				},
+			}),
+		},
+		{
+			name:           "mutation fails",
+			response:       githubv4mock.ErrorResponse("Could not resolve to a node with the global id of 'IC_kwDOComment123'"),
+			expectError:    true,
+			expectedErrMsg: "failed to unminimize comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := githubv4mock.NewMutationMatcher(mutation, input, nil, tc.response)
+			deps := BaseDeps{
+				GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{"node_id": "IC_kwDOComment123"})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var got MinimizedCommentResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+			assert.Equal(t, MinimizedCommentResult{NodeID: "IC_kwDOComment123"}, got)
+		})
+	}
+}
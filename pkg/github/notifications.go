@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -46,7 +47,7 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 					},
 					"since": {
 						Type:        "string",
-						Description: "Only show notifications updated after the given time (ISO 8601 format)",
+						Description: "Only show notifications updated after the given time (ISO 8601 format). To poll for new notifications, pass the 'newest_updated_at' of the previous call",
 					},
 					"before": {
 						Type:        "string",
@@ -60,6 +61,17 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 						Type:        "string",
 						Description: "Optional repository name. If provided with owner, only notifications for this repository are listed.",
 					},
+					"reason": {
+						Type: "string",
+						Description: "Only show notifications with this reason, e.g. 'review_requested' to find pull requests awaiting your review. " +
+							"GitHub cannot filter by reason, so this is applied to each fetched page: a page may hold fewer than perPage notifications, or none, while later pages still have matches",
+						Enum: notificationReasons,
+					},
+					"return_count_only": {
+						Type:        "boolean",
+						Description: fmt.Sprintf("Return only the number of matching notifications and the newest 'updated_at' among them instead of the notifications themselves. Pagination parameters are ignored; counting stops after scanning %d notifications", maxNotificationCount),
+						Default:     json.RawMessage(`false`),
+					},
 				},
 			}),
 		},
@@ -94,7 +106,17 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			paginationParams, err := OptionalPaginationParams(args)
+			reason, err := OptionalParam[string](args, "reason")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			countOnly, err := OptionalBoolParamWithDefault(args, "return_count_only", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			paginationParams, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -126,14 +148,23 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 				opts.Before = beforeTime
 			}
 
-			var notifications []*github.Notification
-			var resp *github.Response
+			listNotifications := func(opts *github.NotificationListOptions) ([]*github.Notification, *github.Response, error) {
+				var notifications []*github.Notification
+				var resp *github.Response
+				var err error
+				if owner != "" && repo != "" {
+					notifications, resp, err = client.Activity.ListRepositoryNotifications(ctx, owner, repo, opts)
+				} else {
+					notifications, resp, err = client.Activity.ListNotifications(ctx, opts)
+				}
+				return filterNotificationsByReason(notifications, reason), resp, err
+			}
 
-			if owner != "" && repo != "" {
-				notifications, resp, err = client.Activity.ListRepositoryNotifications(ctx, owner, repo, opts)
-			} else {
-				notifications, resp, err = client.Activity.ListNotifications(ctx, opts)
+			if countOnly {
+				return countNotifications(ctx, listNotifications, opts), nil, nil
 			}
+
+			notifications, resp, err := listNotifications(opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to list notifications",
@@ -162,6 +193,89 @@ func ListNotifications(t translations.TranslationHelperFunc) inventory.ServerToo
 	)
 }
 
+// notificationReasons lists the reasons GitHub gives for sending a notification.
+var notificationReasons = []any{
+	"approval_requested", "assign", "author", "ci_activity", "comment", "invitation", "manual",
+	"member_feature_requested", "mention", "review_requested", "security_advisory_credit",
+	"security_alert", "state_change", "subscribed", "team_mention",
+}
+
+// filterNotificationsByReason keeps the notifications sent for reason. The
+// REST API has no reason filter, so this runs on each fetched page.
+func filterNotificationsByReason(notifications []*github.Notification, reason string) []*github.Notification {
+	if reason == "" {
+		return notifications
+	}
+	filtered := make([]*github.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		if n.GetReason() == reason {
+			filtered = append(filtered, n)
+		}
+	}
+	return filtered
+}
+
+// maxNotificationCount caps how many notifications list_notifications scans
+// when return_count_only is set.
+const maxNotificationCount = 500
+
+// notificationCountPageSize is the largest page the notifications API returns.
+const notificationCountPageSize = 50
+
+// NotificationCount is the response of list_notifications when
+// return_count_only is set.
+type NotificationCount struct {
+	Count int `json:"count"`
+	// NewestUpdatedAt is the most recent updated_at among the counted
+	// notifications, suitable as the next call's since. It is empty when
+	// there are none.
+	NewestUpdatedAt string `json:"newest_updated_at,omitempty"`
+	// CountCapped reports that counting stopped after scanning
+	// maxNotificationCount notifications while more remained.
+	CountCapped bool `json:"count_capped,omitempty"`
+}
+
+// countNotifications pages through the notifications matching opts, keeping
+// only their number and newest update time.
+func countNotifications(ctx context.Context, list func(*github.NotificationListOptions) ([]*github.Notification, *github.Response, error), opts *github.NotificationListOptions) *mcp.CallToolResult {
+	opts.Page = 1
+	opts.PerPage = notificationCountPageSize
+
+	var result NotificationCount
+	var newest time.Time
+	for scanned := 0; ; scanned += notificationCountPageSize {
+		notifications, resp, err := list(opts)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx,
+				"failed to list notifications",
+				resp,
+				err,
+			)
+		}
+		_ = resp.Body.Close()
+
+		for _, n := range notifications {
+			result.Count++
+			if updated := n.GetUpdatedAt().Time; updated.After(newest) {
+				newest = updated
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		if scanned+notificationCountPageSize >= maxNotificationCount {
+			result.CountCapped = true
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if !newest.IsZero() {
+		result.NewestUpdatedAt = newest.UTC().Format(time.RFC3339)
+	}
+	return MarshalledTextResult(result)
+}
+
 // DismissNotification creates a tool to mark a notification as read/done.
 func DismissNotification(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -348,6 +462,11 @@ func GetNotificationDetails(t translations.TranslationHelperFunc) inventory.Serv
 						Type:        "string",
 						Description: "The ID of the notification",
 					},
+					"include_latest_comment": {
+						Type:        "boolean",
+						Description: fmt.Sprintf("Also fetch the comment that triggered the notification (the subject's latest comment) and include its author and body, cut to %d characters", maxLatestCommentBodyLength),
+						Default:     json.RawMessage(`false`),
+					},
 				},
 				Required: []string{"notificationID"},
 			},
@@ -363,6 +482,10 @@ func GetNotificationDetails(t translations.TranslationHelperFunc) inventory.Serv
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			includeLatestComment, err := OptionalBoolParamWithDefault(args, "include_latest_comment", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			thread, resp, err := client.Activity.GetThread(ctx, notificationID)
 			if err != nil {
@@ -382,7 +505,24 @@ func GetNotificationDetails(t translations.TranslationHelperFunc) inventory.Serv
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get notification details", resp, body), nil, nil
 			}
 
-			r, err := json.Marshal(thread)
+			var details any = thread
+			if includeLatestComment {
+				withComment := NotificationWithLatestComment{Notification: thread}
+				if commentURL := thread.GetSubject().GetLatestCommentURL(); commentURL != "" {
+					// The thread is still useful without its comment, so
+					// report a failure next to it instead of failing the call.
+					var errResult *mcp.CallToolResult
+					withComment.LatestComment, errResult = getNotificationComment(ctx, client, commentURL)
+					if errResult != nil {
+						if text, ok := errResult.Content[0].(*mcp.TextContent); ok {
+							withComment.LatestCommentError = text.Text
+						}
+					}
+				}
+				details = withComment
+			}
+
+			r, err := json.Marshal(details)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
 			}
@@ -601,3 +741,67 @@ func ManageRepositoryNotificationSubscription(t translations.TranslationHelperFu
 		},
 	)
 }
+
+// maxLatestCommentBodyLength caps the comment body get_notification_details
+// returns with include_latest_comment, in characters.
+const maxLatestCommentBodyLength = 2000
+
+// NotificationComment is the comment that triggered a notification.
+type NotificationComment struct {
+	Author        string `json:"author"`
+	Body          string `json:"body"`
+	BodyTruncated bool   `json:"body_truncated,omitempty"`
+	HTMLURL       string `json:"html_url,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+}
+
+// NotificationWithLatestComment is a notification thread together with the
+// comment that triggered it.
+type NotificationWithLatestComment struct {
+	*github.Notification
+	LatestComment      *NotificationComment `json:"latest_comment,omitempty"`
+	LatestCommentError string               `json:"latest_comment_error,omitempty"`
+}
+
+// getNotificationComment fetches the object behind a notification subject's
+// latest_comment_url. That is usually an issue, pull request review or commit
+// comment, or the subject itself when nobody has commented yet; all of them
+// carry an author and a body. A non-nil result reports why the comment
+// could not be fetched.
+func getNotificationComment(ctx context.Context, client *github.Client, commentURL string) (*NotificationComment, *mcp.CallToolResult) {
+	// The URL comes from the API response, but only ever send the client's
+	// credentials back to the API host they were issued for.
+	if !strings.HasPrefix(commentURL, client.BaseURL()) {
+		return nil, utils.NewToolResultError(fmt.Sprintf("latest comment URL %s is not on the GitHub API host", commentURL))
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodGet, commentURL, nil)
+	if err != nil {
+		return nil, utils.NewToolResultErrorFromErr("failed to create request for latest comment", err)
+	}
+	var comment struct {
+		User      *github.User      `json:"user"`
+		Body      string            `json:"body"`
+		HTMLURL   string            `json:"html_url"`
+		CreatedAt *github.Timestamp `json:"created_at"`
+	}
+	resp, err := client.Do(req, &comment)
+	if err != nil {
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get latest comment", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	result := &NotificationComment{
+		Author:  comment.User.GetLogin(),
+		Body:    comment.Body,
+		HTMLURL: comment.HTMLURL,
+	}
+	if runes := []rune(comment.Body); len(runes) > maxLatestCommentBodyLength {
+		result.Body = string(runes[:maxLatestCommentBodyLength])
+		result.BodyTruncated = true
+	}
+	if comment.CreatedAt != nil {
+		result.CreatedAt = comment.CreatedAt.Format(time.RFC3339)
+	}
+	return result, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -32,6 +34,7 @@ func Test_ListNotifications(t *testing.T) {
 	assert.Contains(t, schema.Properties, "repo")
 	assert.Contains(t, schema.Properties, "page")
 	assert.Contains(t, schema.Properties, "perPage")
+	assert.Contains(t, schema.Properties, "return_count_only")
 	// All fields are optional, so Required should be empty
 	assert.Empty(t, schema.Required)
 	mockNotification := &github.Notification{
@@ -138,6 +141,193 @@ func Test_ListNotifications(t *testing.T) {
 	}
 }
 
+func Test_ListNotifications_CountOnly(t *testing.T) {
+	serverTool := ListNotifications(translations.NullTranslationHelper)
+
+	notificationAt := func(id string, updated time.Time) *github.Notification {
+		return &github.Notification{
+			ID:        github.Ptr(id),
+			UpdatedAt: &github.Timestamp{Time: updated},
+		}
+	}
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]any
+		expected     NotificationCount
+	}{
+		{
+			name: "counts every page since the cursor",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetNotifications: func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "2024-05-01T00:00:00Z", r.URL.Query().Get("since"))
+					assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+					if r.URL.Query().Get("page") == "2" {
+						mockResponse(t, http.StatusOK, []*github.Notification{
+							notificationAt("3", base.Add(-2*time.Hour)),
+						})(w, r)
+						return
+					}
+					w.Header().Set("Link", `<https://api.github.com/notifications?page=2>; rel="next"`)
+					mockResponse(t, http.StatusOK, []*github.Notification{
+						notificationAt("1", base),
+						notificationAt("2", base.Add(-time.Hour)),
+					})(w, r)
+				},
+			}),
+			requestArgs: map[string]any{
+				"since":             "2024-05-01T00:00:00Z",
+				"return_count_only": true,
+			},
+			expected: NotificationCount{
+				Count:           3,
+				NewestUpdatedAt: "2024-05-01T12:00:00Z",
+			},
+		},
+		{
+			name: "nothing new",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposNotificationsByOwnerByRepo: mockResponse(t, http.StatusOK, []*github.Notification{}),
+			}),
+			requestArgs: map[string]any{
+				"owner":             "octocat",
+				"repo":              "hello-world",
+				"since":             "2024-05-01T12:00:00Z",
+				"return_count_only": true,
+			},
+			expected: NotificationCount{},
+		},
+		{
+			name: "stops counting at the cap",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetNotifications: func(w http.ResponseWriter, r *http.Request) {
+					page := make([]*github.Notification, 50)
+					for i := range page {
+						page[i] = notificationAt("n", base)
+					}
+					w.Header().Set("Link", `<https://api.github.com/notifications?page=99>; rel="next"`)
+					mockResponse(t, http.StatusOK, page)(w, r)
+				},
+			}),
+			requestArgs: map[string]any{
+				"return_count_only": true,
+			},
+			expected: NotificationCount{
+				Count:           maxNotificationCount,
+				NewestUpdatedAt: "2024-05-01T12:00:00Z",
+				CountCapped:     true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned NotificationCount
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_ListNotifications_ReasonFilter(t *testing.T) {
+	serverTool := ListNotifications(translations.NullTranslationHelper)
+
+	schema, ok := serverTool.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	require.Contains(t, schema.Properties, "reason")
+	assert.Contains(t, schema.Properties["reason"].Enum, "review_requested")
+
+	updated := &github.Timestamp{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	page := []*github.Notification{
+		{ID: github.Ptr("1"), Reason: github.Ptr("mention"), UpdatedAt: updated},
+		{ID: github.Ptr("2"), Reason: github.Ptr("review_requested"), UpdatedAt: updated},
+		{ID: github.Ptr("3"), Reason: github.Ptr("subscribed"), UpdatedAt: updated},
+		{ID: github.Ptr("4"), Reason: github.Ptr("review_requested"), UpdatedAt: updated},
+	}
+
+	tests := []struct {
+		name        string
+		requestArgs map[string]any
+		expectedIDs []string
+	}{
+		{
+			name:        "keeps only notifications with the reason",
+			requestArgs: map[string]any{"reason": "review_requested"},
+			expectedIDs: []string{"2", "4"},
+		},
+		{
+			name:        "no matches on the page",
+			requestArgs: map[string]any{"reason": "assign"},
+			expectedIDs: []string{},
+		},
+		{
+			name:        "no reason keeps everything",
+			requestArgs: map[string]any{},
+			expectedIDs: []string{"1", "2", "3", "4"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetNotifications: func(w http.ResponseWriter, r *http.Request) {
+					assert.Empty(t, r.URL.Query().Get("reason"), "reason is filtered client-side")
+					mockResponse(t, http.StatusOK, page)(w, r)
+				},
+			}))
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned []*github.Notification
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			ids := make([]string, 0, len(returned))
+			for _, n := range returned {
+				ids = append(ids, n.GetID())
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+
+	t.Run("applies to count only mode", func(t *testing.T) {
+		client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetNotifications: mockResponse(t, http.StatusOK, page),
+		}))
+		deps := BaseDeps{
+			Client: client,
+		}
+		handler := serverTool.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"reason":            "review_requested",
+			"return_count_only": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned NotificationCount
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		assert.Equal(t, NotificationCount{Count: 2, NewestUpdatedAt: "2024-05-01T12:00:00Z"}, returned)
+	})
+}
+
 func Test_ManageNotificationSubscription(t *testing.T) {
 	// Verify tool definition and schema
 	serverTool := ManageNotificationSubscription(translations.NullTranslationHelper)
@@ -737,3 +927,112 @@ func Test_GetNotificationDetails(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetNotificationDetails_LatestComment(t *testing.T) {
+	serverTool := GetNotificationDetails(translations.NullTranslationHelper)
+
+	schema, ok := serverTool.Tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "include_latest_comment")
+
+	threadWithComment := func(commentURL string) *github.Notification {
+		return &github.Notification{
+			ID:     github.Ptr("123"),
+			Reason: github.Ptr("mention"),
+			Subject: &github.NotificationSubject{
+				Title:            github.Ptr("Crash on startup"),
+				Type:             github.Ptr("Issue"),
+				LatestCommentURL: github.Ptr(commentURL),
+			},
+		}
+	}
+	longBody := strings.Repeat("é", maxLatestCommentBodyLength+10)
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		expectedComment      *NotificationComment
+		expectedCommentError string
+	}{
+		{
+			name: "includes the triggering comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetNotificationsThreadsByThreadID: mockResponse(t, http.StatusOK, threadWithComment("https://api.github.com/repos/octo/hello/issues/comments/42")),
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, &github.IssueComment{
+					ID:        github.Ptr(int64(42)),
+					Body:      github.Ptr("@me can you take a look?"),
+					User:      &github.User{Login: github.Ptr("octocat")},
+					HTMLURL:   github.Ptr("https://github.com/octo/hello/issues/1#issuecomment-42"),
+					CreatedAt: &github.Timestamp{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+				}),
+			}),
+			expectedComment: &NotificationComment{
+				Author:    "octocat",
+				Body:      "@me can you take a look?",
+				HTMLURL:   "https://github.com/octo/hello/issues/1#issuecomment-42",
+				CreatedAt: "2024-05-01T12:00:00Z",
+			},
+		},
+		{
+			name: "cuts long comment bodies",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetNotificationsThreadsByThreadID: mockResponse(t, http.StatusOK, threadWithComment("https://api.github.com/repos/octo/hello/issues/comments/42")),
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusOK, &github.IssueComment{
+					Body: github.Ptr(longBody),
+					User: &github.User{Login: github.Ptr("octocat")},
+				}),
+			}),
+			expectedComment: &NotificationComment{
+				Author:        "octocat",
+				Body:          longBody[:maxLatestCommentBodyLength*len("é")],
+				BodyTruncated: true,
+			},
+		},
+		{
+			name: "reports a comment that cannot be fetched next to the thread",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetNotificationsThreadsByThreadID:             mockResponse(t, http.StatusOK, threadWithComment("https://api.github.com/repos/octo/hello/issues/comments/42")),
+				GetReposIssuesCommentByOwnerByRepoByCommentID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			expectedCommentError: "failed to get latest comment",
+		},
+		{
+			name: "does not follow URLs off the API host",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetNotificationsThreadsByThreadID: mockResponse(t, http.StatusOK, threadWithComment("https://attacker.example.com/repos/octo/hello/issues/comments/42")),
+				GetReposIssuesCommentByOwnerByRepoByCommentID: func(_ http.ResponseWriter, _ *http.Request) {
+					t.Error("comment URL on another host must not be requested")
+				},
+			}),
+			expectedCommentError: "is not on the GitHub API host",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"notificationID":         "123",
+				"include_latest_comment": true,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var returned NotificationWithLatestComment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			require.NotNil(t, returned.Notification)
+			assert.Equal(t, "123", returned.GetID())
+			assert.Equal(t, tc.expectedComment, returned.LatestComment)
+			if tc.expectedCommentError != "" {
+				assert.Contains(t, returned.LatestCommentError, tc.expectedCommentError)
+			} else {
+				assert.Empty(t, returned.LatestCommentError)
+			}
+		})
+	}
+}
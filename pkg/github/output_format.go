@@ -0,0 +1,159 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// OutputFormatJSON returns the tool's regular JSON response.
+	OutputFormatJSON = "json"
+	// OutputFormatMarkdown returns a compact markdown table of the key columns.
+	OutputFormatMarkdown = "markdown"
+)
+
+// WithOutputFormat adds the optional output_format parameter to a list tool.
+func WithOutputFormat(schema *jsonschema.Schema) *jsonschema.Schema {
+	schema.Properties["output_format"] = outputFormatSchemaProperty("")
+	return schema
+}
+
+// outputFormatSchemaProperty returns the output_format schema. extra is
+// appended to the description so multi-method tools can say when it applies.
+func outputFormatSchemaProperty(extra string) *jsonschema.Schema {
+	description := "Response format. 'json' (default) returns the full JSON response; 'markdown' returns a compact table of the key columns, which uses fewer tokens."
+	if extra != "" {
+		description += " " + extra
+	}
+	return &jsonschema.Schema{
+		Type:        "string",
+		Description: description,
+		Enum:        []any{OutputFormatJSON, OutputFormatMarkdown},
+		Default:     json.RawMessage(`"json"`),
+	}
+}
+
+// OptionalOutputFormat reads the output_format parameter, defaulting to json.
+func OptionalOutputFormat(args map[string]any) (string, error) {
+	format, err := OptionalParam[string](args, "output_format")
+	if err != nil {
+		return "", err
+	}
+	switch format {
+	case "":
+		return OutputFormatJSON, nil
+	case OutputFormatJSON, OutputFormatMarkdown:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid output_format %q: must be one of %q or %q", format, OutputFormatJSON, OutputFormatMarkdown)
+	}
+}
+
+// requestedMarkdownOutput reports whether the raw tool call asked for markdown
+// output. Wrappers that post-process JSON responses use it to leave markdown
+// responses untouched.
+func requestedMarkdownOutput(req *mcp.CallToolRequest) bool {
+	if req == nil || req.Params == nil || len(req.Params.Arguments) == 0 {
+		return false
+	}
+	var args struct {
+		OutputFormat string `json:"output_format"`
+	}
+	if err := json.Unmarshal(req.Params.Arguments, &args); err != nil {
+		return false
+	}
+	return args.OutputFormat == OutputFormatMarkdown
+}
+
+func issuesMarkdown(resp MinimalIssuesResponse) string {
+	rows := make([][]string, 0, len(resp.Issues))
+	for _, issue := range resp.Issues {
+		rows = append(rows, []string{
+			strconv.Itoa(issue.Number),
+			issue.Title,
+			strings.ToLower(issue.State),
+			minimalUserLogin(issue.User),
+			strings.Join(issue.Labels, ", "),
+			issue.UpdatedAt,
+		})
+	}
+
+	var b strings.Builder
+	b.WriteString(utils.MarkdownTable([]string{"#", "Title", "State", "Author", "Labels", "Updated"}, rows))
+	fmt.Fprintf(&b, "\nTotal: %d", resp.TotalCount)
+	if resp.PageInfo.HasNextPage && resp.PageInfo.EndCursor != "" {
+		fmt.Fprintf(&b, "; next page: after=%s", resp.PageInfo.EndCursor)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+func pullRequestsMarkdown(prs []MinimalPullRequest) string {
+	rows := make([][]string, 0, len(prs))
+	for _, pr := range prs {
+		state := pr.State
+		switch {
+		case pr.Merged:
+			state = "merged"
+		case pr.Draft:
+			state += " (draft)"
+		}
+		var head, base string
+		if pr.Head != nil {
+			head = pr.Head.Ref
+		}
+		if pr.Base != nil {
+			base = pr.Base.Ref
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(pr.Number),
+			pr.Title,
+			state,
+			minimalUserLogin(pr.User),
+			head + " → " + base,
+			pr.UpdatedAt,
+		})
+	}
+	return utils.MarkdownTable([]string{"#", "Title", "State", "Author", "Head → Base", "Updated"}, rows)
+}
+
+func workflowRunsMarkdown(runs *github.WorkflowRuns) string {
+	rows := make([][]string, 0, len(runs.WorkflowRuns))
+	for _, run := range runs.WorkflowRuns {
+		if run == nil {
+			continue
+		}
+		var created string
+		if run.CreatedAt != nil {
+			created = run.CreatedAt.Format("2006-01-02T15:04:05Z")
+		}
+		rows = append(rows, []string{
+			strconv.FormatInt(run.GetID(), 10),
+			run.GetName(),
+			run.GetHeadBranch(),
+			run.GetEvent(),
+			run.GetStatus(),
+			run.GetConclusion(),
+			created,
+		})
+	}
+
+	var b strings.Builder
+	b.WriteString(utils.MarkdownTable([]string{"ID", "Workflow", "Branch", "Event", "Status", "Conclusion", "Created"}, rows))
+	fmt.Fprintf(&b, "\nTotal: %d\n", runs.GetTotalCount())
+	return b.String()
+}
+
+func minimalUserLogin(u *MinimalUser) string {
+	if u == nil {
+		return ""
+	}
+	return u.Login
+}
@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionalOutputFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "defaults to json",
+			args:     map[string]any{},
+			expected: OutputFormatJSON,
+		},
+		{
+			name:     "json",
+			args:     map[string]any{"output_format": "json"},
+			expected: OutputFormatJSON,
+		},
+		{
+			name:     "markdown",
+			args:     map[string]any{"output_format": "markdown"},
+			expected: OutputFormatMarkdown,
+		},
+		{
+			name:        "unknown format",
+			args:        map[string]any{"output_format": "yaml"},
+			expectError: true,
+		},
+		{
+			name:        "wrong type",
+			args:        map[string]any{"output_format": 1},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			format, err := OptionalOutputFormat(tc.args)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, format)
+		})
+	}
+}
+
+func Test_IssuesMarkdown(t *testing.T) {
+	resp := MinimalIssuesResponse{
+		Issues: []MinimalIssue{
+			{
+				Number:    1,
+				Title:     "Crash when | is in title",
+				State:     "OPEN",
+				User:      &MinimalUser{Login: "octocat"},
+				Labels:    []string{"bug", "p1"},
+				UpdatedAt: "2026-01-02T00:00:00Z",
+			},
+			{
+				Number: 2,
+				Title:  "No author",
+				State:  "CLOSED",
+			},
+		},
+		TotalCount: 5,
+		PageInfo:   MinimalPageInfo{HasNextPage: true, EndCursor: "Y3Vyc29y"},
+	}
+
+	assert.Equal(t,
+		"| # | Title | State | Author | Labels | Updated |\n"+
+			"| --- | --- | --- | --- | --- | --- |\n"+
+			"| 1 | Crash when \\| is in title | open | octocat | bug, p1 | 2026-01-02T00:00:00Z |\n"+
+			"| 2 | No author | closed |  |  |  |\n"+
+			"\nTotal: 5; next page: after=Y3Vyc29y\n",
+		issuesMarkdown(resp))
+}
+
+func Test_ListPullRequests_MarkdownOutput(t *testing.T) {
+	mockPRs := []*github.PullRequest{
+		{
+			Number: github.Ptr(42),
+			Title:  github.Ptr("Add feature"),
+			State:  github.Ptr("open"),
+			Draft:  github.Ptr(true),
+			User:   &github.User{Login: github.Ptr("octocat")},
+			Head:   &github.PullRequestBranch{Ref: github.Ptr("feature")},
+			Base:   &github.PullRequestBranch{Ref: github.Ptr("main")},
+		},
+		{
+			Number: github.Ptr(41),
+			Title:  github.Ptr("Fix bug"),
+			State:  github.Ptr("closed"),
+			Merged: github.Ptr(true),
+			Head:   &github.PullRequestBranch{Ref: github.Ptr("fix")},
+			Base:   &github.PullRequestBranch{Ref: github.Ptr("main")},
+		},
+	}
+
+	for _, tc := range []struct {
+		name string
+		tool inventory.ServerTool
+	}{
+		{name: "fields variant", tool: ListPullRequests(translations.NullTranslationHelper)},
+		{name: "legacy variant", tool: LegacyListPullRequests(translations.NullTranslationHelper)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepo: mockResponse(t, http.StatusOK, mockPRs),
+			}))
+			deps := BaseDeps{Client: client}
+			handler := tc.tool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"output_format": "markdown",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t,
+				"| # | Title | State | Author | Head → Base | Updated |\n"+
+					"| --- | --- | --- | --- | --- | --- |\n"+
+					"| 42 | Add feature | open (draft) | octocat | feature → main |  |\n"+
+					"| 41 | Fix bug | merged |  | fix → main |  |\n",
+				textContent.Text)
+		})
+	}
+
+	t.Run("invalid format", func(t *testing.T) {
+		st := ListPullRequests(translations.NullTranslationHelper)
+		deps := BaseDeps{Client: mustNewGHClient(t, MockHTTPClientWithHandlers(nil))}
+		handler := st.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"owner":         "owner",
+			"repo":          "repo",
+			"output_format": "yaml",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "invalid output_format")
+	})
+}
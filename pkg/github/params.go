@@ -365,6 +365,25 @@ func WithUnifiedPagination(schema *jsonschema.Schema) *jsonschema.Schema {
 	return schema
 }
 
+// WithPageAndCursorPagination adds page-number and REST cursor pagination parameters to a tool
+// whose endpoint supports both. A cursor, when given, takes precedence over "page"; see
+// restPaginationOptions.
+func WithPageAndCursorPagination(schema *jsonschema.Schema) *jsonschema.Schema {
+	WithPagination(schema)
+
+	schema.Properties["after"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Cursor for the next page, from pageInfo.nextCursor of the previous response. Takes precedence over page.",
+	}
+
+	schema.Properties["before"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Cursor for the previous page, from pageInfo.prevCursor of the previous response. Takes precedence over page.",
+	}
+
+	return schema
+}
+
 // WithCursorPagination adds only cursor-based pagination parameters to a tool (no page parameter).
 func WithCursorPagination(schema *jsonschema.Schema) *jsonschema.Schema {
 	schema.Properties["perPage"] = &jsonschema.Schema{
@@ -386,19 +405,36 @@ type PaginationParams struct {
 	Page    int
 	PerPage int
 	After   string
+	Before  string
+}
+
+// DefaultPerPage is the page size paginated tools use when neither the call
+// nor the server configuration sets one.
+const DefaultPerPage = 30
+
+// ClampPerPage limits a configured default page size to the 1-100 range the
+// GitHub API accepts.
+func ClampPerPage(perPage int) int {
+	return max(1, min(perPage, 100))
+}
+
+// defaultPerPageOr returns perPage, or DefaultPerPage when it is not set.
+func defaultPerPageOr(perPage int) int {
+	if perPage <= 0 {
+		return DefaultPerPage
+	}
+	return perPage
 }
 
-// OptionalPaginationParams returns the "page", "perPage", and "after" parameters from the request,
-// or their default values if not present, "page" default is 1, "perPage" default is 30.
-// In future, we may want to make the default values configurable, or even have this
-// function returned from `withPagination`, where the defaults are provided alongside
-// the min/max values.
-func OptionalPaginationParams(args map[string]any) (PaginationParams, error) {
+// OptionalPaginationParams returns the "page", "perPage", "after" and "before" parameters from the request,
+// or their default values if not present: "page" defaults to 1 and "perPage" to defaultPerPage,
+// which tools take from ToolDependencies.GetDefaultPerPage.
+func OptionalPaginationParams(args map[string]any, defaultPerPage int) (PaginationParams, error) {
 	page, err := OptionalIntParamWithDefault(args, "page", 1)
 	if err != nil {
 		return PaginationParams{}, err
 	}
-	perPage, err := OptionalIntParamWithDefault(args, "perPage", 30)
+	perPage, err := OptionalIntParamWithDefault(args, "perPage", defaultPerPage)
 	if err != nil {
 		return PaginationParams{}, err
 	}
@@ -406,17 +442,37 @@ func OptionalPaginationParams(args map[string]any) (PaginationParams, error) {
 	if err != nil {
 		return PaginationParams{}, err
 	}
+	before, err := OptionalParam[string](args, "before")
+	if err != nil {
+		return PaginationParams{}, err
+	}
 	return PaginationParams{
 		Page:    page,
 		PerPage: perPage,
 		After:   after,
+		Before:  before,
 	}, nil
 }
 
+// restPaginationOptions returns the list options for a REST endpoint that accepts both page
+// numbers and before/after cursors. The API rejects a page number combined with a cursor, so
+// only one of the two is set: the cursor options when a cursor was given, the page options
+// otherwise.
+func restPaginationOptions(p PaginationParams) (github.ListOptions, github.ListCursorOptions) {
+	if p.After != "" || p.Before != "" {
+		return github.ListOptions{}, github.ListCursorOptions{
+			PerPage: p.PerPage,
+			After:   p.After,
+			Before:  p.Before,
+		}
+	}
+	return github.ListOptions{Page: p.Page, PerPage: p.PerPage}, github.ListCursorOptions{}
+}
+
 // OptionalCursorPaginationParams returns the "perPage" and "after" parameters from the request,
 // without the "page" parameter, suitable for cursor-based pagination only.
-func OptionalCursorPaginationParams(args map[string]any) (CursorPaginationParams, error) {
-	perPage, err := OptionalIntParamWithDefault(args, "perPage", 30)
+func OptionalCursorPaginationParams(args map[string]any, defaultPerPage int) (CursorPaginationParams, error) {
+	perPage, err := OptionalIntParamWithDefault(args, "perPage", defaultPerPage)
 	if err != nil {
 		return CursorPaginationParams{}, err
 	}
@@ -440,14 +496,20 @@ type pageInfo struct {
 	HasPreviousPage bool   `json:"hasPreviousPage"`
 	NextCursor      string `json:"nextCursor,omitempty"`
 	PrevCursor      string `json:"prevCursor,omitempty"`
+	// NextPage and PrevPage are set instead of the cursors when the
+	// endpoint paginated by page number.
+	NextPage int `json:"nextPage,omitempty"`
+	PrevPage int `json:"prevPage,omitempty"`
 }
 
 func buildPageInfo(resp *github.Response) pageInfo {
 	return pageInfo{
-		HasNextPage:     resp.After != "",
-		HasPreviousPage: resp.Before != "",
+		HasNextPage:     resp.After != "" || resp.NextPage != 0,
+		HasPreviousPage: resp.Before != "" || resp.PrevPage != 0,
 		NextCursor:      resp.After,
 		PrevCursor:      resp.Before,
+		NextPage:        resp.NextPage,
+		PrevPage:        resp.PrevPage,
 	}
 }
 
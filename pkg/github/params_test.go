@@ -1,12 +1,16 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"net/http"
 	"testing"
 
+	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v89/github"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_IsAcceptedError(t *testing.T) {
@@ -551,10 +555,11 @@ func TestOptionalStringArrayParam(t *testing.T) {
 
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
-		name        string
-		params      map[string]any
-		expected    PaginationParams
-		expectError bool
+		name           string
+		params         map[string]any
+		defaultPerPage int
+		expected       PaginationParams
+		expectError    bool
 	}{
 		{
 			name:   "no pagination parameters, default values",
@@ -599,6 +604,42 @@ func TestOptionalPaginationParams(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name:           "configured default perPage",
+			params:         map[string]any{},
+			defaultPerPage: 100,
+			expected: PaginationParams{
+				Page:    1,
+				PerPage: 100,
+			},
+			expectError: false,
+		},
+		{
+			name: "perPage parameter overrides configured default",
+			params: map[string]any{
+				"perPage": float64(10),
+			},
+			defaultPerPage: 100,
+			expected: PaginationParams{
+				Page:    1,
+				PerPage: 10,
+			},
+			expectError: false,
+		},
+		{
+			name: "cursor parameters",
+			params: map[string]any{
+				"after":  "next",
+				"before": "prev",
+			},
+			expected: PaginationParams{
+				Page:    1,
+				PerPage: 30,
+				After:   "next",
+				Before:  "prev",
+			},
+			expectError: false,
+		},
 		{
 			name: "invalid page parameter",
 			params: map[string]any{
@@ -631,7 +672,7 @@ func TestOptionalPaginationParams(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := OptionalPaginationParams(tc.params)
+			result, err := OptionalPaginationParams(tc.params, defaultPerPageOr(tc.defaultPerPage))
 
 			if tc.expectError {
 				assert.Error(t, err)
@@ -642,3 +683,61 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func TestClampPerPage(t *testing.T) {
+	assert.Equal(t, 1, ClampPerPage(-5))
+	assert.Equal(t, 1, ClampPerPage(0))
+	assert.Equal(t, 50, ClampPerPage(50))
+	assert.Equal(t, 100, ClampPerPage(250))
+}
+
+func TestDefaultPerPageFromDeps(t *testing.T) {
+	serverTool := ListBranches(translations.NullTranslationHelper)
+
+	tests := []struct {
+		name            string
+		deps            BaseDeps
+		args            map[string]any
+		expectedPerPage string
+	}{
+		{
+			name:            "unset uses DefaultPerPage",
+			args:            map[string]any{},
+			expectedPerPage: "30",
+		},
+		{
+			name:            "configured default is applied",
+			deps:            BaseDeps{DefaultPerPage: 100},
+			args:            map[string]any{},
+			expectedPerPage: "100",
+		},
+		{
+			name:            "perPage overrides configured default",
+			deps:            BaseDeps{DefaultPerPage: 100},
+			args:            map[string]any{"perPage": float64(5)},
+			expectedPerPage: "5",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := tc.deps
+			deps.Client = mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposBranchesByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": tc.expectedPerPage,
+				}).andThen(mockResponse(t, http.StatusOK, []*github.Branch{})),
+			}))
+			handler := serverTool.Handler(deps)
+
+			args := map[string]any{"owner": "owner", "repo": "repo"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v89/github"
@@ -16,6 +18,7 @@ import (
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
 	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/lockdown"
 	"github.com/github/github-mcp-server/pkg/octicons"
 	"github.com/github/github-mcp-server/pkg/sanitize"
 	"github.com/github/github-mcp-server/pkg/scopes"
@@ -59,6 +62,11 @@ Possible options:
 		},
 		Required: []string{"method", "owner", "repo", "pullNumber"},
 	}
+	schema.Properties["fields"] = fieldPathsSchemaProperty()
+	schema.Properties["include_reactions"] = &jsonschema.Schema{
+		Type:        "boolean",
+		Description: "Used only by the get method. Include the pull request's reaction counts (+1, heart, etc.), useful for ranking by community interest. Costs one extra API request.",
+	}
 	WithPagination(schema)
 	// get_review_comments uses GraphQL cursor-based pagination and accepts the
 	// `after` cursor. Other methods rely on the `page`/`perPage` parameters
@@ -98,7 +106,11 @@ Possible options:
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			fields, err := OptionalStringArrayParam(args, "fields")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -108,19 +120,24 @@ Possible options:
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
-			// attachIFC adds the IFC label to a successful tool result when
-			// IFC labels are enabled. Pull request content (descriptions,
-			// diffs, comments, reviews) is user-authored and therefore
-			// untrusted; confidentiality follows repo visibility. If the
-			// visibility lookup fails the label is omitted rather than
-			// misclassifying the result.
+			// attachIFC trims the result to the requested fields and adds the
+			// IFC label to a successful tool result when IFC labels are
+			// enabled. Pull request content (descriptions, diffs, comments,
+			// reviews) is user-authored and therefore untrusted;
+			// confidentiality follows repo visibility. If the visibility
+			// lookup fails the label is omitted rather than misclassifying the
+			// result.
 			attachIFC := func(r *mcp.CallToolResult) *mcp.CallToolResult {
-				return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, r, ifc.LabelRepoUserContent)
+				return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, projectResultFields(r, fields), ifc.LabelRepoUserContent)
 			}
 
 			switch method {
 			case "get":
-				result, err := GetPullRequest(ctx, client, deps, owner, repo, pullNumber)
+				includeReactions, err := OptionalParam[bool](args, "include_reactions")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				result, err := GetPullRequest(ctx, client, deps, owner, repo, pullNumber, includeReactions)
 				return attachIFC(result), nil, err
 			case "get_diff":
 				result, err := GetPullRequestDiff(ctx, client, deps, owner, repo, pullNumber)
@@ -139,7 +156,7 @@ Possible options:
 				if err != nil {
 					return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
 				}
-				cursorPagination, err := OptionalCursorPaginationParams(args)
+				cursorPagination, err := OptionalCursorPaginationParams(args, deps.GetDefaultPerPage())
 				if err != nil {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
@@ -160,7 +177,7 @@ Possible options:
 		})
 }
 
-func GetPullRequest(ctx context.Context, client *github.Client, deps ToolDependencies, owner, repo string, pullNumber int) (*mcp.CallToolResult, error) {
+func GetPullRequest(ctx context.Context, client *github.Client, deps ToolDependencies, owner, repo string, pullNumber int, includeReactions bool) (*mcp.CallToolResult, error) {
 	cache, err := deps.GetRepoAccessCache(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
@@ -203,6 +220,16 @@ func GetPullRequest(ctx context.Context, client *github.Client, deps ToolDepende
 
 	minimalPR := convertToMinimalPullRequest(pr)
 
+	if includeReactions {
+		// Reactions are only returned on the issue that backs the pull request.
+		issue, resp, err := client.Issues.Get(ctx, owner, repo, pullNumber)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request reactions", resp, err), nil
+		}
+		_ = resp.Body.Close()
+		minimalPR.Reactions = convertToMinimalReactions(issue.Reactions)
+	}
+
 	return MarshalledTextResult(minimalPR), nil
 }
 
@@ -531,19 +558,9 @@ func GetPullRequestReviewComments(ctx context.Context, gqlClient *githubv4.Clien
 		// Iterate through threads and filter comments
 		for i := range query.Repository.PullRequest.ReviewThreads.Nodes {
 			thread := &query.Repository.PullRequest.ReviewThreads.Nodes[i]
-			filteredComments := make([]reviewCommentNode, 0, len(thread.Comments.Nodes))
-
-			for _, comment := range thread.Comments.Nodes {
-				login := string(comment.Author.Login)
-				if login != "" {
-					isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
-					if err != nil {
-						return nil, fmt.Errorf("failed to check lockdown mode: %w", err)
-					}
-					if isSafeContent {
-						filteredComments = append(filteredComments, comment)
-					}
-				}
+			filteredComments, err := filterLockdownReviewComments(ctx, cache, owner, repo, thread.Comments.Nodes)
+			if err != nil {
+				return nil, err
 			}
 
 			thread.Comments.Nodes = filteredComments
@@ -554,6 +571,25 @@ func GetPullRequestReviewComments(ctx context.Context, gqlClient *githubv4.Clien
 	return MarshalledTextResult(convertToMinimalReviewThreadsResponse(query)), nil
 }
 
+// filterLockdownReviewComments keeps the review comments whose authors are
+// safe to show in lockdown mode.
+func filterLockdownReviewComments(ctx context.Context, cache *lockdown.RepoAccessCache, owner, repo string, comments []reviewCommentNode) ([]reviewCommentNode, error) {
+	filteredComments := make([]reviewCommentNode, 0, len(comments))
+	for _, comment := range comments {
+		login := string(comment.Author.Login)
+		if login != "" {
+			isSafeContent, err := cache.IsSafeContent(ctx, login, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check lockdown mode: %w", err)
+			}
+			if isSafeContent {
+				filteredComments = append(filteredComments, comment)
+			}
+		}
+	}
+	return filteredComments, nil
+}
+
 func GetPullRequestReviews(ctx context.Context, client *github.Client, deps ToolDependencies, owner, repo string, pullNumber int, pagination PaginationParams) (*mcp.CallToolResult, error) {
 	cache, err := deps.GetRepoAccessCache(ctx)
 	if err != nil {
@@ -1394,6 +1430,7 @@ func listPullRequestsTool(t translations.TranslationHelperFunc, includeFields bo
 		)
 	}
 	WithPagination(schema)
+	WithOutputFormat(schema)
 
 	return NewTool(
 		ToolsetMetadataPullRequests,
@@ -1443,7 +1480,11 @@ func listPullRequestsTool(t translations.TranslationHelperFunc, includeFields bo
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
 			}
-			pagination, err := OptionalPaginationParams(args)
+			outputFormat, err := OptionalOutputFormat(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1502,6 +1543,11 @@ func listPullRequestsTool(t translations.TranslationHelperFunc, includeFields bo
 				}
 			}
 
+			if outputFormat == OutputFormatMarkdown {
+				result := utils.NewToolResultText(pullRequestsMarkdown(minimalPRs))
+				return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoUserContent), nil, nil
+			}
+
 			filtered := false
 			var payload any = minimalPRs
 			if includeFields && len(fields) > 0 {
@@ -1708,6 +1754,8 @@ func searchPullRequestsTool(t translations.TranslationHelperFunc, includeFields
 		},
 		Required: []string{"query"},
 	}
+	schema.Properties["group_by_repo"] = groupByRepoSchemaProperty()
+	schema.Properties["count_only"] = countOnlySchemaProperty()
 	if includeFields {
 		schema.Properties["fields"] = fieldsSchemaProperty(
 			"Subset of fields to return for each pull request result. If omitted, all fields are returned. Use this to reduce response size when you only need specific fields; omitting 'body', 'reactions', and 'labels' in particular drops the largest per-result data.",
@@ -1729,7 +1777,15 @@ func searchPullRequestsTool(t translations.TranslationHelperFunc, includeFields
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
-			options := []searchOption{ifcSearchPostProcessOption(ctx, deps)}
+			groupByRepo, err := OptionalParam[bool](args, "group_by_repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			countOnly, err := OptionalParam[bool](args, "count_only")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			options := []searchOption{ifcSearchPostProcessOption(ctx, deps), withGroupByRepo(groupByRepo), withCountOnly(deps, countOnly)}
 			if includeFields {
 				fields, err := OptionalStringArrayParam(args, "fields")
 				if err != nil {
@@ -1737,7 +1793,7 @@ func searchPullRequestsTool(t translations.TranslationHelperFunc, includeFields
 				}
 				options = append(options, withFieldsFiltering(deps, "search_pull_requests", fields))
 			}
-			result, err := searchHandler(ctx, deps.GetClient, args, "pr", "failed to search pull requests", options...)
+			result, err := searchHandler(ctx, deps.GetClient, args, deps.GetDefaultPerPage(), "pr", "failed to search pull requests", options...)
 			return result, nil, err
 		})
 }
@@ -1761,7 +1817,7 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 			},
 			"expectedHeadSha": {
 				Type:        "string",
-				Description: "The expected SHA of the pull request's HEAD ref",
+				Description: "The SHA the pull request's head ref is expected to have. The branch is only updated if the head still points at this SHA, so commits pushed meanwhile are not merged with unseen changes",
 			},
 		},
 		Required: []string{"owner", "repo", "pullNumber"},
@@ -1812,6 +1868,15 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
 					return utils.NewToolResultText("Pull request branch update is in progress"), nil, nil
 				}
+				if expectedHeadSHA != "" && isExpectedHeadSHAMismatch(resp, err) {
+					message := fmt.Sprintf("pull request branch was not updated because its head is no longer %s", expectedHeadSHA)
+					if pr, prResp, prErr := client.PullRequests.Get(ctx, owner, repo, pullNumber); prErr == nil {
+						_ = prResp.Body.Close()
+						message = fmt.Sprintf("pull request branch was not updated because its head moved from %s to %s; review the new commits and retry with the current head SHA",
+							expectedHeadSHA, pr.GetHead().GetSHA())
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, message, resp, err), nil, nil
+				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to update pull request branch",
 					resp,
@@ -1837,6 +1902,16 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 		})
 }
 
+// isExpectedHeadSHAMismatch reports whether a branch update was rejected
+// because the pull request's head did not match expected_head_sha.
+func isExpectedHeadSHAMismatch(resp *github.Response, err error) bool {
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	var errResp *github.ErrorResponse
+	return errors.As(err, &errResp) && strings.Contains(strings.ToLower(errResp.Message), "expected head sha")
+}
+
 type PullRequestReviewWriteParams struct {
 	Method     string
 	Owner      string
@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ClosePullRequestResult reports what close_pull_request closed and deleted.
+type ClosePullRequestResult struct {
+	PullNumber int    `json:"pull_number"`
+	URL        string `json:"url"`
+	// Closed is false when the pull request was already closed.
+	Closed bool   `json:"closed"`
+	Branch string `json:"branch"`
+	// BranchDeleted is true when the head branch was deleted. When deletion
+	// was requested but skipped, BranchKeptReason says why.
+	BranchDeleted    bool   `json:"branch_deleted"`
+	BranchKeptReason string `json:"branch_kept_reason,omitempty"`
+}
+
+// ClosePullRequest creates a tool to close a pull request without merging it.
+func ClosePullRequest(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "close_pull_request",
+			Description: t("TOOL_CLOSE_PULL_REQUEST_DESCRIPTION",
+				"Close a pull request without merging it, and optionally delete its head branch. "+
+					"The branch is only deleted when it is in the same repository, is not protected and is not the default branch."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_CLOSE_PULL_REQUEST_USER_TITLE", "Close pull request"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+					"delete_branch": {
+						Type:        "boolean",
+						Description: "Delete the pull request's head branch after closing it",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			deleteBranch, err := OptionalBoolParamWithDefault(args, "delete_branch", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			if pr.GetMerged() {
+				return utils.NewToolResultError("pull request is already merged and cannot be closed"), nil, nil
+			}
+
+			result := ClosePullRequestResult{
+				PullNumber: pullNumber,
+				URL:        pr.GetHTMLURL(),
+				Branch:     pr.GetHead().GetRef(),
+			}
+
+			if pr.GetState() != "closed" {
+				_, resp, err := client.PullRequests.Edit(ctx, owner, repo, pullNumber, &github.PullRequest{State: github.Ptr("closed")})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to close pull request", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+				result.Closed = true
+			}
+
+			if deleteBranch {
+				reason, errResult := deletePullRequestHeadBranch(ctx, client, owner, repo, pr)
+				if errResult != nil {
+					return errResult, nil, nil
+				}
+				result.BranchDeleted = reason == ""
+				result.BranchKeptReason = reason
+			}
+
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
+
+// deletePullRequestHeadBranch deletes the head branch of a closed pull
+// request. It returns why the branch was kept when it is in another
+// repository, is the default branch, is protected, has commits pushed after
+// the pull request's head or is already gone.
+func deletePullRequestHeadBranch(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest) (string, *mcp.CallToolResult) {
+	head := pr.GetHead()
+	if head.GetRepo() == nil {
+		return "the head repository no longer exists", nil
+	}
+	if !strings.EqualFold(head.GetRepo().GetFullName(), pr.GetBase().GetRepo().GetFullName()) {
+		return "the branch is in " + head.GetRepo().GetFullName() + ", not in this repository", nil
+	}
+	if head.GetRef() == pr.GetBase().GetRepo().GetDefaultBranch() {
+		return "the branch is the repository's default branch", nil
+	}
+
+	branch, resp, err := client.Repositories.GetBranch(ctx, owner, repo, head.GetRef(), 1)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "the branch no longer exists", nil
+		}
+		return "", ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get head branch", resp, err)
+	}
+	_ = resp.Body.Close()
+	if branch.GetProtected() {
+		return "the branch is protected", nil
+	}
+	if branch.GetCommit().GetSHA() != head.GetSHA() {
+		return "the branch has commits that are not in the pull request", nil
+	}
+
+	resp, err = client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+head.GetRef())
+	if err != nil {
+		return "", ghErrors.NewGitHubAPIErrorResponse(ctx, "pull request was closed but its branch could not be deleted", resp, err)
+	}
+	_ = resp.Body.Close()
+	return "", nil
+}
@@ -0,0 +1,220 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ClosePullRequest(t *testing.T) {
+	serverTool := ClosePullRequest(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "close_pull_request", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "delete_branch")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	baseRepo := &github.Repository{FullName: github.Ptr("owner/repo"), DefaultBranch: github.Ptr("main")}
+	pullRequest := func(state, headRepo string) *github.PullRequest {
+		return &github.PullRequest{
+			Number:  github.Ptr(42),
+			State:   github.Ptr(state),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+			Head: &github.PullRequestBranch{
+				Ref:  github.Ptr("feature"),
+				SHA:  github.Ptr("abc123"),
+				Repo: &github.Repository{FullName: github.Ptr(headRepo)},
+			},
+			Base: &github.PullRequestBranch{Ref: github.Ptr("main"), Repo: baseRepo},
+		}
+	}
+	closePR := expectRequestBody(t, map[string]any{"state": "closed"}).
+		andThen(mockResponse(t, http.StatusOK, pullRequest("closed", "owner/repo")))
+	branch := func(protected bool, sha string) http.HandlerFunc {
+		return mockResponse(t, http.StatusOK, &github.Branch{
+			Name:      github.Ptr("feature"),
+			Protected: github.Ptr(protected),
+			Commit:    &github.RepositoryCommit{SHA: github.Ptr(sha)},
+		})
+	}
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedResult  ClosePullRequestResult
+	}{
+		{
+			name: "closes and deletes the branch",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:   mockResponse(t, http.StatusOK, pullRequest("open", "owner/repo")),
+				PatchReposPullsByOwnerByRepoByPullNumber: closePR,
+				GetReposBranchesByOwnerByRepoByBranch:    branch(false, "abc123"),
+				DeleteReposGitRefsByOwnerByRepoByRef: func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/owner/repo/git/refs/heads/feature", r.URL.Path)
+					w.WriteHeader(http.StatusNoContent)
+				},
+			},
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedResult: ClosePullRequestResult{
+				PullNumber:    42,
+				URL:           "https://github.com/owner/repo/pull/42",
+				Closed:        true,
+				Branch:        "feature",
+				BranchDeleted: true,
+			},
+		},
+		{
+			name: "closes without deleting the branch by default",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:   mockResponse(t, http.StatusOK, pullRequest("open", "owner/repo")),
+				PatchReposPullsByOwnerByRepoByPullNumber: closePR,
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResult: ClosePullRequestResult{
+				PullNumber: 42,
+				URL:        "https://github.com/owner/repo/pull/42",
+				Closed:     true,
+				Branch:     "feature",
+			},
+		},
+		{
+			name: "keeps a protected branch",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:   mockResponse(t, http.StatusOK, pullRequest("open", "owner/repo")),
+				PatchReposPullsByOwnerByRepoByPullNumber: closePR,
+				GetReposBranchesByOwnerByRepoByBranch:    branch(true, "abc123"),
+			},
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedResult: ClosePullRequestResult{
+				PullNumber:       42,
+				URL:              "https://github.com/owner/repo/pull/42",
+				Closed:           true,
+				Branch:           "feature",
+				BranchKeptReason: "the branch is protected",
+			},
+		},
+		{
+			name: "keeps a branch with new commits",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:   mockResponse(t, http.StatusOK, pullRequest("open", "owner/repo")),
+				PatchReposPullsByOwnerByRepoByPullNumber: closePR,
+				GetReposBranchesByOwnerByRepoByBranch:    branch(false, "def456"),
+			},
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedResult: ClosePullRequestResult{
+				PullNumber:       42,
+				URL:              "https://github.com/owner/repo/pull/42",
+				Closed:           true,
+				Branch:           "feature",
+				BranchKeptReason: "the branch has commits that are not in the pull request",
+			},
+		},
+		{
+			name: "keeps a branch in a fork of an already closed pull request",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, pullRequest("closed", "contributor/repo")),
+			},
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedResult: ClosePullRequestResult{
+				PullNumber:       42,
+				URL:              "https://github.com/owner/repo/pull/42",
+				Branch:           "feature",
+				BranchKeptReason: "the branch is in contributor/repo, not in this repository",
+			},
+		},
+		{
+			name: "refuses a merged pull request",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, &github.PullRequest{
+					Number: github.Ptr(42),
+					State:  github.Ptr("closed"),
+					Merged: github.Ptr(true),
+				}),
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "pull request is already merged",
+		},
+		{
+			name: "close fails",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:   mockResponse(t, http.StatusOK, pullRequest("open", "owner/repo")),
+				PatchReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to close pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response ClosePullRequestResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
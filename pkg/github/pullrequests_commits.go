@@ -0,0 +1,215 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxCommitStatusLookups bounds how many commits of a page are annotated with
+// their check status. Each lookup costs two API calls.
+const maxCommitStatusLookups = 30
+
+// Commit check states reported by list_pull_request_commits.
+const (
+	commitCheckSuccess = "success"
+	commitCheckFailure = "failure"
+	commitCheckPending = "pending"
+	commitCheckNone    = "none"
+)
+
+// CommitCheckStatus summarizes the check runs and commit statuses reported for a commit.
+type CommitCheckStatus struct {
+	// State is "failure" when any check failed, "pending" when any check is
+	// still running, "success" when all passed and "none" when nothing reported.
+	State string `json:"state"`
+	// Failing names the check runs and status contexts that failed.
+	Failing []string `json:"failing,omitempty"`
+	Pending int      `json:"pending"`
+	Total   int      `json:"total"`
+	// Error is set when the status of the commit could not be read.
+	Error string `json:"error,omitempty"`
+}
+
+// PullRequestCommitWithStatus is a pull request commit, optionally annotated with its check status.
+type PullRequestCommitWithStatus struct {
+	MinimalPullRequestCommit
+	Status *CommitCheckStatus `json:"status,omitempty"`
+}
+
+// PullRequestCommitsResult is the output of list_pull_request_commits.
+type PullRequestCommitsResult struct {
+	Commits []PullRequestCommitWithStatus `json:"commits"`
+	// StatusesOmitted counts the commits of the page that were not annotated
+	// because of the lookup limit. The oldest commits are left out.
+	StatusesOmitted int `json:"statuses_omitted,omitempty"`
+}
+
+// ListPullRequestCommits creates a tool to list the commits of a pull request with their check status.
+func ListPullRequestCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: DescriptionRepositoryOwner,
+			},
+			"repo": {
+				Type:        "string",
+				Description: DescriptionRepositoryName,
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+			"include_status": {
+				Type: "boolean",
+				Description: fmt.Sprintf("Annotate each commit with the combined state of its check runs and commit statuses, and the checks that failed. "+
+					"At most the %d most recent commits of the page are annotated.", maxCommitStatusLookups),
+				Default: json.RawMessage(`false`),
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "list_pull_request_commits",
+			Description: t("TOOL_LIST_PULL_REQUEST_COMMITS_DESCRIPTION",
+				"List the commits of a pull request, oldest first. With include_status, each commit reports whether its checks passed, failed or are pending, "+
+					"which finds the commit that broke CI."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_PULL_REQUEST_COMMITS_USER_TITLE", "List pull request commits"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeStatus, err := OptionalBoolParamWithDefault(args, "include_status", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			commits, resp, err := client.PullRequests.ListCommits(ctx, owner, repo, pullNumber, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request commits", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			minimal := convertToMinimalPullRequestCommits(commits)
+			result := PullRequestCommitsResult{Commits: make([]PullRequestCommitWithStatus, 0, len(minimal))}
+			for _, commit := range minimal {
+				result.Commits = append(result.Commits, PullRequestCommitWithStatus{MinimalPullRequestCommit: commit})
+			}
+
+			if includeStatus {
+				// Commits are listed oldest first; the newest are the likeliest to matter.
+				first := max(len(result.Commits)-maxCommitStatusLookups, 0)
+				result.StatusesOmitted = first
+				statuses, _ := runBounded(ctx, maxGetFilesConcurrency, len(result.Commits)-first, func(ctx context.Context, i int) (*CommitCheckStatus, error) {
+					return getCommitCheckStatus(ctx, client, owner, repo, result.Commits[first+i].SHA), nil
+				})
+				for i, status := range statuses {
+					result.Commits[first+i].Status = status
+				}
+			}
+
+			toolResult := MarshalledTextResult(result)
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, toolResult, ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
+
+// getCommitCheckStatus summarizes the latest check runs and the commit
+// statuses of a commit. Only the first page of each is read, so a commit with
+// more than 100 checks is summarized from the first 100. A failed lookup is
+// reported in the summary rather than failing the whole listing.
+func getCommitCheckStatus(ctx context.Context, client *github.Client, owner, repo, sha string) *CommitCheckStatus {
+	status := &CommitCheckStatus{State: commitCheckNone}
+
+	runs, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, &github.ListCheckRunsOptions{
+		Filter:      github.Ptr("latest"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to list check runs: %v", err)
+		return status
+	}
+	_ = resp.Body.Close()
+
+	combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to get combined status: %v", err)
+		return status
+	}
+	_ = resp.Body.Close()
+
+	for _, run := range runs.CheckRuns {
+		status.Total++
+		if run.GetStatus() != "completed" {
+			status.Pending++
+			continue
+		}
+		switch run.GetConclusion() {
+		case "success", "neutral", "skipped":
+		default:
+			status.Failing = append(status.Failing, run.GetName())
+		}
+	}
+	for _, s := range combined.Statuses {
+		status.Total++
+		switch s.GetState() {
+		case "pending":
+			status.Pending++
+		case "failure", "error":
+			status.Failing = append(status.Failing, s.GetContext())
+		}
+	}
+
+	switch {
+	case len(status.Failing) > 0:
+		status.State = commitCheckFailure
+	case status.Pending > 0:
+		status.State = commitCheckPending
+	case status.Total > 0:
+		status.State = commitCheckSuccess
+	}
+	return status
+}
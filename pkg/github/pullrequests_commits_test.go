@@ -0,0 +1,212 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListPullRequestCommits(t *testing.T) {
+	serverTool := ListPullRequestCommits(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_commits", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "include_status")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	commits := mockResponse(t, http.StatusOK, []*github.RepositoryCommit{
+		{SHA: github.Ptr("aaa"), Commit: &github.Commit{Message: github.Ptr("Add feature")}},
+		{SHA: github.Ptr("bbb"), Commit: &github.Commit{Message: github.Ptr("Refactor")}},
+		{SHA: github.Ptr("ccc"), Commit: &github.Commit{Message: github.Ptr("Fix typo")}},
+	})
+	// Check runs and statuses are routed by the commit SHA in the path.
+	checkRuns := map[string]*github.ListCheckRunsResults{
+		"aaa": {CheckRuns: []*github.CheckRun{
+			{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+		}},
+		"bbb": {CheckRuns: []*github.CheckRun{
+			{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+			{Name: github.Ptr("lint"), Status: github.Ptr("completed"), Conclusion: github.Ptr("skipped")},
+		}},
+		"ccc": {CheckRuns: []*github.CheckRun{
+			{Name: github.Ptr("build"), Status: github.Ptr("in_progress")},
+		}},
+	}
+	statuses := map[string]*github.CombinedStatus{
+		"aaa": {},
+		"bbb": {Statuses: []*github.RepoStatus{{Context: github.Ptr("ci/legacy"), State: github.Ptr("error")}}},
+		"ccc": {},
+	}
+	shaFromPath := func(path string) string {
+		parts := strings.Split(path, "/")
+		return parts[len(parts)-2]
+	}
+	statusHandlers := map[string]http.HandlerFunc{
+		GetReposPullsCommitsByOwnerByRepoByPullNumber: commits,
+		GetReposCommitsCheckRunsByOwnerByRepoByRef: func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "latest", r.URL.Query().Get("filter"))
+			mockResponse(t, http.StatusOK, checkRuns[shaFromPath(r.URL.Path)])(w, r)
+		},
+		GetReposCommitsStatusByOwnerByRepoByRef: func(w http.ResponseWriter, r *http.Request) {
+			mockResponse(t, http.StatusOK, statuses[shaFromPath(r.URL.Path)])(w, r)
+		},
+	}
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		validateResult  func(t *testing.T, result PullRequestCommitsResult)
+	}{
+		{
+			name:     "annotates commits with their check status",
+			handlers: statusHandlers,
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"include_status": true,
+			},
+			validateResult: func(t *testing.T, result PullRequestCommitsResult) {
+				require.Len(t, result.Commits, 3)
+				assert.Zero(t, result.StatusesOmitted)
+
+				assert.Equal(t, &CommitCheckStatus{State: "success", Total: 1}, result.Commits[0].Status)
+				assert.Equal(t, &CommitCheckStatus{State: "failure", Failing: []string{"build", "ci/legacy"}, Total: 3}, result.Commits[1].Status)
+				assert.Equal(t, &CommitCheckStatus{State: "pending", Pending: 1, Total: 1}, result.Commits[2].Status)
+				assert.Equal(t, "Refactor", result.Commits[1].Message)
+			},
+		},
+		{
+			name: "lists commits without status by default",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsCommitsByOwnerByRepoByPullNumber: commits,
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			validateResult: func(t *testing.T, result PullRequestCommitsResult) {
+				require.Len(t, result.Commits, 3)
+				for _, commit := range result.Commits {
+					assert.Nil(t, commit.Status)
+				}
+			},
+		},
+		{
+			name: "reports a failed status lookup on the commit",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsCommitsByOwnerByRepoByPullNumber: commits,
+				GetReposCommitsCheckRunsByOwnerByRepoByRef:    mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+			},
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"include_status": true,
+			},
+			validateResult: func(t *testing.T, result PullRequestCommitsResult) {
+				require.Len(t, result.Commits, 3)
+				for _, commit := range result.Commits {
+					require.NotNil(t, commit.Status)
+					assert.Equal(t, "none", commit.Status.State)
+					assert.Contains(t, commit.Status.Error, "failed to list check runs")
+				}
+			},
+		},
+		{
+			name: "pull request not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsCommitsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get pull request commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response PullRequestCommitsResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			tc.validateResult(t, response)
+		})
+	}
+}
+
+func Test_ListPullRequestCommitsBoundsStatusLookups(t *testing.T) {
+	serverTool := ListPullRequestCommits(translations.NullTranslationHelper)
+
+	page := make([]*github.RepositoryCommit, 0, maxCommitStatusLookups+5)
+	for i := range maxCommitStatusLookups + 5 {
+		page = append(page, &github.RepositoryCommit{SHA: github.Ptr(strings.Repeat("a", i+1))})
+	}
+	deps := BaseDeps{
+		Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposPullsCommitsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, page),
+			GetReposCommitsCheckRunsByOwnerByRepoByRef:    mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{}),
+			GetReposCommitsStatusByOwnerByRepoByRef:       mockResponse(t, http.StatusOK, &github.CombinedStatus{}),
+		})),
+	}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":          "owner",
+		"repo":           "repo",
+		"pullNumber":     float64(42),
+		"perPage":        float64(100),
+		"include_status": true,
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response PullRequestCommitsResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Commits, maxCommitStatusLookups+5)
+	assert.Equal(t, 5, response.StatusesOmitted)
+	for i, commit := range response.Commits {
+		if i < 5 {
+			assert.Nil(t, commit.Status, "commit %d should not be annotated", i)
+		} else {
+			assert.NotNil(t, commit.Status, "commit %d should be annotated", i)
+		}
+	}
+}
@@ -377,7 +377,7 @@ func GranularCreatePullRequestReview(t translations.TranslationHelperFunc) inven
 		ToolsetMetadataPullRequests,
 		mcp.Tool{
 			Name:        "create_pull_request_review",
-			Description: t("TOOL_CREATE_PULL_REQUEST_REVIEW_DESCRIPTION", "Create a review on a pull request. If event is provided, the review is submitted immediately; otherwise a pending review is created."),
+			Description: t("TOOL_CREATE_PULL_REQUEST_REVIEW_DESCRIPTION", "Create a review on a pull request, optionally with line comments on the diff. If event is provided, the review is submitted immediately; otherwise a pending review is created."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_CREATE_PULL_REQUEST_REVIEW_USER_TITLE", "Create Pull Request Review"),
 				ReadOnlyHint:    false,
@@ -393,6 +393,7 @@ func GranularCreatePullRequestReview(t translations.TranslationHelperFunc) inven
 					"body":       {Type: "string", Description: "The review body text (optional)"},
 					"event":      {Type: "string", Description: "The review action to perform. If omitted, creates a pending review.", Enum: []any{"APPROVE", "REQUEST_CHANGES", "COMMENT"}},
 					"commitID":   {Type: "string", Description: "The SHA of the commit to review (optional, defaults to latest)"},
+					"comments":   reviewLineCommentsSchema,
 				},
 				Required: []string{"owner", "repo", "pullNumber"},
 			},
@@ -415,6 +416,22 @@ func GranularCreatePullRequestReview(t translations.TranslationHelperFunc) inven
 			event, _ := OptionalParam[string](args, "event")
 			commitID, _ := OptionalParam[string](args, "commitID")
 
+			var comments []ReviewLineComment
+			if raw, ok := args["comments"]; ok {
+				comments, err = parseReviewLineComments(raw)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			if len(comments) > 0 {
+				client, err := deps.GetClient(ctx)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+				}
+				result, err := CreatePullRequestReviewWithComments(ctx, client, owner, repo, pullNumber, body, event, commitID, comments)
+				return result, nil, err
+			}
+
 			gqlClient, err := deps.GetGQLClient(ctx)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
@@ -676,7 +693,7 @@ func GranularResolveReviewThread(t translations.TranslationHelperFunc) inventory
 		ToolsetMetadataPullRequests,
 		mcp.Tool{
 			Name:        "resolve_review_thread",
-			Description: t("TOOL_RESOLVE_REVIEW_THREAD_DESCRIPTION", "Resolve a review thread on a pull request. Resolving an already-resolved thread is a no-op."),
+			Description: t("TOOL_RESOLVE_REVIEW_THREAD_DESCRIPTION", "Resolve a review thread on a pull request, identified by its node ID or by pull request, file path and line. Resolving an already-resolved thread is a no-op."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_RESOLVE_REVIEW_THREAD_USER_TITLE", "Resolve Review Thread"),
 				ReadOnlyHint:    false,
@@ -684,27 +701,24 @@ func GranularResolveReviewThread(t translations.TranslationHelperFunc) inventory
 				OpenWorldHint:   jsonschema.Ptr(true),
 			},
 			InputSchema: &jsonschema.Schema{
-				Type: "object",
-				Properties: map[string]*jsonschema.Schema{
-					"threadID": {
-						Type:        "string",
-						Description: "The node ID of the review thread to resolve (e.g., PRRT_kwDOxxx)",
-					},
-				},
-				Required: []string{"threadID"},
+				Type:       "object",
+				Properties: reviewThreadTargetProperties("resolve"),
 			},
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
-			threadID, err := RequiredParam[string](args, "threadID")
-			if err != nil {
-				return utils.NewToolResultError(err.Error()), nil, nil
-			}
-
 			gqlClient, err := deps.GetGQLClient(ctx)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
 			}
+			if gqlClient == nil {
+				return utils.NewToolResultError("resolving review threads requires the GitHub GraphQL API, which is not configured"), nil, nil
+			}
+
+			threadID, errResult := reviewThreadIDFromArgs(ctx, gqlClient, args, true)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
 
 			result, err := ResolveReviewThread(ctx, gqlClient, threadID, true)
 			return result, nil, err
@@ -720,7 +734,7 @@ func GranularUnresolveReviewThread(t translations.TranslationHelperFunc) invento
 		ToolsetMetadataPullRequests,
 		mcp.Tool{
 			Name:        "unresolve_review_thread",
-			Description: t("TOOL_UNRESOLVE_REVIEW_THREAD_DESCRIPTION", "Unresolve a previously resolved review thread on a pull request. Unresolving an already-unresolved thread is a no-op."),
+			Description: t("TOOL_UNRESOLVE_REVIEW_THREAD_DESCRIPTION", "Unresolve a previously resolved review thread on a pull request, identified by its node ID or by pull request, file path and line. Unresolving an already-unresolved thread is a no-op."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_UNRESOLVE_REVIEW_THREAD_USER_TITLE", "Unresolve Review Thread"),
 				ReadOnlyHint:    false,
@@ -728,27 +742,24 @@ func GranularUnresolveReviewThread(t translations.TranslationHelperFunc) invento
 				OpenWorldHint:   jsonschema.Ptr(true),
 			},
 			InputSchema: &jsonschema.Schema{
-				Type: "object",
-				Properties: map[string]*jsonschema.Schema{
-					"threadID": {
-						Type:        "string",
-						Description: "The node ID of the review thread to unresolve (e.g., PRRT_kwDOxxx)",
-					},
-				},
-				Required: []string{"threadID"},
+				Type:       "object",
+				Properties: reviewThreadTargetProperties("unresolve"),
 			},
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
-			threadID, err := RequiredParam[string](args, "threadID")
-			if err != nil {
-				return utils.NewToolResultError(err.Error()), nil, nil
-			}
-
 			gqlClient, err := deps.GetGQLClient(ctx)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
 			}
+			if gqlClient == nil {
+				return utils.NewToolResultError("unresolving review threads requires the GitHub GraphQL API, which is not configured"), nil, nil
+			}
+
+			threadID, errResult := reviewThreadIDFromArgs(ctx, gqlClient, args, false)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
 
 			result, err := ResolveReviewThread(ctx, gqlClient, threadID, false)
 			return result, nil, err
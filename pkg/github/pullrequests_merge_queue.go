@@ -0,0 +1,445 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// mergeQueueEntriesPerPage bounds the entries returned by get_merge_queue.
+const mergeQueueEntriesPerPage = 100
+
+// mergeQueueQuery reads the merge queue of a branch, or of the default branch
+// when $branch is null. MergeQueue is nil when the branch has no merge queue.
+type mergeQueueQuery struct {
+	Repository struct {
+		MergeQueue *struct {
+			URL     githubv4.URI
+			Entries struct {
+				Nodes      []mergeQueueEntryNode
+				TotalCount githubv4.Int
+			} `graphql:"entries(first: $first)"`
+		} `graphql:"mergeQueue(branch: $branch)"`
+		DefaultBranchRef *struct {
+			Name githubv4.String
+		}
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+type mergeQueueEntryNode struct {
+	Position             githubv4.Int
+	State                githubv4.MergeQueueEntryState
+	EnqueuedAt           githubv4.DateTime
+	EstimatedTimeToMerge *githubv4.Int
+	Jump                 githubv4.Boolean
+	Solo                 githubv4.Boolean
+	Enqueuer             *struct {
+		Login githubv4.String
+	}
+	PullRequest *struct {
+		Number githubv4.Int
+		Title  githubv4.String
+		URL    githubv4.URI
+		Author *struct {
+			Login githubv4.String
+		}
+	}
+}
+
+// mergeQueuePullRequestQuery reads a pull request's merge queue state.
+type mergeQueuePullRequestQuery struct {
+	Repository struct {
+		PullRequest struct {
+			ID              githubv4.ID
+			State           githubv4.PullRequestState
+			BaseRefName     githubv4.String
+			IsInMergeQueue  githubv4.Boolean
+			MergeQueueEntry *struct {
+				Position githubv4.Int
+			}
+		} `graphql:"pullRequest(number: $prNum)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// mergeQueueExistsQuery checks whether a branch has a merge queue.
+type mergeQueueExistsQuery struct {
+	Repository struct {
+		MergeQueue *struct {
+			ID githubv4.ID
+		} `graphql:"mergeQueue(branch: $branch)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// MergeQueueEntry is a pull request waiting in a merge queue.
+type MergeQueueEntry struct {
+	// Position is the entry's place in the queue, starting at 1.
+	Position   int    `json:"position"`
+	State      string `json:"state"`
+	PullNumber int    `json:"pull_number,omitempty"`
+	Title      string `json:"title,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Author     string `json:"author,omitempty"`
+	Enqueuer   string `json:"enqueuer,omitempty"`
+	EnqueuedAt string `json:"enqueued_at,omitempty"`
+	// EstimatedTimeToMerge is GitHub's estimate, in seconds, of when the entry merges.
+	EstimatedTimeToMerge *int `json:"estimated_time_to_merge_seconds,omitempty"`
+	// Jump is true when the entry was added to the front of the queue, and
+	// Solo when it is merged on its own rather than grouped with others.
+	Jump bool `json:"jump,omitempty"`
+	Solo bool `json:"solo,omitempty"`
+}
+
+// MergeQueueResult is the output of get_merge_queue.
+type MergeQueueResult struct {
+	Branch     string            `json:"branch"`
+	URL        string            `json:"url"`
+	Entries    []MergeQueueEntry `json:"entries"`
+	TotalCount int               `json:"total_count"`
+	// Truncated is true when the queue holds more entries than were returned.
+	Truncated bool `json:"truncated"`
+}
+
+func convertToMergeQueueEntry(node mergeQueueEntryNode) MergeQueueEntry {
+	entry := MergeQueueEntry{
+		Position: int(node.Position),
+		State:    string(node.State),
+		Jump:     bool(node.Jump),
+		Solo:     bool(node.Solo),
+	}
+	if !node.EnqueuedAt.IsZero() {
+		entry.EnqueuedAt = node.EnqueuedAt.Format(time.RFC3339)
+	}
+	if node.EstimatedTimeToMerge != nil {
+		seconds := int(*node.EstimatedTimeToMerge)
+		entry.EstimatedTimeToMerge = &seconds
+	}
+	if node.Enqueuer != nil {
+		entry.Enqueuer = string(node.Enqueuer.Login)
+	}
+	if pr := node.PullRequest; pr != nil {
+		entry.PullNumber = int(pr.Number)
+		entry.Title = string(pr.Title)
+		entry.URL = pr.URL.String()
+		if pr.Author != nil {
+			entry.Author = string(pr.Author.Login)
+		}
+	}
+	return entry
+}
+
+// noMergeQueueMessage explains that a branch has no merge queue.
+func noMergeQueueMessage(owner, repo, branch string) string {
+	return fmt.Sprintf("%s/%s has no merge queue for branch %s; a merge queue is enabled by a branch ruleset. Merge pull requests into this branch with merge_pull_request instead",
+		owner, repo, branch)
+}
+
+// GetMergeQueue creates a tool to list the pull requests in a branch's merge queue.
+func GetMergeQueue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "get_merge_queue",
+			Description: t("TOOL_GET_MERGE_QUEUE_DESCRIPTION",
+				"Get the merge queue of a branch: the queued pull requests in order, with their position, state and estimated time to merge."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_MERGE_QUEUE_USER_TITLE", "Get merge queue"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"branch": {
+						Type:        "string",
+						Description: "Branch whose merge queue to get. Defaults to the repository's default branch",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			branch, err := OptionalParam[string](args, "branch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
+			}
+			if gqlClient == nil {
+				return utils.NewToolResultError("merge queues require the GitHub GraphQL API, which is not configured"), nil, nil
+			}
+
+			vars := map[string]any{
+				"owner":  githubv4.String(owner),
+				"repo":   githubv4.String(repo),
+				"branch": (*githubv4.String)(nil),
+				"first":  githubv4.Int(mergeQueueEntriesPerPage),
+			}
+			if branch != "" {
+				vars["branch"] = githubv4.NewString(githubv4.String(branch))
+			}
+
+			var query mergeQueueQuery
+			if err := gqlClient.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get merge queue", err), nil, nil
+			}
+
+			if branch == "" && query.Repository.DefaultBranchRef != nil {
+				branch = string(query.Repository.DefaultBranchRef.Name)
+			}
+			queue := query.Repository.MergeQueue
+			if queue == nil {
+				return utils.NewToolResultError(noMergeQueueMessage(owner, repo, branch)), nil, nil
+			}
+
+			result := MergeQueueResult{
+				Branch:     branch,
+				URL:        queue.URL.String(),
+				Entries:    make([]MergeQueueEntry, 0, len(queue.Entries.Nodes)),
+				TotalCount: int(queue.Entries.TotalCount),
+			}
+			for _, node := range queue.Entries.Nodes {
+				result.Entries = append(result.Entries, convertToMergeQueueEntry(node))
+			}
+			result.Truncated = result.TotalCount > len(result.Entries)
+
+			return attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, MarshalledTextResult(result), ifc.LabelRepoUserContent), nil, nil
+		},
+	)
+}
+
+// getMergeQueuePullRequest reads the merge queue state of a pull request.
+func getMergeQueuePullRequest(ctx context.Context, client *githubv4.Client, owner, repo string, pullNumber int) (*mergeQueuePullRequestQuery, *mcp.CallToolResult) {
+	var query mergeQueuePullRequestQuery
+	vars := map[string]any{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"prNum": githubv4.Int(int32(pullNumber)), //nolint:gosec // pull request numbers fit in int32
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return nil, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request", err)
+	}
+	return &query, nil
+}
+
+// mergeQueueToolSchema is the input schema shared by enqueue_pull_request and dequeue_pull_request.
+func mergeQueueToolSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: DescriptionRepositoryOwner,
+			},
+			"repo": {
+				Type:        "string",
+				Description: DescriptionRepositoryName,
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+}
+
+// EnqueuePullRequest creates a tool to add a pull request to its base branch's merge queue.
+func EnqueuePullRequest(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := mergeQueueToolSchema()
+	schema.Properties["jump"] = &jsonschema.Schema{
+		Type:        "boolean",
+		Description: "Add the pull request to the front of the queue instead of the back. Requires permission to bypass the queue order",
+	}
+	schema.Properties["expectedHeadSha"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "The SHA the pull request's head ref is expected to have. The pull request is only queued if the head still points at this SHA",
+	}
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "enqueue_pull_request",
+			Description: t("TOOL_ENQUEUE_PULL_REQUEST_DESCRIPTION",
+				"Add a pull request to the merge queue of its base branch. The pull request is merged once the queue's required checks pass."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_ENQUEUE_PULL_REQUEST_USER_TITLE", "Add pull request to merge queue"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			jump, err := OptionalParam[bool](args, "jump")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](args, "expectedHeadSha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
+			}
+			if gqlClient == nil {
+				return utils.NewToolResultError("merge queues require the GitHub GraphQL API, which is not configured"), nil, nil
+			}
+
+			query, errResult := getMergeQueuePullRequest(ctx, gqlClient, owner, repo, pullNumber)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			pr := query.Repository.PullRequest
+			if pr.State != githubv4.PullRequestStateOpen {
+				return utils.NewToolResultError(fmt.Sprintf("pull request #%d is %s and cannot be queued", pullNumber, pr.State)), nil, nil
+			}
+			if pr.IsInMergeQueue {
+				position := 0
+				if pr.MergeQueueEntry != nil {
+					position = int(pr.MergeQueueEntry.Position)
+				}
+				return utils.NewToolResultError(fmt.Sprintf("pull request #%d is already in the merge queue at position %d", pullNumber, position)), nil, nil
+			}
+
+			var exists mergeQueueExistsQuery
+			if err := gqlClient.Query(ctx, &exists, map[string]any{
+				"owner":  githubv4.String(owner),
+				"repo":   githubv4.String(repo),
+				"branch": githubv4.NewString(pr.BaseRefName),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get merge queue", err), nil, nil
+			}
+			if exists.Repository.MergeQueue == nil {
+				return utils.NewToolResultError(noMergeQueueMessage(owner, repo, string(pr.BaseRefName))), nil, nil
+			}
+
+			var mutation struct {
+				EnqueuePullRequest struct {
+					MergeQueueEntry mergeQueueEntryNode
+				} `graphql:"enqueuePullRequest(input: $input)"`
+			}
+			input := githubv4.EnqueuePullRequestInput{
+				PullRequestID: pr.ID,
+			}
+			if jump {
+				input.Jump = githubv4.NewBoolean(true)
+			}
+			if expectedHeadSHA != "" {
+				input.ExpectedHeadOid = githubv4.NewGitObjectID(githubv4.GitObjectID(expectedHeadSHA))
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add pull request to the merge queue", err), nil, nil
+			}
+
+			return MarshalledTextResult(convertToMergeQueueEntry(mutation.EnqueuePullRequest.MergeQueueEntry)), nil, nil
+		},
+	)
+}
+
+// DequeuePullRequest creates a tool to remove a pull request from the merge queue.
+func DequeuePullRequest(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "dequeue_pull_request",
+			Description: t("TOOL_DEQUEUE_PULL_REQUEST_DESCRIPTION",
+				"Remove a pull request from the merge queue. The pull request stays open and can be queued again."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DEQUEUE_PULL_REQUEST_USER_TITLE", "Remove pull request from merge queue"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: mergeQueueToolSchema(),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
+			}
+			if gqlClient == nil {
+				return utils.NewToolResultError("merge queues require the GitHub GraphQL API, which is not configured"), nil, nil
+			}
+
+			query, errResult := getMergeQueuePullRequest(ctx, gqlClient, owner, repo, pullNumber)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			pr := query.Repository.PullRequest
+			if !pr.IsInMergeQueue {
+				return utils.NewToolResultError(fmt.Sprintf("pull request #%d is not in a merge queue", pullNumber)), nil, nil
+			}
+
+			var mutation struct {
+				DequeuePullRequest struct {
+					MergeQueueEntry struct {
+						ID githubv4.ID
+					}
+				} `graphql:"dequeuePullRequest(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, githubv4.DequeuePullRequestInput{ID: pr.ID}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to remove pull request from the merge queue", err), nil, nil
+			}
+
+			return utils.NewToolResultText(fmt.Sprintf("pull request #%d removed from the merge queue", pullNumber)), nil, nil
+		},
+	)
+}
@@ -0,0 +1,389 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetMergeQueue(t *testing.T) {
+	serverTool := GetMergeQueue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_merge_queue", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "branch")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	queueVars := func(branch *githubv4.String) map[string]any {
+		return map[string]any{
+			"owner":  githubv4.String("owner"),
+			"repo":   githubv4.String("repo"),
+			"branch": branch,
+			"first":  githubv4.Int(100),
+		}
+	}
+	queue := map[string]any{
+		"repository": map[string]any{
+			"mergeQueue": map[string]any{
+				"url": "https://github.com/owner/repo/queue/main",
+				"entries": map[string]any{
+					"nodes": []map[string]any{
+						{
+							"position":             1,
+							"state":                "AWAITING_CHECKS",
+							"enqueuedAt":           "2024-05-01T10:00:00Z",
+							"estimatedTimeToMerge": 600,
+							"jump":                 false,
+							"solo":                 false,
+							"enqueuer":             map[string]any{"login": "maintainer"},
+							"pullRequest": map[string]any{
+								"number": 12,
+								"title":  "Add feature",
+								"url":    "https://github.com/owner/repo/pull/12",
+								"author": map[string]any{"login": "contributor"},
+							},
+						},
+						{
+							"position":             2,
+							"state":                "QUEUED",
+							"enqueuedAt":           "2024-05-01T10:05:00Z",
+							"estimatedTimeToMerge": nil,
+							"jump":                 true,
+							"solo":                 true,
+							"enqueuer":             map[string]any{"login": "maintainer"},
+							"pullRequest": map[string]any{
+								"number": 15,
+								"title":  "Hotfix",
+								"url":    "https://github.com/owner/repo/pull/15",
+								"author": map[string]any{"login": "maintainer"},
+							},
+						},
+					},
+					"totalCount": 3,
+				},
+			},
+			"defaultBranchRef": map[string]any{"name": "main"},
+		},
+	}
+	releaseQueue := githubv4mock.NewQueryMatcher(
+		mergeQueueQuery{},
+		queueVars(githubv4.NewString("release")),
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"mergeQueue":       nil,
+				"defaultBranchRef": map[string]any{"name": "main"},
+			},
+		}),
+	)
+	// The query is rendered from the pointer type the tool sends, but the mock
+	// compares the decoded variable by value.
+	releaseQueue.Variables["branch"] = githubv4.String("release")
+
+	tests := []struct {
+		name            string
+		gqlHTTPClient   *http.Client
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedResult  MergeQueueResult
+	}{
+		{
+			name: "lists the default branch's queue",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(mergeQueueQuery{}, queueVars(nil), githubv4mock.DataResponse(queue)),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: MergeQueueResult{
+				Branch: "main",
+				URL:    "https://github.com/owner/repo/queue/main",
+				Entries: []MergeQueueEntry{
+					{
+						Position:             1,
+						State:                "AWAITING_CHECKS",
+						PullNumber:           12,
+						Title:                "Add feature",
+						URL:                  "https://github.com/owner/repo/pull/12",
+						Author:               "contributor",
+						Enqueuer:             "maintainer",
+						EnqueuedAt:           "2024-05-01T10:00:00Z",
+						EstimatedTimeToMerge: func() *int { v := 600; return &v }(),
+					},
+					{
+						Position:   2,
+						State:      "QUEUED",
+						PullNumber: 15,
+						Title:      "Hotfix",
+						URL:        "https://github.com/owner/repo/pull/15",
+						Author:     "maintainer",
+						Enqueuer:   "maintainer",
+						EnqueuedAt: "2024-05-01T10:05:00Z",
+						Jump:       true,
+						Solo:       true,
+					},
+				},
+				TotalCount: 3,
+				Truncated:  true,
+			},
+		},
+		{
+			name:          "branch without a merge queue",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(releaseQueue),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "release",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "owner/repo has no merge queue for branch release",
+		},
+		{
+			name: "GraphQL error",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(mergeQueueQuery{}, queueVars(nil),
+					githubv4mock.ErrorResponse("Could not resolve to a Repository with the name 'owner/repo'.")),
+			),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get merge queue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				GQLClient: githubv4.NewClient(tc.gqlHTTPClient),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response MergeQueueResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
+
+func Test_EnqueueAndDequeuePullRequest(t *testing.T) {
+	enqueueTool := EnqueuePullRequest(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(enqueueTool.Tool.Name, enqueueTool.Tool))
+	dequeueTool := DequeuePullRequest(translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(dequeueTool.Tool.Name, dequeueTool.Tool))
+	assert.False(t, enqueueTool.Tool.Annotations.ReadOnlyHint)
+	assert.False(t, dequeueTool.Tool.Annotations.ReadOnlyHint)
+
+	pullRequest := func(state string, inQueue bool) githubv4mock.Matcher {
+		var entry any
+		if inQueue {
+			entry = map[string]any{"position": 3}
+		}
+		return githubv4mock.NewQueryMatcher(
+			mergeQueuePullRequestQuery{},
+			map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+				"prNum": githubv4.Int(42),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"pullRequest": map[string]any{
+						"id":              "PR_42",
+						"state":           state,
+						"baseRefName":     "main",
+						"isInMergeQueue":  inQueue,
+						"mergeQueueEntry": entry,
+					},
+				},
+			}),
+		)
+	}
+	queueExists := func(exists bool) githubv4mock.Matcher {
+		var queue any
+		if exists {
+			queue = map[string]any{"id": "MQ_1"}
+		}
+		m := githubv4mock.NewQueryMatcher(
+			mergeQueueExistsQuery{},
+			map[string]any{
+				"owner":  githubv4.String("owner"),
+				"repo":   githubv4.String("repo"),
+				"branch": githubv4.NewString("main"),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{"mergeQueue": queue},
+			}),
+		)
+		m.Variables["branch"] = githubv4.String("main")
+		return m
+	}
+	enqueue := githubv4mock.NewMutationMatcher(
+		struct {
+			EnqueuePullRequest struct {
+				MergeQueueEntry mergeQueueEntryNode
+			} `graphql:"enqueuePullRequest(input: $input)"`
+		}{},
+		githubv4.EnqueuePullRequestInput{
+			PullRequestID:   githubv4.ID("PR_42"),
+			Jump:            githubv4.NewBoolean(true),
+			ExpectedHeadOid: githubv4.NewGitObjectID("abc123"),
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"enqueuePullRequest": map[string]any{
+				"mergeQueueEntry": map[string]any{
+					"position":             1,
+					"state":                "QUEUED",
+					"enqueuedAt":           "2024-05-01T10:00:00Z",
+					"estimatedTimeToMerge": 900,
+					"jump":                 true,
+					"solo":                 false,
+					"enqueuer":             map[string]any{"login": "maintainer"},
+					"pullRequest": map[string]any{
+						"number": 42,
+						"title":  "Add feature",
+						"url":    "https://github.com/owner/repo/pull/42",
+						"author": map[string]any{"login": "contributor"},
+					},
+				},
+			},
+		}),
+	)
+	dequeue := githubv4mock.NewMutationMatcher(
+		struct {
+			DequeuePullRequest struct {
+				MergeQueueEntry struct {
+					ID githubv4.ID
+				}
+			} `graphql:"dequeuePullRequest(input: $input)"`
+		}{},
+		githubv4.DequeuePullRequestInput{ID: githubv4.ID("PR_42")},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"dequeuePullRequest": map[string]any{
+				"mergeQueueEntry": map[string]any{"id": "MQE_1"},
+			},
+		}),
+	)
+
+	enqueueArgs := map[string]any{
+		"owner":           "owner",
+		"repo":            "repo",
+		"pullNumber":      float64(42),
+		"jump":            true,
+		"expectedHeadSha": "abc123",
+	}
+	dequeueArgs := map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+	}
+
+	tests := []struct {
+		name            string
+		tool            func(t translations.TranslationHelperFunc) inventory.ServerTool
+		gqlHTTPClient   *http.Client
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedText    string
+	}{
+		{
+			name:          "enqueues a pull request",
+			tool:          EnqueuePullRequest,
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(pullRequest("OPEN", false), queueExists(true), enqueue),
+			requestArgs:   enqueueArgs,
+			expectedText:  `"estimated_time_to_merge_seconds":900`,
+		},
+		{
+			name:            "enqueue without a merge queue",
+			tool:            EnqueuePullRequest,
+			gqlHTTPClient:   githubv4mock.NewMockedHTTPClient(pullRequest("OPEN", false), queueExists(false)),
+			requestArgs:     enqueueArgs,
+			expectToolError: true,
+			expectedErrMsg:  "owner/repo has no merge queue for branch main",
+		},
+		{
+			name:            "enqueue a queued pull request",
+			tool:            EnqueuePullRequest,
+			gqlHTTPClient:   githubv4mock.NewMockedHTTPClient(pullRequest("OPEN", true)),
+			requestArgs:     enqueueArgs,
+			expectToolError: true,
+			expectedErrMsg:  "pull request #42 is already in the merge queue at position 3",
+		},
+		{
+			name:            "enqueue a closed pull request",
+			tool:            EnqueuePullRequest,
+			gqlHTTPClient:   githubv4mock.NewMockedHTTPClient(pullRequest("CLOSED", false)),
+			requestArgs:     enqueueArgs,
+			expectToolError: true,
+			expectedErrMsg:  "pull request #42 is CLOSED and cannot be queued",
+		},
+		{
+			name:          "dequeues a pull request",
+			tool:          DequeuePullRequest,
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(pullRequest("OPEN", true), dequeue),
+			requestArgs:   dequeueArgs,
+			expectedText:  "pull request #42 removed from the merge queue",
+		},
+		{
+			name:            "dequeue a pull request that is not queued",
+			tool:            DequeuePullRequest,
+			gqlHTTPClient:   githubv4mock.NewMockedHTTPClient(pullRequest("OPEN", false)),
+			requestArgs:     dequeueArgs,
+			expectToolError: true,
+			expectedErrMsg:  "pull request #42 is not in a merge queue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				GQLClient: githubv4.NewClient(tc.gqlHTTPClient),
+			}
+			serverTool := tc.tool(translations.NullTranslationHelper)
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package github
+
+import (
+	"context"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxRequiredCheckRunPages bounds how many pages of check runs are read for
+// the head commit of a pull request.
+const maxRequiredCheckRunPages = 5
+
+// Required check states reported by get_required_status_checks.
+const (
+	requiredCheckPassing = "passing"
+	requiredCheckFailing = "failing"
+	requiredCheckPending = "pending"
+)
+
+// RequiredCheckState is the state of one check required to merge a pull request.
+type RequiredCheckState struct {
+	Context string `json:"context"`
+	// AppID is the GitHub App that must report the check, when one is required.
+	AppID *int64 `json:"app_id,omitempty"`
+	// Source is "branch_protection" or "ruleset".
+	Source string `json:"source"`
+	// State is "passing", "failing" or "pending".
+	State string `json:"state"`
+	// Reported is false when nothing has reported the check for the head commit yet.
+	Reported bool `json:"reported"`
+	// Result is the check run conclusion or status, or the commit status state.
+	Result     string `json:"result,omitempty"`
+	DetailsURL string `json:"details_url,omitempty"`
+}
+
+// RequiredStatusChecksResult reports the checks required to merge a pull request.
+type RequiredStatusChecksResult struct {
+	BaseRef string `json:"base_ref"`
+	HeadSHA string `json:"head_sha"`
+	// Strict is true when the head branch must be up to date with the base branch.
+	Strict     bool                 `json:"strict"`
+	Checks     []RequiredCheckState `json:"checks"`
+	Passing    int                  `json:"passing"`
+	Failing    int                  `json:"failing"`
+	Pending    int                  `json:"pending"`
+	AllPassing bool                 `json:"all_passing"`
+}
+
+// GetRequiredStatusChecks creates a tool to report the state of the checks required to merge a pull request.
+func GetRequiredStatusChecks(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "get_required_status_checks",
+			Description: t("TOOL_GET_REQUIRED_STATUS_CHECKS_DESCRIPTION",
+				"Get the status checks required to merge a pull request and whether each is passing, failing or pending on the pull request's head commit. "+
+					"Required checks are read from the base branch's protection and from the repository rulesets that apply to it. "+
+					"A required check that nothing has reported yet is pending."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_REQUIRED_STATUS_CHECKS_USER_TITLE", "Get required status checks for a pull request"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := RequiredStatusChecksResult{
+				BaseRef: pr.GetBase().GetRef(),
+				HeadSHA: pr.GetHead().GetSHA(),
+				Checks:  []RequiredCheckState{},
+			}
+
+			required, strict, errResult := getRequiredChecks(ctx, client, owner, repo, result.BaseRef)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			result.Strict = strict
+
+			if len(required) > 0 {
+				runs, errResult := listLatestCheckRuns(ctx, client, owner, repo, result.HeadSHA)
+				if errResult != nil {
+					return errResult, nil, nil
+				}
+				status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, result.HeadSHA, &github.ListOptions{PerPage: 100})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get combined status", resp, err), nil, nil
+				}
+				_ = resp.Body.Close()
+
+				for _, check := range required {
+					state := evaluateRequiredCheck(check, runs, status.Statuses)
+					switch state.State {
+					case requiredCheckPassing:
+						result.Passing++
+					case requiredCheckFailing:
+						result.Failing++
+					default:
+						result.Pending++
+					}
+					result.Checks = append(result.Checks, state)
+				}
+			}
+			result.AllPassing = result.Failing == 0 && result.Pending == 0
+
+			return attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, MarshalledTextResult(result), ifc.LabelActionsResult), nil, nil
+		},
+	)
+}
+
+// getRequiredChecks collects the checks required on a branch by its protection
+// and by the rulesets that apply to it, without duplicates. A branch without
+// protection or rulesets has no required checks.
+func getRequiredChecks(ctx context.Context, client *github.Client, owner, repo, branch string) ([]RequiredCheckState, bool, *mcp.CallToolResult) {
+	var required []RequiredCheckState
+	strict := false
+	type checkKey struct {
+		name  string
+		appID int64
+	}
+	seen := make(map[checkKey]bool)
+	add := func(name string, appID *int64, source string) {
+		key := checkKey{name: name, appID: -1}
+		if appID != nil {
+			key.appID = *appID
+		}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		required = append(required, RequiredCheckState{Context: name, AppID: appID, Source: source})
+	}
+
+	protection, resp, err := client.Repositories.GetRequiredStatusChecks(ctx, owner, repo, branch)
+	switch {
+	case err == nil:
+		_ = resp.Body.Close()
+		strict = protection.Strict
+		if protection.Checks != nil {
+			for _, check := range *protection.Checks {
+				add(check.Context, requiredAppID(check.AppID), "branch_protection")
+			}
+		} else if protection.Contexts != nil {
+			for _, name := range *protection.Contexts {
+				add(name, nil, "branch_protection")
+			}
+		}
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		// The branch is not protected or does not require status checks.
+	default:
+		return nil, false, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch protection required status checks", resp, err)
+	}
+
+	rules, resp, err := client.Repositories.ListRulesForBranch(ctx, owner, repo, branch, &github.ListOptions{PerPage: 100})
+	switch {
+	case err == nil:
+		_ = resp.Body.Close()
+		for _, rule := range rules.RequiredStatusChecks {
+			strict = strict || rule.Parameters.StrictRequiredStatusChecksPolicy
+			for _, check := range rule.Parameters.RequiredStatusChecks {
+				add(check.Context, requiredAppID(check.IntegrationID), "ruleset")
+			}
+		}
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		// No rulesets apply to the branch.
+	default:
+		return nil, false, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get rules for branch", resp, err)
+	}
+
+	return required, strict, nil
+}
+
+// requiredAppID returns the app a required check must come from, or nil when
+// any source is accepted, which the API spells as a missing ID or -1.
+func requiredAppID(id *int64) *int64 {
+	if id == nil || *id < 0 {
+		return nil
+	}
+	return id
+}
+
+// listLatestCheckRuns lists the most recent check run for each check on a commit.
+func listLatestCheckRuns(ctx context.Context, client *github.Client, owner, repo, sha string) ([]*github.CheckRun, *mcp.CallToolResult) {
+	opts := &github.ListCheckRunsOptions{
+		Filter:      github.Ptr("latest"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var runs []*github.CheckRun
+	for range maxRequiredCheckRunPages {
+		page, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list check runs", resp, err)
+		}
+		_ = resp.Body.Close()
+		runs = append(runs, page.CheckRuns...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return runs, nil
+}
+
+// evaluateRequiredCheck finds what reported a required check and maps it to
+// passing, failing or pending. Check runs are preferred over commit statuses
+// with the same name; a required app only constrains check runs, since commit
+// statuses do not record the app that created them.
+func evaluateRequiredCheck(check RequiredCheckState, runs []*github.CheckRun, statuses []*github.RepoStatus) RequiredCheckState {
+	check.State = requiredCheckPending
+
+	for _, run := range runs {
+		if run.GetName() != check.Context {
+			continue
+		}
+		if check.AppID != nil && run.GetApp().GetID() != *check.AppID {
+			continue
+		}
+		check.Reported = true
+		check.DetailsURL = run.GetHTMLURL()
+		if run.GetStatus() != "completed" {
+			check.Result = run.GetStatus()
+			return check
+		}
+		check.Result = run.GetConclusion()
+		switch run.GetConclusion() {
+		case "success", "neutral", "skipped":
+			check.State = requiredCheckPassing
+		default:
+			check.State = requiredCheckFailing
+		}
+		return check
+	}
+
+	for _, status := range statuses {
+		if status.GetContext() != check.Context {
+			continue
+		}
+		check.Reported = true
+		check.DetailsURL = status.GetTargetURL()
+		check.Result = status.GetState()
+		switch status.GetState() {
+		case "success":
+			check.State = requiredCheckPassing
+		case "failure", "error":
+			check.State = requiredCheckFailing
+		}
+		return check
+	}
+
+	return check
+}
@@ -0,0 +1,241 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRequiredStatusChecks(t *testing.T) {
+	serverTool := GetRequiredStatusChecks(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_required_status_checks", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pullRequest := mockResponse(t, http.StatusOK, &github.PullRequest{
+		Number: github.Ptr(42),
+		Base:   &github.PullRequestBranch{Ref: github.Ptr("main")},
+		Head:   &github.PullRequestBranch{SHA: github.Ptr("abc123")},
+	})
+	notFound := mockResponse(t, http.StatusNotFound, `{"message": "Branch not protected"}`)
+	checkRuns := mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{
+		Total: github.Ptr(3),
+		CheckRuns: []*github.CheckRun{
+			{
+				Name:       github.Ptr("build"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("success"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/runs/1"),
+				App:        &github.App{ID: github.Ptr(int64(15368))},
+			},
+			{
+				Name:       github.Ptr("test"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				App:        &github.App{ID: github.Ptr(int64(15368))},
+			},
+			{
+				Name:   github.Ptr("lint"),
+				Status: github.Ptr("in_progress"),
+				App:    &github.App{ID: github.Ptr(int64(99))},
+			},
+		},
+	})
+	combinedStatus := mockResponse(t, http.StatusOK, &github.CombinedStatus{
+		State: github.Ptr("success"),
+		Statuses: []*github.RepoStatus{
+			{
+				Context:   github.Ptr("ci/legacy"),
+				State:     github.Ptr("success"),
+				TargetURL: github.Ptr("https://ci.example.com/1"),
+			},
+		},
+	})
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+		expected        RequiredStatusChecksResult
+	}{
+		{
+			name: "branch protection and ruleset checks",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: pullRequest,
+				GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch: mockResponse(t, http.StatusOK, &github.RequiredStatusChecks{
+					Strict: true,
+					Checks: &[]*github.RequiredStatusCheck{
+						{Context: "build", AppID: github.Ptr(int64(15368))},
+						{Context: "ci/legacy", AppID: github.Ptr(int64(-1))},
+					},
+				}),
+				GetReposRulesBranchesByOwnerByRepoByBranch: mockResponse(t, http.StatusOK, `[
+					{
+						"type": "required_status_checks",
+						"ruleset_source_type": "Repository",
+						"ruleset_source": "owner/repo",
+						"ruleset_id": 1,
+						"parameters": {
+							"strict_required_status_checks_policy": false,
+							"required_status_checks": [
+								{"context": "build", "integration_id": 15368},
+								{"context": "test"},
+								{"context": "lint", "integration_id": 15368},
+								{"context": "deploy"}
+							]
+						}
+					}
+				]`),
+				GetReposCommitsCheckRunsByOwnerByRepoByRef: checkRuns,
+				GetReposCommitsStatusByOwnerByRepoByRef:    combinedStatus,
+			},
+			expected: RequiredStatusChecksResult{
+				BaseRef: "main",
+				HeadSHA: "abc123",
+				Strict:  true,
+				Checks: []RequiredCheckState{
+					{
+						Context:    "build",
+						AppID:      github.Ptr(int64(15368)),
+						Source:     "branch_protection",
+						State:      "passing",
+						Reported:   true,
+						Result:     "success",
+						DetailsURL: "https://github.com/owner/repo/runs/1",
+					},
+					{
+						Context:    "ci/legacy",
+						Source:     "branch_protection",
+						State:      "passing",
+						Reported:   true,
+						Result:     "success",
+						DetailsURL: "https://ci.example.com/1",
+					},
+					{
+						Context:  "test",
+						Source:   "ruleset",
+						State:    "failing",
+						Reported: true,
+						Result:   "failure",
+					},
+					{
+						// Reported by a different app than the one required.
+						Context: "lint",
+						AppID:   github.Ptr(int64(15368)),
+						Source:  "ruleset",
+						State:   "pending",
+					},
+					{
+						Context: "deploy",
+						Source:  "ruleset",
+						State:   "pending",
+					},
+				},
+				Passing: 2,
+				Failing: 1,
+				Pending: 2,
+			},
+		},
+		{
+			name: "legacy contexts",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: pullRequest,
+				GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch: mockResponse(t, http.StatusOK, &github.RequiredStatusChecks{
+					Contexts: &[]string{"build"},
+				}),
+				GetReposRulesBranchesByOwnerByRepoByBranch: mockResponse(t, http.StatusOK, `[]`),
+				GetReposCommitsCheckRunsByOwnerByRepoByRef: checkRuns,
+				GetReposCommitsStatusByOwnerByRepoByRef:    combinedStatus,
+			},
+			expected: RequiredStatusChecksResult{
+				BaseRef: "main",
+				HeadSHA: "abc123",
+				Checks: []RequiredCheckState{
+					{
+						Context:    "build",
+						Source:     "branch_protection",
+						State:      "passing",
+						Reported:   true,
+						Result:     "success",
+						DetailsURL: "https://github.com/owner/repo/runs/1",
+					},
+				},
+				Passing:    1,
+				AllPassing: true,
+			},
+		},
+		{
+			name: "unprotected branch",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:                              pullRequest,
+				GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch: notFound,
+				GetReposRulesBranchesByOwnerByRepoByBranch:                          notFound,
+			},
+			expected: RequiredStatusChecksResult{
+				BaseRef:    "main",
+				HeadSHA:    "abc123",
+				Checks:     []RequiredCheckState{},
+				AllPassing: true,
+			},
+		},
+		{
+			name: "pull request not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get pull request",
+		},
+		{
+			name: "branch protection error",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:                              pullRequest,
+				GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch: mockResponse(t, http.StatusInternalServerError, `{"message": "Server Error"}`),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get branch protection required status checks",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response RequiredStatusChecksResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// maxReviewLineComments bounds the line comments submitted with one review.
+	maxReviewLineComments = 50
+	// maxPullRequestFilePages bounds how many pages of changed files are read
+	// to validate line comments. The API lists at most 3000 files.
+	maxPullRequestFilePages = 30
+)
+
+// hunkHeaderPattern matches a unified diff hunk header and captures the first
+// line of the hunk in the old and new file.
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// ReviewLineComment is a comment on one line of a pull request's diff.
+type ReviewLineComment struct {
+	Path string
+	Line int
+	// Side is RIGHT for the new version of the file and LEFT for the old one.
+	Side string
+	Body string
+}
+
+// reviewLineCommentsSchema is the input schema of the comments submitted with a review.
+var reviewLineCommentsSchema = &jsonschema.Schema{
+	Type:        "array",
+	Description: "Line comments to submit with the review. Each comment must be on a line that is part of the pull request's diff.",
+	MaxItems:    jsonschema.Ptr(maxReviewLineComments),
+	Items: &jsonschema.Schema{
+		Type:                 "object",
+		AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+		Properties: map[string]*jsonschema.Schema{
+			"path": {
+				Type:        "string",
+				Description: "Path of the file relative to the repository root",
+			},
+			"line": {
+				Type:        "number",
+				Description: "Line of the file to comment on",
+				Minimum:     jsonschema.Ptr(1.0),
+			},
+			"side": {
+				Type:        "string",
+				Description: "Side of the diff the line is on: RIGHT for added or unchanged lines in the new version, LEFT for deleted lines",
+				Enum:        []any{"RIGHT", "LEFT"},
+				Default:     json.RawMessage(`"RIGHT"`),
+			},
+			"body": {
+				Type:        "string",
+				Description: "Comment text",
+			},
+		},
+		Required: []string{"path", "line", "body"},
+	},
+}
+
+// parseReviewLineComments validates the raw "comments" argument of create_pull_request_review.
+func parseReviewLineComments(raw any) ([]ReviewLineComment, error) {
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("comments must be an array of comment objects")
+	}
+	if len(items) > maxReviewLineComments {
+		return nil, fmt.Errorf("at most %d comments can be submitted with a review, got %d", maxReviewLineComments, len(items))
+	}
+
+	comments := make([]ReviewLineComment, 0, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("comment %d must be an object", i+1)
+		}
+		path, err := RequiredParam[string](m, "path")
+		if err != nil {
+			return nil, fmt.Errorf("comment %d: %w", i+1, err)
+		}
+		line, err := RequiredInt(m, "line")
+		if err != nil {
+			return nil, fmt.Errorf("comment %d: %w", i+1, err)
+		}
+		if line < 1 {
+			return nil, fmt.Errorf("comment %d: line must be at least 1", i+1)
+		}
+		body, err := RequiredParam[string](m, "body")
+		if err != nil {
+			return nil, fmt.Errorf("comment %d: %w", i+1, err)
+		}
+		side, err := OptionalParam[string](m, "side")
+		if err != nil {
+			return nil, fmt.Errorf("comment %d: %w", i+1, err)
+		}
+		switch side {
+		case "":
+			side = "RIGHT"
+		case "RIGHT", "LEFT":
+		default:
+			return nil, fmt.Errorf("comment %d: side must be RIGHT or LEFT", i+1)
+		}
+		comments = append(comments, ReviewLineComment{
+			Path: strings.TrimPrefix(path, "/"),
+			Line: line,
+			Side: side,
+			Body: body,
+		})
+	}
+	return comments, nil
+}
+
+// diffLines records the lines of a file that a pull request's diff shows and
+// that can therefore be commented on.
+type diffLines struct {
+	right map[int]bool
+	left  map[int]bool
+}
+
+// parseDiffLines reads the lines shown by a file's patch. Added and context
+// lines are on the right side, deleted and context lines on the left.
+func parseDiffLines(patch string) diffLines {
+	lines := diffLines{right: map[int]bool{}, left: map[int]bool{}}
+	inHunk := false
+	var oldLine, newLine int
+	for _, l := range strings.Split(patch, "\n") {
+		if m := hunkHeaderPattern.FindStringSubmatch(l); m != nil {
+			oldLine, _ = strconv.Atoi(m[1])
+			newLine, _ = strconv.Atoi(m[2])
+			inHunk = true
+			continue
+		}
+		if !inHunk || l == "" {
+			continue
+		}
+		switch l[0] {
+		case '+':
+			lines.right[newLine] = true
+			newLine++
+		case '-':
+			lines.left[oldLine] = true
+			oldLine++
+		case '\\':
+			// "\ No newline at end of file"
+		default:
+			lines.right[newLine] = true
+			lines.left[oldLine] = true
+			newLine++
+			oldLine++
+		}
+	}
+	return lines
+}
+
+// lineRanges formats a set of line numbers as compact ranges, e.g. "3-7, 12".
+func lineRanges(set map[int]bool) string {
+	lines := make([]int, 0, len(set))
+	for line := range set {
+		lines = append(lines, line)
+	}
+	slices.Sort(lines)
+
+	var ranges []string
+	for i := 0; i < len(lines); {
+		j := i
+		for j+1 < len(lines) && lines[j+1] == lines[j]+1 {
+			j++
+		}
+		if i == j {
+			ranges = append(ranges, strconv.Itoa(lines[i]))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", lines[i], lines[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ranges, ", ")
+}
+
+// validateReviewLineComments checks that every comment is on a line shown by
+// the pull request's diff, so the review is not rejected as a whole. It
+// returns a tool error describing every comment that is not.
+func validateReviewLineComments(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, comments []ReviewLineComment) *mcp.CallToolResult {
+	wanted := make(map[string]bool, len(comments))
+	for _, c := range comments {
+		wanted[c.Path] = true
+	}
+
+	// patches maps the commented files found in the diff to their patch,
+	// which is empty for binary files and files whose diff is too large.
+	patches := make(map[string]string, len(wanted))
+	opts := &github.ListOptions{PerPage: 100}
+	for range maxPullRequestFilePages {
+		files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request files", resp, err)
+		}
+		_ = resp.Body.Close()
+		for _, f := range files {
+			if wanted[f.GetFilename()] {
+				patches[f.GetFilename()] = f.GetPatch()
+			}
+		}
+		if len(patches) == len(wanted) || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	parsed := make(map[string]diffLines, len(patches))
+	var problems []string
+	for i, c := range comments {
+		patch, ok := patches[c.Path]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("comment %d: %s is not changed by the pull request", i+1, c.Path))
+			continue
+		}
+		if patch == "" {
+			problems = append(problems, fmt.Sprintf("comment %d: %s has no text diff to comment on", i+1, c.Path))
+			continue
+		}
+		lines, ok := parsed[c.Path]
+		if !ok {
+			lines = parseDiffLines(patch)
+			parsed[c.Path] = lines
+		}
+		sideLines := lines.right
+		if c.Side == "LEFT" {
+			sideLines = lines.left
+		}
+		if !sideLines[c.Line] {
+			problems = append(problems, fmt.Sprintf("comment %d: line %d of %s is not in the diff on the %s side; lines that can be commented on: %s",
+				i+1, c.Line, c.Path, c.Side, lineRanges(sideLines)))
+		}
+	}
+
+	if len(problems) > 0 {
+		return utils.NewToolResultError("review not submitted because some comments are not on lines in the pull request's diff:\n" + strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// CreatePullRequestReviewWithComments creates a review with line comments
+// through the REST API, after checking that each comment is on the diff.
+// Without an event the review is left pending.
+func CreatePullRequestReviewWithComments(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, body, event, commitID string, comments []ReviewLineComment) (*mcp.CallToolResult, error) {
+	if errResult := validateReviewLineComments(ctx, client, owner, repo, pullNumber, comments); errResult != nil {
+		return errResult, nil
+	}
+
+	request := &github.PullRequestReviewRequest{
+		Comments: make([]*github.DraftReviewComment, 0, len(comments)),
+	}
+	if body != "" {
+		request.Body = github.Ptr(body)
+	}
+	if event != "" {
+		request.Event = github.Ptr(event)
+	}
+	if commitID != "" {
+		request.CommitID = github.Ptr(commitID)
+	}
+	for _, c := range comments {
+		request.Comments = append(request.Comments, &github.DraftReviewComment{
+			Path: github.Ptr(c.Path),
+			Line: github.Ptr(c.Line),
+			Side: github.Ptr(c.Side),
+			Body: github.Ptr(c.Body),
+		})
+	}
+
+	review, resp, err := client.PullRequests.CreateReview(ctx, owner, repo, pullNumber, request)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create pull request review", resp, err), nil
+	}
+	_ = resp.Body.Close()
+
+	return MarshalledTextResult(convertToMinimalPullRequestReview(review)), nil
+}
@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseDiffLines(t *testing.T) {
+	patch := "@@ -1,4 +1,5 @@\n" +
+		" package main\n" +
+		"-import \"fmt\"\n" +
+		"+import (\n" +
+		"+\t\"fmt\"\n" +
+		"+)\n" +
+		" \n" +
+		"@@ -20,2 +21,2 @@ func main() {\n" +
+		"-\tfmt.Println(\"hi\")\n" +
+		"+\tfmt.Println(\"hello\")\n" +
+		"\\ No newline at end of file"
+
+	lines := parseDiffLines(patch)
+	assert.Equal(t, "1-5, 21", lineRanges(lines.right))
+	assert.Equal(t, "1-3, 20", lineRanges(lines.left))
+}
+
+func Test_ParseReviewLineComments(t *testing.T) {
+	comments, err := parseReviewLineComments([]any{
+		map[string]any{"path": "/main.go", "line": float64(3), "body": "Group imports"},
+		map[string]any{"path": "main.go", "line": float64(20), "side": "LEFT", "body": "Why remove this?"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []ReviewLineComment{
+		{Path: "main.go", Line: 3, Side: "RIGHT", Body: "Group imports"},
+		{Path: "main.go", Line: 20, Side: "LEFT", Body: "Why remove this?"},
+	}, comments)
+
+	_, err = parseReviewLineComments([]any{map[string]any{"path": "main.go", "body": "no line"}})
+	assert.ErrorContains(t, err, "comment 1: missing required parameter: line")
+
+	_, err = parseReviewLineComments([]any{map[string]any{"path": "main.go", "line": float64(1), "side": "UP", "body": "x"}})
+	assert.ErrorContains(t, err, "side must be RIGHT or LEFT")
+
+	_, err = parseReviewLineComments("main.go:1")
+	assert.ErrorContains(t, err, "comments must be an array")
+}
+
+func Test_CreatePullRequestReviewWithComments(t *testing.T) {
+	serverTool := GranularCreatePullRequestReview(translations.NullTranslationHelper)
+
+	files := mockResponse(t, http.StatusOK, []*github.CommitFile{
+		{
+			Filename: github.Ptr("main.go"),
+			Patch:    github.Ptr("@@ -10,3 +10,4 @@ func main() {\n \ta := 1\n-\tb := 2\n+\tb := 3\n+\tc := 4\n \treturn\n"),
+		},
+		{
+			Filename: github.Ptr("logo.png"),
+		},
+	})
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		comments        []any
+		expectToolError bool
+		expectedErrMsg  string
+	}{
+		{
+			name: "submits review with comments on the diff",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsFilesByOwnerByRepoByPullNumber: files,
+				PostReposPullsReviewsByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"body":  "A few suggestions",
+					"event": "REQUEST_CHANGES",
+					"comments": []any{
+						map[string]any{"path": "main.go", "line": float64(12), "side": "RIGHT", "body": "Name this"},
+						map[string]any{"path": "main.go", "line": float64(11), "side": "LEFT", "body": "Keep this?"},
+					},
+				}).andThen(mockResponse(t, http.StatusOK, &github.PullRequestReview{
+					ID:      github.Ptr(int64(80)),
+					State:   github.Ptr("CHANGES_REQUESTED"),
+					HTMLURL: github.Ptr("https://github.com/owner/repo/pull/1#pullrequestreview-80"),
+				})),
+			},
+			comments: []any{
+				map[string]any{"path": "main.go", "line": float64(12), "body": "Name this"},
+				map[string]any{"path": "main.go", "line": float64(11), "side": "LEFT", "body": "Keep this?"},
+			},
+		},
+		{
+			name: "rejects comments outside the diff",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsFilesByOwnerByRepoByPullNumber: files,
+			},
+			comments: []any{
+				map[string]any{"path": "main.go", "line": float64(40), "body": "Out of range"},
+				map[string]any{"path": "other.go", "line": float64(1), "body": "Unchanged file"},
+				map[string]any{"path": "logo.png", "line": float64(1), "body": "Binary"},
+			},
+			expectToolError: true,
+			expectedErrMsg: "comment 1: line 40 of main.go is not in the diff on the RIGHT side; lines that can be commented on: 10-13\n" +
+				"comment 2: other.go is not changed by the pull request\n" +
+				"comment 3: logo.png has no text diff to comment on",
+		},
+		{
+			name: "reports API errors",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsFilesByOwnerByRepoByPullNumber:    files,
+				PostReposPullsReviewsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+			},
+			comments: []any{
+				map[string]any{"path": "main.go", "line": float64(10), "body": "Context line"},
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to create pull request review",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(1),
+				"body":       "A few suggestions",
+				"event":      "REQUEST_CHANGES",
+				"comments":   tc.comments,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var review MinimalPullRequestReview
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &review))
+			assert.Equal(t, int64(80), review.ID)
+			assert.Equal(t, "CHANGES_REQUESTED", review.State)
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// reviewThreadsPerPage is the number of review threads requested per GraphQL page.
+	reviewThreadsPerPage = 100
+	// maxReviewThreadPages bounds how many pages of review threads are read.
+	maxReviewThreadPages = 10
+)
+
+// reviewThreadListQuery reads the review threads of a pull request together
+// with the code location each thread is attached to.
+type reviewThreadListQuery struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				Nodes      []reviewThreadLocationNode
+				PageInfo   pageInfoFragment
+				TotalCount githubv4.Int
+			} `graphql:"reviewThreads(first: $first, after: $after)"`
+		} `graphql:"pullRequest(number: $prNum)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+type reviewThreadLocationNode struct {
+	ID         githubv4.ID
+	Path       githubv4.String
+	Line       *githubv4.Int
+	StartLine  *githubv4.Int
+	IsResolved githubv4.Boolean
+	IsOutdated githubv4.Boolean
+	ResolvedBy *struct {
+		Login githubv4.String
+	}
+	Comments struct {
+		Nodes      []reviewCommentNode
+		TotalCount githubv4.Int
+	} `graphql:"comments(first: $commentsPerThread)"`
+}
+
+// PullRequestReviewThread is a review thread on a pull request and its comments.
+type PullRequestReviewThread struct {
+	ID   string `json:"id"`
+	Path string `json:"path"`
+	// Line is the line the thread is attached to, and StartLine the first line
+	// of a multi-line thread. Line is omitted for outdated threads whose line
+	// no longer exists in the diff.
+	Line          *int                   `json:"line,omitempty"`
+	StartLine     *int                   `json:"start_line,omitempty"`
+	IsResolved    bool                   `json:"is_resolved"`
+	IsOutdated    bool                   `json:"is_outdated"`
+	ResolvedBy    string                 `json:"resolved_by,omitempty"`
+	Comments      []MinimalReviewComment `json:"comments"`
+	TotalComments int                    `json:"total_comments"`
+}
+
+// PullRequestReviewThreadsResult lists the review threads of a pull request.
+type PullRequestReviewThreadsResult struct {
+	Threads         []PullRequestReviewThread `json:"threads"`
+	TotalCount      int                       `json:"total_count"`
+	ResolvedCount   int                       `json:"resolved_count"`
+	UnresolvedCount int                       `json:"unresolved_count"`
+	// AllResolved is true when every thread on the pull request is resolved.
+	AllResolved bool `json:"all_resolved"`
+	// Truncated is true when the pull request has more threads than were read;
+	// the counts then only cover the threads that were read.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ListPullRequestReviewThreads creates a tool to list the review threads of a pull request with their resolution state.
+func ListPullRequestReviewThreads(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "list_pull_request_review_threads",
+			Description: t("TOOL_LIST_PULL_REQUEST_REVIEW_THREADS_DESCRIPTION",
+				"List the review threads on a pull request with the file and line each is attached to, whether it is resolved or outdated, and its comments. "+
+					"The result counts resolved and unresolved threads, so use this to check whether all review comments are resolved before merging."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_PULL_REQUEST_REVIEW_THREADS_USER_TITLE", "List pull request review threads"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+					"include_resolved": {
+						Type:        "boolean",
+						Description: "Whether to include resolved threads in the list. Resolved threads are always counted.",
+						Default:     json.RawMessage(`true`),
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeResolved, err := OptionalBoolParamWithDefault(args, "include_resolved", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GQL client", err), nil, nil
+			}
+			if gqlClient == nil {
+				return utils.NewToolResultError("listing review threads requires the GitHub GraphQL API, which is not configured"), nil, nil
+			}
+
+			threads, totalCount, truncated, errResult := listPullRequestReviewThreads(ctx, gqlClient, owner, repo, pullNumber)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+
+			if deps.GetFlags(ctx).LockdownMode {
+				cache, err := deps.GetRepoAccessCache(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+				}
+				if cache == nil {
+					return nil, nil, fmt.Errorf("lockdown cache is not configured")
+				}
+				for i := range threads {
+					filtered, err := filterLockdownReviewComments(ctx, cache, owner, repo, threads[i].Comments.Nodes)
+					if err != nil {
+						return nil, nil, err
+					}
+					threads[i].Comments.Nodes = filtered
+					threads[i].Comments.TotalCount = githubv4.Int(int32(len(filtered))) //nolint:gosec // comment count is bounded by API limits
+				}
+			}
+
+			result := PullRequestReviewThreadsResult{
+				Threads:    []PullRequestReviewThread{},
+				TotalCount: totalCount,
+				Truncated:  truncated,
+			}
+			for _, thread := range threads {
+				if thread.IsResolved {
+					result.ResolvedCount++
+					if !includeResolved {
+						continue
+					}
+				} else {
+					result.UnresolvedCount++
+				}
+				result.Threads = append(result.Threads, convertToPullRequestReviewThread(thread))
+			}
+			result.AllResolved = result.UnresolvedCount == 0 && !truncated
+
+			return attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, MarshalledTextResult(result), ifc.LabelRepoUserContent), nil, nil
+		},
+	)
+}
+
+// listPullRequestReviewThreads reads up to maxReviewThreadPages pages of review
+// threads. It returns the threads read, the total number of threads on the pull
+// request and whether more remain.
+func listPullRequestReviewThreads(ctx context.Context, gqlClient *githubv4.Client, owner, repo string, pullNumber int) ([]reviewThreadLocationNode, int, bool, *mcp.CallToolResult) {
+	vars := map[string]any{
+		"owner":             githubv4.String(owner),
+		"repo":              githubv4.String(repo),
+		"prNum":             githubv4.Int(int32(pullNumber)), //nolint:gosec // pullNumber is controlled by user input validation
+		"first":             githubv4.Int(reviewThreadsPerPage),
+		"commentsPerThread": githubv4.Int(100),
+		"after":             (*githubv4.String)(nil),
+	}
+
+	var threads []reviewThreadLocationNode
+	var totalCount int
+	for range maxReviewThreadPages {
+		var query reviewThreadListQuery
+		if err := gqlClient.Query(ctx, &query, vars); err != nil {
+			return nil, 0, false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request review threads", err)
+		}
+		page := query.Repository.PullRequest.ReviewThreads
+		threads = append(threads, page.Nodes...)
+		totalCount = int(page.TotalCount)
+		if !page.PageInfo.HasNextPage {
+			return threads, totalCount, false, nil
+		}
+		vars["after"] = githubv4.NewString(page.PageInfo.EndCursor)
+	}
+	return threads, totalCount, true, nil
+}
+
+func convertToPullRequestReviewThread(thread reviewThreadLocationNode) PullRequestReviewThread {
+	comments := make([]MinimalReviewComment, 0, len(thread.Comments.Nodes))
+	for _, c := range thread.Comments.Nodes {
+		comments = append(comments, convertToMinimalReviewComment(c))
+	}
+
+	result := PullRequestReviewThread{
+		ID:            fmt.Sprintf("%v", thread.ID),
+		Path:          string(thread.Path),
+		IsResolved:    bool(thread.IsResolved),
+		IsOutdated:    bool(thread.IsOutdated),
+		Comments:      comments,
+		TotalComments: int(thread.Comments.TotalCount),
+	}
+	if thread.Line != nil {
+		line := int(*thread.Line)
+		result.Line = &line
+	}
+	if thread.StartLine != nil {
+		startLine := int(*thread.StartLine)
+		result.StartLine = &startLine
+	}
+	if thread.ResolvedBy != nil {
+		result.ResolvedBy = string(thread.ResolvedBy.Login)
+	}
+	return result
+}
+
+// reviewThreadIDFromArgs returns the review thread named by the threadID
+// argument or, without one, finds the thread on a pull request by file path
+// and line. When several threads share the location, the one whose
+// resolution would change is chosen.
+func reviewThreadIDFromArgs(ctx context.Context, gqlClient *githubv4.Client, args map[string]any, resolve bool) (string, *mcp.CallToolResult) {
+	threadID, err := OptionalParam[string](args, "threadID")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	if threadID != "" {
+		return threadID, nil
+	}
+
+	owner, err := OptionalParam[string](args, "owner")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	repo, err := OptionalParam[string](args, "repo")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	pullNumber, err := OptionalIntParam(args, "pullNumber")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	path, err := OptionalParam[string](args, "path")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	line, err := OptionalIntParam(args, "line")
+	if err != nil {
+		return "", utils.NewToolResultError(err.Error())
+	}
+	if owner == "" || repo == "" || pullNumber == 0 || path == "" || line == 0 {
+		return "", utils.NewToolResultError("either threadID or all of owner, repo, pullNumber, path and line are required")
+	}
+
+	threads, _, _, errResult := listPullRequestReviewThreads(ctx, gqlClient, owner, repo, pullNumber)
+	if errResult != nil {
+		return "", errResult
+	}
+
+	var atLocation, toChange []string
+	for _, thread := range threads {
+		if string(thread.Path) != path || thread.Line == nil || int(*thread.Line) != line {
+			continue
+		}
+		id := fmt.Sprintf("%v", thread.ID)
+		atLocation = append(atLocation, id)
+		if bool(thread.IsResolved) != resolve {
+			toChange = append(toChange, id)
+		}
+	}
+
+	switch {
+	case len(atLocation) == 0:
+		return "", utils.NewToolResultError(fmt.Sprintf("no review thread found on %s line %d of pull request #%d", path, line, pullNumber))
+	case len(toChange) == 1:
+		return toChange[0], nil
+	case len(toChange) == 0:
+		// Every thread there is already in the requested state, which makes
+		// the mutation a no-op.
+		return atLocation[0], nil
+	default:
+		return "", utils.NewToolResultError(fmt.Sprintf("found %d review threads on %s line %d; pass threadID with one of: %s",
+			len(toChange), path, line, strings.Join(toChange, ", ")))
+	}
+}
+
+// reviewThreadTargetProperties returns the input properties that identify a
+// review thread for resolve_review_thread and unresolve_review_thread.
+func reviewThreadTargetProperties(action string) map[string]*jsonschema.Schema {
+	return map[string]*jsonschema.Schema{
+		"threadID": {
+			Type:        "string",
+			Description: fmt.Sprintf("The node ID of the review thread to %s (e.g., PRRT_kwDOxxx). Alternatively, identify the thread with owner, repo, pullNumber, path and line.", action),
+		},
+		"owner": {
+			Type:        "string",
+			Description: DescriptionRepositoryOwner,
+		},
+		"repo": {
+			Type:        "string",
+			Description: DescriptionRepositoryName,
+		},
+		"pullNumber": {
+			Type:        "number",
+			Description: "Pull request number",
+		},
+		"path": {
+			Type:        "string",
+			Description: "Path of the file the review thread is on, relative to the repository root",
+		},
+		"line": {
+			Type:        "number",
+			Description: "Line of the file the review thread is attached to",
+		},
+	}
+}
@@ -0,0 +1,309 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListPullRequestReviewThreads(t *testing.T) {
+	serverTool := ListPullRequestReviewThreads(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_review_threads", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "include_resolved")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pageVars := func(after *githubv4.String) map[string]any {
+		return map[string]any{
+			"owner":             githubv4.String("owner"),
+			"repo":              githubv4.String("repo"),
+			"prNum":             githubv4.Int(42),
+			"first":             githubv4.Int(100),
+			"commentsPerThread": githubv4.Int(100),
+			"after":             after,
+		}
+	}
+	secondPage := githubv4mock.NewQueryMatcher(
+		reviewThreadListQuery{},
+		pageVars(githubv4.NewString("cursor1")),
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"pullRequest": map[string]any{
+					"reviewThreads": map[string]any{
+						"nodes": []map[string]any{
+							{
+								"id":         "RT_2",
+								"path":       "util.go",
+								"line":       nil,
+								"startLine":  nil,
+								"isResolved": false,
+								"isOutdated": true,
+								"resolvedBy": nil,
+								"comments": map[string]any{
+									"totalCount": 1,
+									"nodes": []map[string]any{
+										{
+											"id":        "PRRC_2",
+											"body":      "This leaks a goroutine",
+											"path":      "util.go",
+											"author":    map[string]any{"login": "reviewer"},
+											"createdAt": "2024-01-02T12:00:00Z",
+											"updatedAt": "2024-01-02T12:00:00Z",
+											"url":       "https://github.com/owner/repo/pull/42#discussion_r2",
+										},
+									},
+								},
+							},
+						},
+						"pageInfo": map[string]any{
+							"hasNextPage": false,
+							"endCursor":   "cursor2",
+						},
+						"totalCount": 2,
+					},
+				},
+			},
+		}),
+	)
+	// The query is rendered from the pointer type the tool sends, but the mock
+	// compares the decoded variable by value.
+	secondPage.Variables["after"] = githubv4.String("cursor1")
+	threadPages := cursorRoutedTransport{
+		"": githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+			reviewThreadListQuery{},
+			pageVars(nil),
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"pullRequest": map[string]any{
+						"reviewThreads": map[string]any{
+							"nodes": []map[string]any{
+								{
+									"id":         "RT_1",
+									"path":       "main.go",
+									"line":       12,
+									"startLine":  10,
+									"isResolved": true,
+									"isOutdated": false,
+									"resolvedBy": map[string]any{"login": "author"},
+									"comments": map[string]any{
+										"totalCount": 1,
+										"nodes": []map[string]any{
+											{
+												"id":        "PRRC_1",
+												"body":      "Please rename this",
+												"path":      "main.go",
+												"line":      12,
+												"author":    map[string]any{"login": "reviewer"},
+												"createdAt": "2024-01-01T12:00:00Z",
+												"updatedAt": "2024-01-01T12:00:00Z",
+												"url":       "https://github.com/owner/repo/pull/42#discussion_r1",
+											},
+										},
+									},
+								},
+							},
+							"pageInfo": map[string]any{
+								"hasNextPage": true,
+								"endCursor":   "cursor1",
+							},
+							"totalCount": 2,
+						},
+					},
+				},
+			}),
+		)),
+		"cursor1": githubv4mock.NewMockedHTTPClient(secondPage),
+	}
+
+	tests := []struct {
+		name            string
+		gqlHTTPClient   *http.Client
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		validateResult  func(t *testing.T, result PullRequestReviewThreadsResult)
+	}{
+		{
+			name:          "lists threads across pages",
+			gqlHTTPClient: &http.Client{Transport: threadPages},
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			validateResult: func(t *testing.T, result PullRequestReviewThreadsResult) {
+				assert.Equal(t, 2, result.TotalCount)
+				assert.Equal(t, 1, result.ResolvedCount)
+				assert.Equal(t, 1, result.UnresolvedCount)
+				assert.False(t, result.AllResolved)
+				assert.False(t, result.Truncated)
+				require.Len(t, result.Threads, 2)
+
+				resolved := result.Threads[0]
+				assert.Equal(t, "RT_1", resolved.ID)
+				assert.Equal(t, "main.go", resolved.Path)
+				require.NotNil(t, resolved.Line)
+				assert.Equal(t, 12, *resolved.Line)
+				require.NotNil(t, resolved.StartLine)
+				assert.Equal(t, 10, *resolved.StartLine)
+				assert.True(t, resolved.IsResolved)
+				assert.Equal(t, "author", resolved.ResolvedBy)
+				require.Len(t, resolved.Comments, 1)
+				assert.Equal(t, "Please rename this", resolved.Comments[0].Body)
+				assert.Equal(t, "reviewer", resolved.Comments[0].Author)
+
+				outdated := result.Threads[1]
+				assert.Equal(t, "util.go", outdated.Path)
+				assert.Nil(t, outdated.Line)
+				assert.True(t, outdated.IsOutdated)
+				assert.False(t, outdated.IsResolved)
+				assert.Empty(t, outdated.ResolvedBy)
+			},
+		},
+		{
+			name:          "excludes resolved threads but still counts them",
+			gqlHTTPClient: &http.Client{Transport: threadPages},
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"pullNumber":       float64(42),
+				"include_resolved": false,
+			},
+			validateResult: func(t *testing.T, result PullRequestReviewThreadsResult) {
+				assert.Equal(t, 1, result.ResolvedCount)
+				assert.Equal(t, 1, result.UnresolvedCount)
+				require.Len(t, result.Threads, 1)
+				assert.Equal(t, "RT_2", result.Threads[0].ID)
+			},
+		},
+		{
+			name: "all threads resolved",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					reviewThreadListQuery{},
+					pageVars(nil),
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"reviewThreads": map[string]any{
+									"nodes":      []map[string]any{},
+									"pageInfo":   map[string]any{"hasNextPage": false},
+									"totalCount": 0,
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			validateResult: func(t *testing.T, result PullRequestReviewThreadsResult) {
+				assert.Equal(t, 0, result.TotalCount)
+				assert.True(t, result.AllResolved)
+				assert.Empty(t, result.Threads)
+			},
+		},
+		{
+			name: "GraphQL error",
+			gqlHTTPClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					reviewThreadListQuery{},
+					pageVars(nil),
+					githubv4mock.ErrorResponse("Could not resolve to a PullRequest with the number of 42."),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get pull request review threads",
+		},
+		{
+			name: "GraphQL client not configured",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "requires the GitHub GraphQL API",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{}
+			if tc.gqlHTTPClient != nil {
+				deps.GQLClient = githubv4.NewClient(tc.gqlHTTPClient)
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response PullRequestReviewThreadsResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			tc.validateResult(t, response)
+		})
+	}
+}
+
+// cursorRoutedTransport sends each GraphQL request to the mocked client for its
+// "after" cursor. githubv4mock matches requests on the query alone, and every
+// page of a paginated query is the same query.
+type cursorRoutedTransport map[string]*http.Client
+
+func (c cursorRoutedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	var payload struct {
+		Variables struct {
+			After *string `json:"after"`
+		} `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	cursor := ""
+	if payload.Variables.After != nil {
+		cursor = *payload.Variables.After
+	}
+	client, ok := c[cursor]
+	if !ok {
+		return nil, fmt.Errorf("no mocked page for cursor %q", cursor)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return client.Transport.RoundTrip(req)
+}
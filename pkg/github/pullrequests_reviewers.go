@@ -0,0 +1,299 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// PullRequestReviewers lists the reviewers requested on a pull request.
+type PullRequestReviewers struct {
+	PullNumber int    `json:"pull_number"`
+	URL        string `json:"url,omitempty"`
+	// Users are the logins, and Teams the team slugs, whose review is requested.
+	Users []string `json:"requested_reviewers"`
+	Teams []string `json:"requested_teams"`
+}
+
+// reviewersToolSchema is the input schema shared by request_reviewers and remove_requested_reviewers.
+func reviewersToolSchema(action string) *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: DescriptionRepositoryOwner,
+			},
+			"repo": {
+				Type:        "string",
+				Description: DescriptionRepositoryName,
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+			"reviewers": {
+				Type:        "array",
+				Description: fmt.Sprintf("Logins of the users to %s", action),
+				Items:       &jsonschema.Schema{Type: "string"},
+			},
+			"team_reviewers": {
+				Type:        "array",
+				Description: fmt.Sprintf("Slugs of the teams to %s. Teams must belong to the organization that owns the repository", action),
+				Items:       &jsonschema.Schema{Type: "string"},
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+}
+
+// reviewersParams holds the arguments shared by request_reviewers and remove_requested_reviewers.
+type reviewersParams struct {
+	owner      string
+	repo       string
+	pullNumber int
+	users      []string
+	teams      []string
+}
+
+func parseReviewersParams(args map[string]any) (reviewersParams, error) {
+	var params reviewersParams
+	var err error
+	if params.owner, err = RequiredParam[string](args, "owner"); err != nil {
+		return params, err
+	}
+	if params.repo, err = RequiredParam[string](args, "repo"); err != nil {
+		return params, err
+	}
+	if params.pullNumber, err = RequiredInt(args, "pullNumber"); err != nil {
+		return params, err
+	}
+	if params.users, err = OptionalStringArrayParam(args, "reviewers"); err != nil {
+		return params, err
+	}
+	teams, err := OptionalStringArrayParam(args, "team_reviewers")
+	if err != nil {
+		return params, err
+	}
+	for _, team := range teams {
+		// Accept "org/team-slug" as well as a bare slug.
+		if org, slug, ok := strings.Cut(team, "/"); ok {
+			if !strings.EqualFold(org, params.owner) {
+				return params, fmt.Errorf("team %s does not belong to %s, which owns the repository", team, params.owner)
+			}
+			team = slug
+		}
+		params.teams = append(params.teams, team)
+	}
+	if len(params.users) == 0 && len(params.teams) == 0 {
+		return params, fmt.Errorf("at least one of reviewers or team_reviewers is required")
+	}
+	return params, nil
+}
+
+// RequestReviewers creates a tool to request reviews on a pull request from users and teams.
+func RequestReviewers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "request_reviewers",
+			Description: t("TOOL_REQUEST_REVIEWERS_DESCRIPTION",
+				"Request reviews on a pull request from users and teams. Users must have access to the repository and cannot be the pull request's author; "+
+					"teams must have access to the repository. Invalid reviewers are reported before anything is requested."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_REQUEST_REVIEWERS_USER_TITLE", "Request pull request reviewers"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: reviewersToolSchema("request reviews from"),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			params, err := parseReviewersParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			problems, errResult := validateRequestedReviewers(ctx, client, params)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			if len(problems) > 0 {
+				return utils.NewToolResultError("no reviewers were requested:\n" + strings.Join(problems, "\n")), nil, nil
+			}
+
+			pr, resp, err := client.PullRequests.RequestReviewers(ctx, params.owner, params.repo, params.pullNumber, github.ReviewersRequest{
+				Reviewers:     params.users,
+				TeamReviewers: params.teams,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"GitHub could not request one of the reviewers; check that each user can access the repository and is not the pull request's author, and that each team can access the repository",
+						resp, err), nil, nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to request reviewers", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := PullRequestReviewers{
+				PullNumber: params.pullNumber,
+				URL:        pr.GetHTMLURL(),
+				Users:      []string{},
+				Teams:      []string{},
+			}
+			for _, user := range pr.RequestedReviewers {
+				result.Users = append(result.Users, user.GetLogin())
+			}
+			for _, team := range pr.RequestedTeams {
+				result.Teams = append(result.Teams, team.GetSlug())
+			}
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
+
+// validateRequestedReviewers checks that the requested users can access the
+// repository and did not author the pull request, and that the requested
+// teams can access the repository. It returns one problem per invalid
+// reviewer. When the caller may not check collaborators, users are left for
+// the API to validate.
+func validateRequestedReviewers(ctx context.Context, client *github.Client, params reviewersParams) ([]string, *mcp.CallToolResult) {
+	var problems []string
+
+	if len(params.users) > 0 {
+		pr, resp, err := client.PullRequests.Get(ctx, params.owner, params.repo, params.pullNumber)
+		if err != nil {
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err)
+		}
+		_ = resp.Body.Close()
+		author := pr.GetUser().GetLogin()
+
+		for _, user := range params.users {
+			if strings.EqualFold(user, author) {
+				problems = append(problems, fmt.Sprintf("%s is the pull request's author and cannot review it", user))
+				continue
+			}
+			isCollaborator, resp, err := client.Repositories.IsCollaborator(ctx, params.owner, params.repo, user)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					break
+				}
+				return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to check repository collaborators", resp, err)
+			}
+			_ = resp.Body.Close()
+			if !isCollaborator {
+				problems = append(problems, fmt.Sprintf("%s does not exist or cannot access %s/%s", user, params.owner, params.repo))
+			}
+		}
+	}
+
+	for _, team := range params.teams {
+		_, resp, err := client.Teams.IsTeamRepoBySlug(ctx, params.owner, team, params.owner, params.repo)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				problems = append(problems, fmt.Sprintf("team %s does not exist in %s or cannot access %s/%s", team, params.owner, params.owner, params.repo))
+				continue
+			}
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to check team repository access", resp, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	return problems, nil
+}
+
+// RemoveRequestedReviewers creates a tool to withdraw review requests from users and teams.
+func RemoveRequestedReviewers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name: "remove_requested_reviewers",
+			Description: t("TOOL_REMOVE_REQUESTED_REVIEWERS_DESCRIPTION",
+				"Withdraw review requests on a pull request from users and teams. Only pending review requests can be removed; reviews already submitted are kept."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_REMOVE_REQUESTED_REVIEWERS_USER_TITLE", "Remove requested pull request reviewers"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(false),
+				OpenWorldHint:   jsonschema.Ptr(true),
+			},
+			InputSchema: reviewersToolSchema("withdraw review requests from"),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			params, err := parseReviewersParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			current, resp, err := client.PullRequests.ListReviewers(ctx, params.owner, params.repo, params.pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list requested reviewers", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := PullRequestReviewers{
+				PullNumber: params.pullNumber,
+				Users:      []string{},
+				Teams:      []string{},
+			}
+			var problems []string
+			for _, user := range params.users {
+				if !slices.ContainsFunc(current.Users, func(u *github.User) bool { return strings.EqualFold(u.GetLogin(), user) }) {
+					problems = append(problems, fmt.Sprintf("%s has no pending review request", user))
+				}
+			}
+			for _, team := range params.teams {
+				if !slices.ContainsFunc(current.Teams, func(t *github.Team) bool { return strings.EqualFold(t.GetSlug(), team) }) {
+					problems = append(problems, fmt.Sprintf("team %s has no pending review request", team))
+				}
+			}
+			if len(problems) > 0 {
+				return utils.NewToolResultError("no review requests were removed:\n" + strings.Join(problems, "\n")), nil, nil
+			}
+
+			resp, err = client.PullRequests.RemoveReviewers(ctx, params.owner, params.repo, params.pullNumber, github.ReviewersRequest{
+				Reviewers:     params.users,
+				TeamReviewers: params.teams,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to remove requested reviewers", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			for _, user := range current.Users {
+				if !slices.ContainsFunc(params.users, func(login string) bool { return strings.EqualFold(login, user.GetLogin()) }) {
+					result.Users = append(result.Users, user.GetLogin())
+				}
+			}
+			for _, team := range current.Teams {
+				if !slices.ContainsFunc(params.teams, func(slug string) bool { return strings.EqualFold(slug, team.GetSlug()) }) {
+					result.Teams = append(result.Teams, team.GetSlug())
+				}
+			}
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
@@ -0,0 +1,249 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequestReviewers(t *testing.T) {
+	serverTool := RequestReviewers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "request_reviewers", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "reviewers")
+	assert.Contains(t, schema.Properties, "team_reviewers")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pullRequest := mockResponse(t, http.StatusOK, &github.PullRequest{
+		Number: github.Ptr(42),
+		User:   &github.User{Login: github.Ptr("author")},
+	})
+	collaborator := func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/stranger") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedResult  PullRequestReviewers
+	}{
+		{
+			name: "requests users and teams",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:        pullRequest,
+				GetReposCollaboratorsByOwnerByRepoByUsername:  collaborator,
+				GetOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo: mockResponse(t, http.StatusOK, &github.Repository{Name: github.Ptr("repo")}),
+				PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"reviewers":      []any{"reviewer"},
+					"team_reviewers": []any{"core"},
+				}).andThen(mockResponse(t, http.StatusCreated, &github.PullRequest{
+					Number:             github.Ptr(42),
+					HTMLURL:            github.Ptr("https://github.com/owner/repo/pull/42"),
+					RequestedReviewers: []*github.User{{Login: github.Ptr("reviewer")}},
+					RequestedTeams:     []*github.Team{{Slug: github.Ptr("core")}},
+				})),
+			},
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"reviewers":      []any{"reviewer"},
+				"team_reviewers": []any{"owner/core"},
+			},
+			expectedResult: PullRequestReviewers{
+				PullNumber: 42,
+				URL:        "https://github.com/owner/repo/pull/42",
+				Users:      []string{"reviewer"},
+				Teams:      []string{"core"},
+			},
+		},
+		{
+			name: "reports every invalid reviewer without requesting any",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber:        pullRequest,
+				GetReposCollaboratorsByOwnerByRepoByUsername:  collaborator,
+				GetOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"reviewers":      []any{"author", "stranger", "reviewer"},
+				"team_reviewers": []any{"ghosts"},
+			},
+			expectToolError: true,
+			expectedErrMsg: "no reviewers were requested:\n" +
+				"author is the pull request's author and cannot review it\n" +
+				"stranger does not exist or cannot access owner/repo\n" +
+				"team ghosts does not exist in owner or cannot access owner/repo",
+		},
+		{
+			name: "explains a rejected request",
+			handlers: map[string]http.HandlerFunc{
+				GetOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo:             mockResponse(t, http.StatusOK, &github.Repository{Name: github.Ptr("repo")}),
+				PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Reviews may only be requested from collaborators."}`),
+			},
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"team_reviewers": []any{"core"},
+			},
+			expectToolError: true,
+			expectedErrMsg:  "GitHub could not request one of the reviewers",
+		},
+		{
+			name: "rejects a team from another organization",
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"team_reviewers": []any{"other/core"},
+			},
+			expectToolError: true,
+			expectedErrMsg:  "team other/core does not belong to owner",
+		},
+		{
+			name: "requires a reviewer",
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "at least one of reviewers or team_reviewers is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response PullRequestReviewers
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
+
+func Test_RemoveRequestedReviewers(t *testing.T) {
+	serverTool := RemoveRequestedReviewers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "remove_requested_reviewers", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+
+	requested := mockResponse(t, http.StatusOK, &github.Reviewers{
+		Users: []*github.User{{Login: github.Ptr("alice")}, {Login: github.Ptr("bob")}},
+		Teams: []*github.Team{{Slug: github.Ptr("core")}},
+	})
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedResult  PullRequestReviewers
+	}{
+		{
+			name: "removes pending requests",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber: requested,
+				DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"reviewers":      []any{"Alice"},
+					"team_reviewers": []any{"core"},
+				}).andThen(mockResponse(t, http.StatusOK, &github.PullRequest{Number: github.Ptr(42)})),
+			},
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"reviewers":      []any{"Alice"},
+				"team_reviewers": []any{"core"},
+			},
+			expectedResult: PullRequestReviewers{
+				PullNumber: 42,
+				Users:      []string{"bob"},
+				Teams:      []string{},
+			},
+		},
+		{
+			name: "reports reviewers without a pending request",
+			handlers: map[string]http.HandlerFunc{
+				GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber: requested,
+			},
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"reviewers":      []any{"carol"},
+				"team_reviewers": []any{"docs"},
+			},
+			expectToolError: true,
+			expectedErrMsg: "no review requests were removed:\n" +
+				"carol has no pending review request\n" +
+				"team docs has no pending review request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response PullRequestReviewers
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
@@ -54,14 +54,15 @@ func Test_GetPullRequest(t *testing.T) {
 	}
 
 	tests := []struct {
-		name            string
-		mockedClient    *http.Client
-		requestArgs     map[string]any
-		expectError     bool
-		expectedPR      *github.PullRequest
-		expectedErrMsg  string
-		lockdownEnabled bool
-		restPermission  string
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]any
+		expectError       bool
+		expectedPR        *github.PullRequest
+		expectedReactions *MinimalReactions
+		expectedErrMsg    string
+		lockdownEnabled   bool
+		restPermission    string
 	}{
 		{
 			name: "successful PR fetch",
@@ -77,6 +78,29 @@ func Test_GetPullRequest(t *testing.T) {
 			expectError: false,
 			expectedPR:  mockPR,
 		},
+		{
+			name: "PR fetch with reactions",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, mockPR),
+				GetReposIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, &github.Issue{
+					Number: github.Ptr(42),
+					Reactions: &github.Reactions{
+						TotalCount: github.Ptr(5),
+						PlusOne:    github.Ptr(3),
+						Heart:      github.Ptr(2),
+					},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":            "get",
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"include_reactions": true,
+			},
+			expectedPR:        mockPR,
+			expectedReactions: &MinimalReactions{TotalCount: 5, PlusOne: 3, Heart: 2},
+		},
 		{
 			name: "PR fetch fails",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -176,6 +200,75 @@ func Test_GetPullRequest(t *testing.T) {
 			assert.Equal(t, tc.expectedPR.GetTitle(), returnedPR.Title)
 			assert.Equal(t, tc.expectedPR.GetState(), returnedPR.State)
 			assert.Equal(t, tc.expectedPR.GetHTMLURL(), returnedPR.HTMLURL)
+			assert.Equal(t, tc.expectedReactions, returnedPR.Reactions)
+		})
+	}
+}
+
+func Test_PullRequestRead_Fields(t *testing.T) {
+	serverTool := PullRequestRead(translations.NullTranslationHelper)
+
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Test PR"),
+		Body:    github.Ptr("A long description"),
+		State:   github.Ptr("open"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+		User:    &github.User{Login: github.Ptr("testuser")},
+		Head:    &github.PullRequestBranch{Ref: github.Ptr("feature"), SHA: github.Ptr("abc123")},
+		Base:    &github.PullRequestBranch{Ref: github.Ptr("main"), SHA: github.Ptr("def456")},
+	}
+	const stubbedDiff = "diff --git a/README.md b/README.md\n+new line\n"
+
+	tests := []struct {
+		name     string
+		method   string
+		handler  http.HandlerFunc
+		expected string
+		isJSON   bool
+	}{
+		{
+			name:     "get projects the pull request",
+			method:   "get",
+			handler:  mockResponse(t, http.StatusOK, mockPR),
+			expected: `{"number": 42, "head": {"ref": "feature"}}`,
+			isJSON:   true,
+		},
+		{
+			name:     "get_diff ignores fields",
+			method:   "get_diff",
+			handler:  mockResponse(t, http.StatusOK, stubbedDiff),
+			expected: stubbedDiff,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetReposPullsByOwnerByRepoByPullNumber: tc.handler,
+				})),
+				Flags: stubFeatureFlags(nil),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"method":     tc.method,
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"fields":     []any{"number", "head.ref"},
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			if tc.isJSON {
+				assert.JSONEq(t, tc.expected, textContent.Text)
+				return
+			}
+			assert.Equal(t, tc.expected, textContent.Text)
 		})
 	}
 }
@@ -1155,6 +1248,34 @@ func Test_SearchPullRequests(t *testing.T) {
 
 }
 
+func Test_SearchPullRequests_GroupByRepo(t *testing.T) {
+	serverTool := SearchPullRequests(translations.NullTranslationHelper)
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetSearchIssues: mockResponse(t, http.StatusOK, mockGroupedSearchResult()),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"query":         "fix",
+		"group_by_repo": true,
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response struct {
+		Repositories []SearchRepoGroup[*github.Issue] `json:"repositories"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Repositories, 2)
+	assert.Equal(t, "owner/alpha", response.Repositories[0].Repository)
+	assert.Equal(t, 2, response.Repositories[0].Count)
+	assert.Equal(t, []int{1, 3}, []int{response.Repositories[0].Items[0].GetNumber(), response.Repositories[0].Items[1].GetNumber()})
+	assert.Equal(t, "owner/beta", response.Repositories[1].Repository)
+	assert.Equal(t, 1, response.Repositories[1].Count)
+}
+
 func Test_GetPullRequestFiles(t *testing.T) {
 	// Verify tool definition once
 	serverTool := PullRequestRead(translations.NullTranslationHelper)
@@ -1951,6 +2072,40 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to update pull request branch",
 		},
+		{
+			name: "head moved since expected SHA",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutReposPullsUpdateBranchByOwnerByRepoByPullNumber: mockResponse(t, http.StatusUnprocessableEntity,
+					`{"message": "expected head sha didn't match current head ref."}`),
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, &github.PullRequest{
+					Number: github.Ptr(42),
+					Head:   &github.PullRequestBranch{SHA: github.Ptr("ef567890")},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"expectedHeadSha": "abcd1234",
+			},
+			expectError:    true,
+			expectedErrMsg: "pull request branch was not updated because its head moved from abcd1234 to ef567890",
+		},
+		{
+			name: "head moved and current head unavailable",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutReposPullsUpdateBranchByOwnerByRepoByPullNumber: mockResponse(t, http.StatusUnprocessableEntity,
+					`{"message": "expected head sha didn't match current head ref."}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"expectedHeadSha": "abcd1234",
+			},
+			expectError:    true,
+			expectedErrMsg: "pull request branch was not updated because its head is no longer abcd1234",
+		},
 	}
 
 	for _, tc := range tests {
@@ -4,17 +4,21 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/octicons"
+	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/scopes"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
@@ -81,7 +85,7 @@ func GetCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -132,6 +136,80 @@ func GetCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+// GetCombinedStatus creates a tool to get the combined commit status for a ref.
+func GetCombinedStatus(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "get_combined_status",
+			Description: t("TOOL_GET_COMBINED_STATUS_DESCRIPTION",
+				"Get the combined commit status for a SHA, branch or tag. Returns the overall state (success, pending or failure) and the latest status reported by each context. "+
+					"This covers the legacy commit status API used by many external CI systems; GitHub Actions and other GitHub Apps report check runs instead, so consult both to know whether a commit is green."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_COMBINED_STATUS_USER_TITLE", "Get combined commit status"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Commit SHA, branch name, or tag name",
+					},
+				},
+				Required: []string{"owner", "repo", "ref"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := RequiredParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get combined status for %s", ref),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(convertToMinimalCombinedStatus(status)), nil, nil
+		},
+	)
+}
+
 // ListCommits creates a tool to get the list of commits of a branch in a GitHub
 // repository. It is the FeatureFlagFieldsParam-enabled variant: it advertises
 // the optional `fields` parameter and filters each commit to the requested
@@ -250,7 +328,7 @@ func listCommitsTool(t translations.TranslationHelperFunc, includeFields bool) i
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -377,7 +455,7 @@ func ListBranches(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -736,6 +814,168 @@ func CreateRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 	)
 }
 
+// UpdatedRepository is the output of update_repository.
+type UpdatedRepository struct {
+	MinimalRepository
+	Homepage   string `json:"homepage,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+// UpdateRepository creates a tool to edit the settings of a repository, including archiving it.
+func UpdateRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "update_repository",
+			Description: t("TOOL_UPDATE_REPOSITORY_DESCRIPTION",
+				"Update the settings of a GitHub repository: description, homepage, visibility, default branch, or archived state. "+
+					"Only the fields provided are changed. Archiving makes the repository read-only for everyone and only happens when 'archived' is explicitly set to true; set it to false to unarchive."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_UPDATE_REPOSITORY_USER_TITLE", "Update repository settings"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+				IdempotentHint:  true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"description": {
+						Type:        "string",
+						Description: "New repository description (empty string clears it)",
+					},
+					"homepage": {
+						Type:        "string",
+						Description: "New homepage URL (empty string clears it)",
+					},
+					"visibility": {
+						Type:        "string",
+						Description: "New repository visibility. 'internal' is only available for organizations on GitHub Enterprise.",
+						Enum:        []any{"public", "private", "internal"},
+					},
+					"default_branch": {
+						Type:        "string",
+						Description: "Existing branch to make the default branch",
+					},
+					"archived": {
+						Type:        "boolean",
+						Description: "true to archive the repository (it becomes read-only), false to unarchive it",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			update := &github.Repository{}
+			changed := false
+			for _, field := range []struct {
+				name string
+				dst  **string
+			}{
+				{"description", &update.Description},
+				{"homepage", &update.Homepage},
+				{"visibility", &update.Visibility},
+				{"default_branch", &update.DefaultBranch},
+			} {
+				value, ok, err := OptionalParamOK[string](args, field.name)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				if ok {
+					*field.dst = github.Ptr(value)
+					changed = true
+				}
+			}
+			if update.Visibility != nil {
+				switch *update.Visibility {
+				case "public", "private", "internal":
+				default:
+					return utils.NewToolResultError(fmt.Sprintf("invalid visibility %q: must be one of public, private, internal", *update.Visibility)), nil, nil
+				}
+			}
+			if update.DefaultBranch != nil && *update.DefaultBranch == "" {
+				return utils.NewToolResultError("default_branch cannot be empty"), nil, nil
+			}
+			archived, ok, err := OptionalParamOK[bool](args, "archived")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if ok {
+				update.Archived = github.Ptr(archived)
+				changed = true
+			}
+			if !changed {
+				return utils.NewToolResultError("at least one of description, homepage, visibility, default_branch or archived must be provided"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			updated, resp, err := client.Repositories.Edit(ctx, owner, repo, update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update repository", resp, body), nil, nil
+			}
+
+			response := UpdatedRepository{
+				MinimalRepository: MinimalRepository{
+					ID:            updated.GetID(),
+					Name:          updated.GetName(),
+					FullName:      updated.GetFullName(),
+					Description:   updated.GetDescription(),
+					HTMLURL:       updated.GetHTMLURL(),
+					Language:      updated.GetLanguage(),
+					Stars:         updated.GetStargazersCount(),
+					Forks:         updated.GetForksCount(),
+					OpenIssues:    updated.GetOpenIssuesCount(),
+					Topics:        updated.Topics,
+					Private:       updated.GetPrivate(),
+					Fork:          updated.GetFork(),
+					Archived:      updated.GetArchived(),
+					DefaultBranch: updated.GetDefaultBranch(),
+				},
+				Homepage:   updated.GetHomepage(),
+				Visibility: updated.GetVisibility(),
+			}
+			if updated.UpdatedAt != nil {
+				response.UpdatedAt = updated.UpdatedAt.Format("2006-01-02T15:04:05Z")
+			}
+
+			return MarshalledTextResult(response), nil, nil
+		},
+	)
+}
+
 // FetchRepoIsPrivate returns whether a repository is private. It is a thin
 // wrapper around the GitHub Repositories.Get endpoint provided as a shared
 // helper for IFC label computation across tools.
@@ -876,7 +1116,7 @@ func getFileContentsTool(t translations.TranslationHelperFunc, includeFields boo
 
 			rawOpts, fallbackUsed, err := resolveGitReference(ctx, client, owner, repo, ref, sha)
 			if err != nil {
-				return utils.NewToolResultError(fmt.Sprintf("failed to resolve git reference: %s", err)), nil, nil
+				return gitHubAPIErrorResult(ctx, "failed to resolve git reference", err), nil, nil
 			}
 
 			if rawOpts.SHA != "" {
@@ -899,6 +1139,14 @@ func getFileContentsTool(t translations.TranslationHelperFunc, includeFields boo
 				return attachIFC(res), data, err
 			}
 
+			// Submodules, and symlinks that GitHub does not resolve to a regular
+			// file, carry no content of their own; describe the target instead.
+			if fileContent != nil {
+				if special, ok := convertToSpecialContent(fileContent); ok {
+					return attachIFC(MarshalledTextResult(special)), nil, nil
+				}
+			}
+
 			if fileContent != nil && fileContent.SHA != nil {
 				fileSHA = *fileContent.SHA
 				fileSize := fileContent.GetSize()
@@ -995,54 +1243,476 @@ func getFileContentsTool(t translations.TranslationHelperFunc, includeFields boo
 				}
 				r, err := json.Marshal(payload)
 				if err != nil {
-					return utils.NewToolResultError("failed to marshal response"), nil, nil
+					return utils.NewToolResultError("failed to marshal response"), nil, nil
+				}
+				if includeFields {
+					recordDirContentsFieldsUsage(ctx, deps, dirContent, filtered, len(r))
+				}
+				return attachIFC(utils.NewToolResultText(string(r))), nil, nil
+			}
+
+			return utils.NewToolResultError("failed to get file contents"), nil, nil
+		},
+	)
+}
+
+// recordDirContentsFieldsUsage emits fields telemetry for a get_file_contents
+// directory listing. sentBytes is the size of the payload actually returned.
+func recordDirContentsFieldsUsage(ctx context.Context, deps ToolDependencies, full []*github.RepositoryContent, filtered bool, sentBytes int) {
+	recordFieldsUsageFor(ctx, deps, "get_file_contents", full, filtered, sentBytes)
+}
+
+const (
+	// maxGetFilesCount caps the number of files get_files fetches in one call.
+	maxGetFilesCount = 20
+	// defaultGetFilesMaxBytes is the default per-file content budget of get_files.
+	defaultGetFilesMaxBytes = 100 * 1024
+	// maxGetFilesConcurrency bounds the number of files get_files fetches at once.
+	maxGetFilesConcurrency = 5
+)
+
+// FileRequest identifies a single file requested from get_files.
+type FileRequest struct {
+	Path string
+	Ref  string
+}
+
+// FileContentResult is the content of a single file returned by get_files.
+// Content is empty for binary files.
+type FileContentResult struct {
+	Path    string `json:"path"`
+	Ref     string `json:"ref,omitempty"`
+	Content string `json:"content,omitempty"`
+	// Truncated reports that the file is larger than max_bytes_per_file and
+	// Content holds only its beginning.
+	Truncated bool `json:"truncated,omitempty"`
+	Binary    bool `json:"binary,omitempty"`
+}
+
+// parseFileRequests validates the raw "files" argument of get_files.
+func parseFileRequests(raw any) ([]FileRequest, error) {
+	items, ok := raw.([]any)
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("files must be a non-empty array of file objects")
+	}
+	if len(items) > maxGetFilesCount {
+		return nil, fmt.Errorf("at most %d files can be fetched at once, got %d", maxGetFilesCount, len(items))
+	}
+
+	files := make([]FileRequest, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("each file must be an object")
+		}
+		path, err := RequiredParam[string](m, "path")
+		if err != nil {
+			return nil, fmt.Errorf("each file must have a path")
+		}
+		ref, err := OptionalParam[string](m, "ref")
+		if err != nil {
+			return nil, fmt.Errorf("file %s: %w", path, err)
+		}
+		files = append(files, FileRequest{
+			Path: strings.TrimPrefix(path, "/"),
+			Ref:  ref,
+		})
+	}
+	return files, nil
+}
+
+// lastRuneStart returns the index of the byte that starts the last
+// character of b, looking back no further than one full UTF-8 sequence.
+func lastRuneStart(b []byte) int {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			return i
+		}
+	}
+	return len(b)
+}
+
+// limitTextContent cuts content to at most maxBytes without splitting a
+// multi-byte character. Content containing NUL bytes or invalid UTF-8 is
+// reported as binary, in which case truncated is always false.
+func limitTextContent(content []byte, maxBytes int) (limited []byte, truncated, binary bool) {
+	if len(content) > maxBytes {
+		content = content[:maxBytes]
+		// Don't leave half of a multi-byte character at the cut.
+		if i := lastRuneStart(content); !utf8.FullRune(content[i:]) {
+			content = content[:i]
+		}
+		truncated = true
+	}
+	if slices.Contains(content, 0) || !utf8.Valid(content) {
+		return nil, false, true
+	}
+	return content, truncated, false
+}
+
+// fetchFileContent reads at most maxBytes of a single file through the raw
+// content API. The returned error describes why this one file could not be
+// fetched; the caller reports it without failing the rest of the batch.
+func fetchFileContent(ctx context.Context, rawClient *raw.Client, owner, repo string, file FileRequest, maxBytes int) (FileContentResult, error) {
+	result := FileContentResult{
+		Path: file.Path,
+		Ref:  file.Ref,
+	}
+
+	resp, err := rawClient.GetRawContent(ctx, owner, repo, file.Path, &raw.ContentOpts{Ref: file.Ref})
+	if err != nil {
+		return result, fmt.Errorf("failed to get file contents: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return result, errors.New("file not found")
+	default:
+		return result, fmt.Errorf("failed to get file contents: unexpected status %d", resp.StatusCode)
+	}
+
+	// Read one byte past the budget to tell an exactly-sized file from a
+	// truncated one.
+	content, err := io.ReadAll(io.LimitReader(resp.Body, int64(maxBytes)+1))
+	if err != nil {
+		return result, fmt.Errorf("failed to read file contents: %w", err)
+	}
+	content, result.Truncated, result.Binary = limitTextContent(content, maxBytes)
+	if result.Binary {
+		return result, nil
+	}
+	result.Content = string(content)
+	return result, nil
+}
+
+// GetFiles creates a tool to fetch the contents of several files in one call.
+func GetFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "get_files",
+			Description: t("TOOL_GET_FILES_DESCRIPTION",
+				fmt.Sprintf("Get the contents of up to %d files from a GitHub repository in one call, each at its own ref. ", maxGetFilesCount)+
+					"Content beyond 'max_bytes_per_file' is cut off and the file is marked 'truncated'; binary files are marked 'binary' and their content is omitted. "+
+					"A file that cannot be fetched is reported with an 'error' and does not fail the other files. Use get_file_contents for directories."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_FILES_USER_TITLE", "Get multiple file contents"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"files": {
+						Type:        "array",
+						Description: "Files to fetch",
+						MinItems:    jsonschema.Ptr(1),
+						MaxItems:    jsonschema.Ptr(maxGetFilesCount),
+						Items: &jsonschema.Schema{
+							Type:                 "object",
+							AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+							Properties: map[string]*jsonschema.Schema{
+								"path": {
+									Type:        "string",
+									Description: "Path of the file relative to the repository root",
+								},
+								"ref": {
+									Type:        "string",
+									Description: "Branch, tag or commit SHA to read the file at. Defaults to the repository's default branch",
+								},
+							},
+							Required: []string{"path"},
+						},
+					},
+					"max_bytes_per_file": {
+						Type:        "number",
+						Description: "Maximum number of bytes of content to return for each file",
+						Default:     json.RawMessage(strconv.Itoa(defaultGetFilesMaxBytes)),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(1024 * 1024.0),
+					},
+				},
+				Required: []string{"owner", "repo", "files"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			files, err := parseFileRequests(args["files"])
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxBytes, err := OptionalIntParamWithDefault(args, "max_bytes_per_file", defaultGetFilesMaxBytes)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxBytes < 1 || maxBytes > 1024*1024 {
+				return utils.NewToolResultError("max_bytes_per_file must be between 1 and 1048576"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+			rawClient, err := deps.GetRawClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub raw content client", err), nil, nil
+			}
+
+			// Failures are reported per file, so the pool never returns an
+			// error of its own.
+			items, _ := runBounded(ctx, maxGetFilesConcurrency, len(files), func(ctx context.Context, i int) (utils.PartialItem, error) {
+				content, err := fetchFileContent(ctx, rawClient, owner, repo, files[i], maxBytes)
+				item := utils.PartialItem{Target: content.Path, Success: err == nil, Result: content}
+				if err != nil {
+					item.Error = err.Error()
+				}
+				return item, nil
+			})
+
+			result := utils.NewToolResultPartial(items, nil)
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelGetFileContents)
+			return result, nil, nil
+		},
+	)
+}
+
+// ForkResponse is the output of fork_repository.
+type ForkResponse struct {
+	ID       string `json:"id"`
+	URL      string `json:"url"`
+	FullName string `json:"full_name"`
+	CloneURL string `json:"clone_url,omitempty"`
+	SSHURL   string `json:"ssh_url,omitempty"`
+	// Ready is true once the fork's default branch can be read. It is only
+	// checked when wait_for_ready is set.
+	Ready   bool   `json:"ready"`
+	Message string `json:"message"`
+}
+
+// ForkRepository creates a tool to fork a repository.
+func ForkRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "fork_repository",
+			Description: t("TOOL_FORK_REPOSITORY_DESCRIPTION",
+				"Fork a GitHub repository to your account or specified organization. "+
+					"Forking is asynchronous: GitHub returns the new repository's name and clone URLs immediately, but its contents may take from a few seconds to several minutes to become available. "+
+					"Set wait_for_ready to poll briefly until the fork can be read; if it is still not ready the response has ready=false and the fork keeps being created in the background."),
+			Icons: octicons.Icons("repo-forked"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_FORK_REPOSITORY_USER_TITLE", "Fork repository"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"organization": {
+						Type:        "string",
+						Description: "Organization to fork to",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name for the fork (defaults to the name of the source repository)",
+					},
+					"default_branch_only": {
+						Type:        "boolean",
+						Description: "Only fork the default branch",
+					},
+					"wait_for_ready": {
+						Type:        "boolean",
+						Description: "Poll for a short, bounded time until the fork's default branch can be read",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			org, err := OptionalParam[string](args, "organization")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			name, err := OptionalParam[string](args, "name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			defaultBranchOnly, err := OptionalParam[bool](args, "default_branch_only")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			waitForReady, err := OptionalParam[bool](args, "wait_for_ready")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.RepositoryCreateForkOptions{
+				Organization:      org,
+				Name:              name,
+				DefaultBranchOnly: defaultBranchOnly,
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
+			if err != nil {
+				// An acceptedError means the fork was queued and is being created in
+				// the background; it carries the details of the pending fork.
+				if resp == nil || resp.StatusCode != http.StatusAccepted || !isAcceptedError(err) {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to fork repository",
+						resp,
+						err,
+					), nil, nil
+				}
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusAccepted {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				if includeFields {
-					recordDirContentsFieldsUsage(ctx, deps, dirContent, filtered, len(r))
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to fork repository", resp, body), nil, nil
+			}
+
+			response := ForkResponse{
+				ID:       fmt.Sprintf("%d", forkedRepo.GetID()),
+				URL:      forkedRepo.GetHTMLURL(),
+				FullName: forkedRepo.GetFullName(),
+				CloneURL: forkedRepo.GetCloneURL(),
+				SSHURL:   forkedRepo.GetSSHURL(),
+				Message:  "Fork is in progress; it may take a few seconds to several minutes before its contents are available",
+			}
+			if waitForReady && forkedRepo.GetOwner().GetLogin() != "" && forkedRepo.GetName() != "" {
+				if waitForFork(ctx, client, forkedRepo) {
+					response.Ready = true
+					response.Message = "Fork is ready"
 				}
-				return attachIFC(utils.NewToolResultText(string(r))), nil, nil
 			}
 
-			return utils.NewToolResultError("failed to get file contents"), nil, nil
+			return MarshalledTextResult(response), nil, nil
 		},
 	)
 }
 
-// recordDirContentsFieldsUsage emits fields telemetry for a get_file_contents
-// directory listing. sentBytes is the size of the payload actually returned.
-func recordDirContentsFieldsUsage(ctx context.Context, deps ToolDependencies, full []*github.RepositoryContent, filtered bool, sentBytes int) {
-	recordFieldsUsageFor(ctx, deps, "get_file_contents", full, filtered, sentBytes)
+// waitForFork polls until the default branch of a newly created fork can be
+// read, which is when its contents have been copied. It gives up after the
+// attempts allowed by the poll config and reports whether the fork is ready.
+func waitForFork(ctx context.Context, client *github.Client, fork *github.Repository) bool {
+	pollConfig := getPollConfig(ctx)
+	forkOwner := fork.GetOwner().GetLogin()
+	forkName := fork.GetName()
+	branch := fork.GetDefaultBranch()
+
+	for attempt := range pollConfig.MaxAttempts {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(pollConfig.Delay):
+			}
+		}
+
+		if branch == "" {
+			repository, resp, err := client.Repositories.Get(ctx, forkOwner, forkName)
+			if err != nil {
+				// The repository may not exist yet; try again on the next attempt.
+				continue
+			}
+			_ = resp.Body.Close()
+			branch = repository.GetDefaultBranch()
+			if branch == "" {
+				continue
+			}
+		}
+
+		_, resp, err := client.Repositories.GetBranch(ctx, forkOwner, forkName, branch, 1)
+		if err != nil {
+			continue
+		}
+		_ = resp.Body.Close()
+		return true
+	}
+	return false
 }
 
-// ForkRepository creates a tool to fork a repository.
-func ForkRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
+// SyncForkResponse is the output of sync_fork.
+type SyncForkResponse struct {
+	Branch string `json:"branch"`
+	// Status is "fast_forwarded", "merged", "up_to_date" or "conflict".
+	Status     string `json:"status"`
+	BaseBranch string `json:"base_branch,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+// SyncFork creates a tool to update a branch of a fork from its upstream repository.
+func SyncFork(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataRepos,
 		mcp.Tool{
-			Name:        "fork_repository",
-			Description: t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization"),
-			Icons:       octicons.Icons("repo-forked"),
+			Name: "sync_fork",
+			Description: t("TOOL_SYNC_FORK_DESCRIPTION",
+				"Sync a branch of a forked repository with the same branch of its upstream repository. "+
+					"Reports whether the branch was fast-forwarded, merged, already up to date, or could not be synced because of conflicts; on conflict the branch is left unchanged."),
+			Icons: octicons.Icons("repo-forked"),
 			Annotations: &mcp.ToolAnnotations{
-				Title:        t("TOOL_FORK_REPOSITORY_USER_TITLE", "Fork repository"),
-				ReadOnlyHint: false,
+				Title:          t("TOOL_SYNC_FORK_USER_TITLE", "Sync fork with upstream"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
 			},
 			InputSchema: &jsonschema.Schema{
 				Type: "object",
 				Properties: map[string]*jsonschema.Schema{
 					"owner": {
 						Type:        "string",
-						Description: "Repository owner",
+						Description: "Owner of the fork",
 					},
 					"repo": {
 						Type:        "string",
-						Description: "Repository name",
+						Description: "Name of the fork",
 					},
-					"organization": {
+					"branch": {
 						Type:        "string",
-						Description: "Organization to fork to",
+						Description: "Branch of the fork to update from upstream",
 					},
 				},
-				Required: []string{"owner", "repo"},
+				Required: []string{"owner", "repo", "branch"},
 			},
 		},
 		[]scopes.Scope{scopes.Repo},
@@ -1055,55 +1725,52 @@ func ForkRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			org, err := OptionalParam[string](args, "organization")
+			branch, err := RequiredParam[string](args, "branch")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			opts := &github.RepositoryCreateForkOptions{}
-			if org != "" {
-				opts.Organization = org
-			}
-
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
+
+			result, resp, err := client.Repositories.MergeUpstream(ctx, owner, repo, &github.RepoMergeUpstreamRequest{
+				Branch: github.Ptr(branch),
+			})
+			if resp != nil && resp.StatusCode == http.StatusConflict {
+				_ = resp.Body.Close()
+				return MarshalledTextResult(SyncForkResponse{
+					Branch:  branch,
+					Status:  "conflict",
+					Message: fmt.Sprintf("%s cannot be synced with upstream because of conflicts; the branch was not changed", branch),
+				}), nil, nil
+			}
 			if err != nil {
-				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
-				// and it's not a real error.
-				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return utils.NewToolResultText("Fork is in progress"), nil, nil
-				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to fork repository",
+					fmt.Sprintf("failed to sync branch %s of %s/%s with upstream", branch, owner, repo),
 					resp,
 					err,
 				), nil, nil
 			}
-			defer func() { _ = resp.Body.Close() }()
-
-			if resp.StatusCode != http.StatusAccepted {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to fork repository", resp, body), nil, nil
-			}
+			_ = resp.Body.Close()
 
-			// Return minimal response with just essential information
-			minimalResponse := MinimalResponse{
-				ID:  fmt.Sprintf("%d", forkedRepo.GetID()),
-				URL: forkedRepo.GetHTMLURL(),
+			response := SyncForkResponse{
+				Branch:     branch,
+				BaseBranch: result.GetBaseBranch(),
+				Message:    result.GetMessage(),
 			}
-
-			r, err := json.Marshal(minimalResponse)
-			if err != nil {
-				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			switch result.GetMergeType() {
+			case "fast-forward":
+				response.Status = "fast_forwarded"
+			case "merge":
+				response.Status = "merged"
+			default:
+				// GitHub reports merge_type "none" when the branch is not behind upstream.
+				response.Status = "up_to_date"
 			}
 
-			return utils.NewToolResultText(string(r)), nil, nil
+			return MarshalledTextResult(response), nil, nil
 		},
 	)
 }
@@ -1183,7 +1850,7 @@ func DeleteFile(t translations.TranslationHelperFunc) inventory.ServerTool {
 			// Get the reference for the branch
 			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get branch reference: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get branch reference", resp, err), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -1526,7 +2193,7 @@ func PushFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
 				if branchNotFound {
 					ref, err = createReferenceFromDefaultBranch(ctx, client, owner, repo, branch)
 					if err != nil {
-						return utils.NewToolResultError(fmt.Sprintf("failed to create branch from default: %v", err)), nil, nil
+						return gitHubAPIErrorResult(ctx, "failed to create branch from default", err), nil, nil
 					}
 				}
 
@@ -1547,7 +2214,7 @@ func PushFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
 				// Repository is empty, need to initialize it first
 				ref, base, err = initializeRepository(ctx, client, owner, repo)
 				if err != nil {
-					return utils.NewToolResultError(fmt.Sprintf("failed to initialize repository: %v", err)), nil, nil
+					return gitHubAPIErrorResult(ctx, "failed to initialize repository", err), nil, nil
 				}
 
 				defaultBranch := strings.TrimPrefix(*ref.Ref, "refs/heads/")
@@ -1555,7 +2222,7 @@ func PushFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
 					// Create the requested branch from the default branch
 					ref, err = createReferenceFromDefaultBranch(ctx, client, owner, repo, branch)
 					if err != nil {
-						return utils.NewToolResultError(fmt.Sprintf("failed to create branch from default: %v", err)), nil, nil
+						return gitHubAPIErrorResult(ctx, "failed to create branch from default", err), nil, nil
 					}
 				}
 
@@ -1682,7 +2349,7 @@ func ListTags(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1928,7 +2595,7 @@ func listReleasesTool(t translations.TranslationHelperFunc, includeFields bool)
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -1945,7 +2612,7 @@ func listReleasesTool(t translations.TranslationHelperFunc, includeFields bool)
 
 			releases, resp, err := client.Repositories.ListReleases(ctx, owner, repo, opts)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to list releases: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list releases", resp, err), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -2050,7 +2717,7 @@ func GetLatestRelease(t translations.TranslationHelperFunc) inventory.ServerTool
 
 			release, resp, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get latest release: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get latest release", resp, err), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -2212,7 +2879,7 @@ func ListStarredRepositories(t translations.TranslationHelperFunc) inventory.Ser
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -2442,6 +3109,104 @@ func UnstarRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 	)
 }
 
+// ListStargazers creates a tool to list the users who starred a repository and when they did so.
+func ListStargazers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataStargazers,
+		mcp.Tool{
+			Name:        "list_stargazers",
+			Description: t("TOOL_LIST_STARGAZERS_DESCRIPTION", "List the users who starred a GitHub repository, with the time each star was given. Results are ordered from oldest to newest star and are paginated; the response includes `nextPage`, `prevPage`, `firstPage`, and `lastPage` fields. To get the next page, use the `nextPage` value as the `page` parameter."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_STARGAZERS_USER_TITLE", "List stargazers"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// ListStargazers requests the star media type, so each entry carries
+			// the time the star was given alongside the user.
+			stargazers, resp, err := client.Activity.ListStargazers(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list stargazers for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list stargazers", resp, body), nil, nil
+			}
+
+			result := make([]MinimalStargazer, 0, len(stargazers))
+			for _, s := range stargazers {
+				stargazer := MinimalStargazer{
+					Login: s.GetUser().GetLogin(),
+					ID:    s.GetUser().GetID(),
+				}
+				if s.StarredAt != nil {
+					stargazer.StarredAt = s.StarredAt.Format(time.RFC3339)
+				}
+				result = append(result, stargazer)
+			}
+
+			response := map[string]any{
+				"items":     result,
+				"nextPage":  resp.NextPage,
+				"prevPage":  resp.PrevPage,
+				"firstPage": resp.FirstPage,
+				"lastPage":  resp.LastPage,
+			}
+
+			callResult := MarshalledTextResult(response)
+			// Anyone who can see a repository can star it, so the stargazer
+			// list is outsider-controlled; confidentiality follows visibility.
+			callResult = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, callResult, ifc.LabelRepoUserContent)
+			return callResult, nil, nil
+		},
+	)
+}
+
 // maxBlameRanges caps the number of matching blame ranges considered for one response.
 const maxBlameRanges = 1000
 
@@ -2653,7 +3418,7 @@ func GetFileBlame(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if _, hasPage := args["page"]; hasPage {
 				return utils.NewToolResultError("This tool uses cursor-based pagination. Use the 'after' parameter with the 'endCursor' value from the previous response instead of 'page'."), nil, nil
 			}
-			pagination, err := OptionalCursorPaginationParams(args)
+			pagination, err := OptionalCursorPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -2911,7 +3676,7 @@ func ListRepositoryCollaborators(t translations.TranslationHelperFunc) inventory
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -2974,3 +3739,213 @@ func ListRepositoryCollaborators(t translations.TranslationHelperFunc) inventory
 		},
 	)
 }
+
+const (
+	// defaultFileHistoryCommits is the number of commits get_file_history
+	// returns when max_commits is omitted.
+	defaultFileHistoryCommits = 10
+	// maxFileHistoryCommits caps max_commits, since every commit costs one
+	// additional commit-detail request.
+	maxFileHistoryCommits = 30
+	// defaultFileHistoryPatchBytes is the per-commit patch budget used when
+	// max_patch_bytes is omitted.
+	defaultFileHistoryPatchBytes = 4000
+)
+
+// FileHistoryEntry is a single commit that touched the requested file, along
+// with that file's diff in the commit.
+type FileHistoryEntry struct {
+	SHA              string `json:"sha"`
+	Author           string `json:"author"`
+	Date             string `json:"date,omitempty"`
+	Message          string `json:"message"`
+	Status           string `json:"status,omitempty"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Patch            string `json:"patch,omitempty"`
+	PatchTruncated   bool   `json:"patch_truncated,omitempty"`
+}
+
+// FileHistoryResult is the response payload returned by the get_file_history tool.
+type FileHistoryResult struct {
+	Repository string             `json:"repository"`
+	Path       string             `json:"path"`
+	Commits    []FileHistoryEntry `json:"commits"`
+}
+
+// truncatePatch cuts patch to at most maxBytes bytes without splitting a
+// UTF-8 sequence, reporting whether anything was removed.
+func truncatePatch(patch string, maxBytes int) (string, bool) {
+	if len(patch) <= maxBytes {
+		return patch, false
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(patch[cut]) {
+		cut--
+	}
+	return patch[:cut], true
+}
+
+// GetFileHistory creates a tool that returns the commits touching a file
+// together with the file's diff in each commit.
+func GetFileHistory(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "get_file_history",
+			Description: t("TOOL_GET_FILE_HISTORY_DESCRIPTION",
+				"Get the git history of a single file, newest first, including the file's diff in each commit. "+
+					"Use this instead of calling list_commits followed by get_commit for every result. "+
+					"Patches larger than 'max_patch_bytes' are cut off and flagged with 'patch_truncated'."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_FILE_HISTORY_USER_TITLE", "Get file history"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"path": {
+						Type:        "string",
+						Description: "Path to the file in the repository, relative to the repository root",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "Commit SHA, branch or tag name to start the history from. Defaults to the repository's default branch.",
+					},
+					"max_commits": {
+						Type:        "number",
+						Description: "Maximum number of commits to return (default 10, max 30)",
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(maxFileHistoryCommits)),
+					},
+					"max_patch_bytes": {
+						Type:        "number",
+						Description: "Maximum size in bytes of the patch returned for each commit (default 4000). Set to 0 to omit patches.",
+						Minimum:     jsonschema.Ptr(0.0),
+					},
+				},
+				Required: []string{"owner", "repo", "path"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			path, err := RequiredParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := OptionalParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxCommits, err := OptionalIntParamWithDefault(args, "max_commits", defaultFileHistoryCommits)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxCommits < 1 || maxCommits > maxFileHistoryCommits {
+				return utils.NewToolResultError(fmt.Sprintf("max_commits must be between 1 and %d", maxFileHistoryCommits)), nil, nil
+			}
+			// max_patch_bytes is read explicitly because 0 is meaningful (omit patches).
+			maxPatchBytes := defaultFileHistoryPatchBytes
+			if _, ok := args["max_patch_bytes"]; ok {
+				maxPatchBytes, err = OptionalIntParam(args, "max_patch_bytes")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+			if maxPatchBytes < 0 {
+				return utils.NewToolResultError("max_patch_bytes must be >= 0"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, &github.CommitsListOptions{
+				SHA:  sha,
+				Path: path,
+				ListOptions: github.ListOptions{
+					PerPage: maxCommits,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list commits for path: %s", path),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			entries := make([]FileHistoryEntry, 0, len(commits))
+			for _, c := range commits {
+				entry := FileHistoryEntry{
+					SHA:     c.GetSHA(),
+					Author:  c.GetAuthor().GetLogin(),
+					Message: c.GetCommit().GetMessage(),
+				}
+				if entry.Author == "" {
+					entry.Author = c.GetCommit().GetAuthor().GetName()
+				}
+				if date := c.GetCommit().GetAuthor().GetDate(); !date.IsZero() {
+					entry.Date = date.Format(time.RFC3339)
+				}
+
+				if maxPatchBytes > 0 {
+					detail, resp, err := client.Repositories.GetCommit(ctx, owner, repo, c.GetSHA(), nil)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to get commit: %s", c.GetSHA()),
+							resp,
+							err,
+						), nil, nil
+					}
+					_ = resp.Body.Close()
+
+					for _, f := range detail.Files {
+						if f.GetFilename() != path {
+							continue
+						}
+						entry.Status = f.GetStatus()
+						entry.PreviousFilename = f.GetPreviousFilename()
+						entry.Patch, entry.PatchTruncated = truncatePatch(f.GetPatch(), maxPatchBytes)
+						break
+					}
+				}
+
+				entries = append(entries, entry)
+			}
+
+			r, err := json.Marshal(FileHistoryResult{
+				Repository: fmt.Sprintf("%s/%s", owner, repo),
+				Path:       path,
+				Commits:    entries,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			result := utils.NewToolResultText(string(r))
+			// Commit content is reachable from the repo's history; integrity
+			// follows the same public-untrusted / private-trusted rule as file
+			// contents. Confidentiality follows repo visibility.
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelCommitContents)
+			return result, nil, nil
+		},
+	)
+}
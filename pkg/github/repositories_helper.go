@@ -3,8 +3,10 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -15,12 +17,11 @@ import (
 )
 
 // initializeRepository creates an initial commit in an empty repository and returns the default branch ref and base commit
-func initializeRepository(ctx context.Context, client *github.Client, owner, repo string) (ref *github.Reference, baseCommit *github.Commit, err error) {
+func initializeRepository(ctx context.Context, client *github.Client, owner, repo string) (*github.Reference, *github.Commit, error) {
 	// First, we need to check what the default branch in this empty repo should be:
 	repository, resp, err := client.Repositories.Get(ctx, owner, repo)
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get repository", resp, err)
-		return nil, nil, fmt.Errorf("failed to get repository: %w", err)
+		return nil, nil, &ghErrors.GitHubAPIError{Message: "failed to get repository", Response: resp, Err: err}
 	}
 	if resp != nil && resp.Body != nil {
 		defer func() { _ = resp.Body.Close() }()
@@ -37,27 +38,24 @@ func initializeRepository(ctx context.Context, client *github.Client, owner, rep
 	// Create an initial empty commit to create the default branch
 	createResp, resp, err := client.Repositories.CreateFile(ctx, owner, repo, "README.md", fileOpts)
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to create initial file", resp, err)
-		return nil, nil, fmt.Errorf("failed to create initial file: %w", err)
+		return nil, nil, &ghErrors.GitHubAPIError{Message: "failed to create initial file", Response: resp, Err: err}
 	}
 	if resp != nil && resp.Body != nil {
 		defer func() { _ = resp.Body.Close() }()
 	}
 
 	// Get the commit that was just created to use as base for remaining files
-	baseCommit, resp, err = client.Git.GetCommit(ctx, owner, repo, *createResp.Commit.SHA)
+	baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *createResp.Commit.SHA)
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get initial commit", resp, err)
-		return nil, nil, fmt.Errorf("failed to get initial commit: %w", err)
+		return nil, nil, &ghErrors.GitHubAPIError{Message: "failed to get initial commit", Response: resp, Err: err}
 	}
 	if resp != nil && resp.Body != nil {
 		defer func() { _ = resp.Body.Close() }()
 	}
 
-	ref, resp, err = client.Git.GetRef(ctx, owner, repo, "refs/heads/"+defaultBranch)
+	ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+defaultBranch)
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get final reference", resp, err)
-		return nil, nil, fmt.Errorf("failed to get branch reference after initial commit: %w", err)
+		return nil, nil, &ghErrors.GitHubAPIError{Message: "failed to get branch reference after initial commit", Response: resp, Err: err}
 	}
 	if resp != nil && resp.Body != nil {
 		defer func() { _ = resp.Body.Close() }()
@@ -70,8 +68,7 @@ func initializeRepository(ctx context.Context, client *github.Client, owner, rep
 func createReferenceFromDefaultBranch(ctx context.Context, client *github.Client, owner, repo, branch string) (*github.Reference, error) {
 	defaultRef, err := resolveDefaultBranch(ctx, client, owner, repo)
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to resolve default branch", nil, err)
-		return nil, fmt.Errorf("failed to resolve default branch: %w", err)
+		return nil, err
 	}
 
 	// Create the new branch reference
@@ -80,8 +77,7 @@ func createReferenceFromDefaultBranch(ctx context.Context, client *github.Client
 		SHA: *defaultRef.Object.SHA,
 	})
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to create new branch reference", resp, err)
-		return nil, fmt.Errorf("failed to create new branch reference: %w", err)
+		return nil, &ghErrors.GitHubAPIError{Message: "failed to create new branch reference", Response: resp, Err: err}
 	}
 	if resp != nil && resp.Body != nil {
 		defer func() { _ = resp.Body.Close() }()
@@ -90,6 +86,17 @@ func createReferenceFromDefaultBranch(ctx context.Context, client *github.Client
 	return createdRef, nil
 }
 
+// gitHubAPIErrorResult returns the tool error for an error from one of the
+// helpers in this file. A *ghErrors.GitHubAPIError is reported with its
+// status, message and field errors, after message and its own message.
+func gitHubAPIErrorResult(ctx context.Context, message string, err error) *mcp.CallToolResult {
+	var apiErr *ghErrors.GitHubAPIError
+	if errors.As(err, &apiErr) {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, message+": "+apiErr.Message, apiErr.Response, apiErr.Err)
+	}
+	return utils.NewToolResultErrorFromErr(message, err)
+}
+
 // matchFiles searches for files in the Git tree that match the given path.
 // It's used when GetContents fails or returns unexpected results.
 func matchFiles(ctx context.Context, client *github.Client, owner, repo, ref, path string, rawOpts *raw.ContentOpts, rawAPIResponseCode int) (*mcp.CallToolResult, any, error) {
@@ -269,13 +276,11 @@ func resolveGitReference(ctx context.Context, githubClient *github.Client, owner
 					}
 
 					// The tag lookup failed for a different reason.
-					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get reference (tag)", resp, err)
-					return nil, false, fmt.Errorf("failed to get reference for tag '%s': %w", originalRef, err)
+					return nil, false, &ghErrors.GitHubAPIError{Message: fmt.Sprintf("failed to get reference for tag '%s'", originalRef), Response: resp, Err: err}
 				}
 			} else {
 				// The branch lookup failed for a different reason.
-				_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get reference (branch)", resp, err)
-				return nil, false, fmt.Errorf("failed to get reference for branch '%s': %w", originalRef, err)
+				return nil, false, &ghErrors.GitHubAPIError{Message: fmt.Sprintf("failed to get reference for branch '%s'", originalRef), Response: resp, Err: err}
 			}
 		}
 	}
@@ -292,8 +297,7 @@ func resolveGitReference(ctx context.Context, githubClient *github.Client, owner
 				ref = reference.GetRef()
 				fallbackUsed = true
 			} else {
-				_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get final reference", resp, err)
-				return nil, false, fmt.Errorf("failed to get final reference for %q: %w", ref, err)
+				return nil, false, &ghErrors.GitHubAPIError{Message: fmt.Sprintf("failed to get final reference for %q", ref), Response: resp, Err: err}
 			}
 		}
 	}
@@ -305,8 +309,7 @@ func resolveGitReference(ctx context.Context, githubClient *github.Client, owner
 func resolveDefaultBranch(ctx context.Context, githubClient *github.Client, owner, repo string) (*github.Reference, error) {
 	repoInfo, resp, err := githubClient.Repositories.Get(ctx, owner, repo)
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get repository info", resp, err)
-		return nil, fmt.Errorf("failed to get repository info: %w", err)
+		return nil, &ghErrors.GitHubAPIError{Message: "failed to get repository info", Response: resp, Err: err}
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -317,8 +320,7 @@ func resolveDefaultBranch(ctx context.Context, githubClient *github.Client, owne
 
 	defaultRef, resp, err := githubClient.Git.GetRef(ctx, owner, repo, "heads/"+defaultBranch)
 	if err != nil {
-		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get default branch reference", resp, err)
-		return nil, fmt.Errorf("failed to get default branch reference: %w", err)
+		return nil, &ghErrors.GitHubAPIError{Message: "failed to get default branch reference", Response: resp, Err: err}
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -327,3 +329,53 @@ func resolveDefaultBranch(ctx context.Context, githubClient *github.Client, owne
 
 	return defaultRef, nil
 }
+
+// SpecialContentResponse describes a get_file_contents path that has no file
+// content of its own: a submodule, or a symlink that GitHub did not resolve
+// to a regular file.
+type SpecialContentResponse struct {
+	Type            string `json:"type"`
+	Path            string `json:"path"`
+	SHA             string `json:"sha"`
+	SubmoduleGitURL string `json:"submodule_git_url,omitempty"`
+	Target          string `json:"target,omitempty"`
+	ResolvedPath    string `json:"resolved_path,omitempty"`
+	HTMLURL         string `json:"html_url,omitempty"`
+	Note            string `json:"note"`
+}
+
+// convertToSpecialContent builds the structured result returned for submodule
+// and symlink entries. It returns false for any other content type.
+func convertToSpecialContent(content *github.RepositoryContent) (SpecialContentResponse, bool) {
+	resp := SpecialContentResponse{
+		Type:    content.GetType(),
+		Path:    content.GetPath(),
+		SHA:     content.GetSHA(),
+		HTMLURL: content.GetHTMLURL(),
+	}
+
+	switch content.GetType() {
+	case "submodule":
+		resp.SubmoduleGitURL = content.GetSubmoduleGitURL()
+		resp.Note = "This path is a git submodule. 'sha' is the commit the submodule is pinned to in 'submodule_git_url'; fetch files from that repository at that commit."
+	case "symlink":
+		resp.Target = content.GetTarget()
+		// Relative targets are resolved against the symlink's directory. Targets
+		// that are absolute or escape the repository cannot be fetched from it.
+		if !strings.HasPrefix(resp.Target, "/") {
+			resolved := path.Join(path.Dir(resp.Path), resp.Target)
+			if resolved != ".." && !strings.HasPrefix(resolved, "../") {
+				resp.ResolvedPath = resolved
+			}
+		}
+		if resp.ResolvedPath != "" {
+			resp.Note = "This path is a symlink whose target is not a regular file. Call get_file_contents with 'resolved_path' to read the target."
+		} else {
+			resp.Note = "This path is a symlink whose target is outside the repository and cannot be fetched."
+		}
+	default:
+		return SpecialContentResponse{}, false
+	}
+
+	return resp, true
+}
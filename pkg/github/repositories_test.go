@@ -548,6 +548,100 @@ func Test_GetFileContents_DirectoryFieldFiltering(t *testing.T) {
 	assert.NotContains(t, textContent.Text, "download_url")
 }
 
+func Test_GetFileContents_SpecialContentTypes(t *testing.T) {
+	serverTool := GetFileContents(translations.NullTranslationHelper)
+
+	tests := []struct {
+		name        string
+		content     *github.RepositoryContent
+		requestPath string
+		expected    SpecialContentResponse
+	}{
+		{
+			name: "submodule returns pinned commit and URL",
+			content: &github.RepositoryContent{
+				Type:            github.Ptr("submodule"),
+				Name:            github.Ptr("vendor-lib"),
+				Path:            github.Ptr("third_party/vendor-lib"),
+				SHA:             github.Ptr("0123456789abcdef0123456789abcdef01234567"),
+				SubmoduleGitURL: github.Ptr("https://github.com/other/vendor-lib.git"),
+			},
+			requestPath: "third_party/vendor-lib",
+			expected: SpecialContentResponse{
+				Type:            "submodule",
+				Path:            "third_party/vendor-lib",
+				SHA:             "0123456789abcdef0123456789abcdef01234567",
+				SubmoduleGitURL: "https://github.com/other/vendor-lib.git",
+			},
+		},
+		{
+			name: "symlink returns target resolved against its directory",
+			content: &github.RepositoryContent{
+				Type:   github.Ptr("symlink"),
+				Name:   github.Ptr("current"),
+				Path:   github.Ptr("docs/current"),
+				SHA:    github.Ptr("abc123"),
+				Target: github.Ptr("../releases/v2"),
+			},
+			requestPath: "docs/current",
+			expected: SpecialContentResponse{
+				Type:         "symlink",
+				Path:         "docs/current",
+				SHA:          "abc123",
+				Target:       "../releases/v2",
+				ResolvedPath: "releases/v2",
+			},
+		},
+		{
+			name: "symlink escaping the repository has no resolved path",
+			content: &github.RepositoryContent{
+				Type:   github.Ptr("symlink"),
+				Name:   github.Ptr("etc"),
+				Path:   github.Ptr("etc"),
+				SHA:    github.Ptr("def456"),
+				Target: github.Ptr("../../etc"),
+			},
+			requestPath: "etc",
+			expected: SpecialContentResponse{
+				Type:   "symlink",
+				Path:   "etc",
+				SHA:    "def456",
+				Target: "../../etc",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposByOwnerByRepo:                          mockResponse(t, http.StatusOK, "{\"name\": \"repo\", \"default_branch\": \"main\"}"),
+				GetReposGitRefByOwnerByRepoByRef:               mockResponse(t, http.StatusOK, "{\"ref\": \"refs/heads/main\", \"object\": {\"sha\": \"\"}}"),
+				"GET /repos/{owner}/{repo}/contents/{path:.*}": mockResponse(t, http.StatusOK, tc.content),
+			}))
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  tc.requestPath,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			var returned SpecialContentResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.NotEmpty(t, returned.Note)
+			returned.Note = ""
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
 func Test_LegacyGetFileContents_Definition(t *testing.T) {
 	serverTool := LegacyGetFileContents(translations.NullTranslationHelper)
 	tool := serverTool.Tool
@@ -876,6 +970,310 @@ func Test_GetCommit_IFC_FeatureFlag(t *testing.T) {
 	})
 }
 
+// getFilesItem is one item of the get_files partial result.
+type getFilesItem struct {
+	Target  string            `json:"target"`
+	Success bool              `json:"success"`
+	Error   string            `json:"error,omitempty"`
+	Result  FileContentResult `json:"result"`
+}
+
+func Test_GetFiles(t *testing.T) {
+	serverTool := GetFiles(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "get_files", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "files")
+	assert.Contains(t, schema.Properties, "max_bytes_per_file")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "files"})
+
+	rawFiles := map[string]string{
+		"/owner/repo/HEAD/README.md":         "# Project\n",
+		"/owner/repo/feature/src/main.go":    "package main\n\nfunc main() {}\n",
+		"/owner/repo/HEAD/docs/long.txt":     "héllo world",
+		"/owner/repo/HEAD/assets/logo.png":   "\x89PNG\r\n\x1a\n\x00\x00",
+		"/owner/repo/abc123/src/old_main.go": "package main\n",
+	}
+	rawHandler := func(w http.ResponseWriter, r *http.Request) {
+		content, ok := rawFiles[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("404: Not Found"))
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]any
+		expectError     bool
+		expectedErrMsg  string
+		expectedSummary string
+		expected        []getFilesItem
+	}{
+		{
+			name: "fetches files at their own refs in request order",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"files": []any{
+					map[string]any{"path": "README.md"},
+					map[string]any{"path": "/src/main.go", "ref": "feature"},
+					map[string]any{"path": "src/old_main.go", "ref": "abc123"},
+				},
+			},
+			expectedSummary: "3 succeeded, 0 failed",
+			expected: []getFilesItem{
+				{Target: "README.md", Success: true, Result: FileContentResult{Path: "README.md", Content: "# Project\n"}},
+				{Target: "src/main.go", Success: true, Result: FileContentResult{Path: "src/main.go", Ref: "feature", Content: "package main\n\nfunc main() {}\n"}},
+				{Target: "src/old_main.go", Success: true, Result: FileContentResult{Path: "src/old_main.go", Ref: "abc123", Content: "package main\n"}},
+			},
+		},
+		{
+			name: "truncates on a character boundary, flags binaries and reports missing files",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"files": []any{
+					map[string]any{"path": "docs/long.txt"},
+					map[string]any{"path": "assets/logo.png"},
+					map[string]any{"path": "missing.go"},
+				},
+				// Cuts "héllo" in the middle of the two-byte "é".
+				"max_bytes_per_file": float64(2),
+			},
+			expectedSummary: "2 succeeded, 1 failed",
+			expected: []getFilesItem{
+				{Target: "docs/long.txt", Success: true, Result: FileContentResult{Path: "docs/long.txt", Content: "h", Truncated: true}},
+				{Target: "assets/logo.png", Success: true, Result: FileContentResult{Path: "assets/logo.png", Binary: true}},
+				{Target: "missing.go", Error: "file not found", Result: FileContentResult{Path: "missing.go"}},
+			},
+		},
+		{
+			name: "too many files",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"files": func() []any {
+					files := make([]any, maxGetFilesCount+1)
+					for i := range files {
+						files[i] = map[string]any{"path": "README.md"}
+					}
+					return files
+				}(),
+			},
+			expectError:    true,
+			expectedErrMsg: "at most 20 files can be fetched at once, got 21",
+		},
+		{
+			name: "file without a path",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"files": []any{map[string]any{"ref": "main"}},
+			},
+			expectError:    true,
+			expectedErrMsg: "each file must have a path",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetRawReposContentsByOwnerByRepoBySHAByPath: rawHandler,
+			}))
+			mockRawClient, err := raw.NewClient(client, &url.URL{Scheme: "https", Host: "raw.example.com", Path: "/"})
+			require.NoError(t, err)
+			deps := BaseDeps{
+				Client:    client,
+				RawClient: mockRawClient,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response struct {
+				Summary string         `json:"summary"`
+				Items   []getFilesItem `json:"items"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedSummary, response.Summary)
+			assert.Equal(t, tc.expected, response.Items)
+		})
+	}
+}
+
+func Test_UpdateRepository(t *testing.T) {
+	serverTool := UpdateRepository(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "update_repository", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	require.NotNil(t, tool.Annotations.DestructiveHint)
+	assert.True(t, *tool.Annotations.DestructiveHint, "archiving is destructive")
+	for _, key := range []string{"description", "homepage", "visibility", "default_branch", "archived"} {
+		assert.Contains(t, schema.Properties, key)
+	}
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       UpdatedRepository
+	}{
+		{
+			name: "archives the repository",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"archived": true,
+				}).andThen(mockResponse(t, http.StatusOK, &github.Repository{
+					ID:            github.Ptr(int64(42)),
+					Name:          github.Ptr("repo"),
+					FullName:      github.Ptr("owner/repo"),
+					HTMLURL:       github.Ptr("https://github.com/owner/repo"),
+					Archived:      github.Ptr(true),
+					Visibility:    github.Ptr("public"),
+					DefaultBranch: github.Ptr("main"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"archived": true,
+			},
+			expected: UpdatedRepository{
+				MinimalRepository: MinimalRepository{
+					ID:            42,
+					Name:          "repo",
+					FullName:      "owner/repo",
+					HTMLURL:       "https://github.com/owner/repo",
+					Archived:      true,
+					DefaultBranch: "main",
+				},
+				Visibility: "public",
+			},
+		},
+		{
+			name: "clears the description and changes settings",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"description":    "",
+					"homepage":       "https://example.com",
+					"visibility":     "private",
+					"default_branch": "trunk",
+				}).andThen(mockResponse(t, http.StatusOK, &github.Repository{
+					ID:            github.Ptr(int64(42)),
+					Name:          github.Ptr("repo"),
+					FullName:      github.Ptr("owner/repo"),
+					HTMLURL:       github.Ptr("https://github.com/owner/repo"),
+					Homepage:      github.Ptr("https://example.com"),
+					Private:       github.Ptr(true),
+					Visibility:    github.Ptr("private"),
+					DefaultBranch: github.Ptr("trunk"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"description":    "",
+				"homepage":       "https://example.com",
+				"visibility":     "private",
+				"default_branch": "trunk",
+			},
+			expected: UpdatedRepository{
+				MinimalRepository: MinimalRepository{
+					ID:            42,
+					Name:          "repo",
+					FullName:      "owner/repo",
+					HTMLURL:       "https://github.com/owner/repo",
+					Private:       true,
+					DefaultBranch: "trunk",
+				},
+				Homepage:   "https://example.com",
+				Visibility: "private",
+			},
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of description, homepage, visibility, default_branch or archived must be provided",
+		},
+		{
+			name:         "invalid visibility",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"visibility": "secret",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid visibility",
+		},
+		{
+			name: "permission denied",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+			}),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"archived": false,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update repository owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response UpdatedRepository
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
 func Test_ForkRepository(t *testing.T) {
 	// Verify tool definition once
 	serverTool := ForkRepository(translations.NullTranslationHelper)
@@ -978,6 +1376,205 @@ func Test_ForkRepository(t *testing.T) {
 	}
 }
 
+func Test_ForkRepository_WaitForReady(t *testing.T) {
+	serverTool := ForkRepository(translations.NullTranslationHelper)
+
+	mockForkedRepo := &github.Repository{
+		ID:       github.Ptr(int64(123456)),
+		Name:     github.Ptr("renamed"),
+		FullName: github.Ptr("octo-org/renamed"),
+		Owner: &github.User{
+			Login: github.Ptr("octo-org"),
+		},
+		HTMLURL:       github.Ptr("https://github.com/octo-org/renamed"),
+		CloneURL:      github.Ptr("https://github.com/octo-org/renamed.git"),
+		SSHURL:        github.Ptr("git@github.com:octo-org/renamed.git"),
+		DefaultBranch: github.Ptr("main"),
+	}
+
+	tests := []struct {
+		name          string
+		branchHandler http.HandlerFunc
+		expected      ForkResponse
+	}{
+		{
+			name: "fork becomes ready",
+			branchHandler: mockResponse(t, http.StatusOK, &github.Branch{
+				Name: github.Ptr("main"),
+			}),
+			expected: ForkResponse{
+				ID:       "123456",
+				URL:      "https://github.com/octo-org/renamed",
+				FullName: "octo-org/renamed",
+				CloneURL: "https://github.com/octo-org/renamed.git",
+				SSHURL:   "git@github.com:octo-org/renamed.git",
+				Ready:    true,
+				Message:  "Fork is ready",
+			},
+		},
+		{
+			name:          "fork still in progress after polling",
+			branchHandler: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+			expected: ForkResponse{
+				ID:       "123456",
+				URL:      "https://github.com/octo-org/renamed",
+				FullName: "octo-org/renamed",
+				CloneURL: "https://github.com/octo-org/renamed.git",
+				SSHURL:   "git@github.com:octo-org/renamed.git",
+				Ready:    false,
+				Message:  "Fork is in progress; it may take a few seconds to several minutes before its contents are available",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var branchCalls int
+			client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposForksByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"organization":        "octo-org",
+					"name":                "renamed",
+					"default_branch_only": true,
+				}).andThen(mockResponse(t, http.StatusAccepted, mockForkedRepo)),
+				GetReposBranchesByOwnerByRepoByBranch: func(w http.ResponseWriter, r *http.Request) {
+					branchCalls++
+					tc.branchHandler(w, r)
+				},
+			}))
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"organization":        "octo-org",
+				"name":                "renamed",
+				"default_branch_only": true,
+				"wait_for_ready":      true,
+			})
+			ctx := ContextWithPollConfig(ContextWithDeps(context.Background(), deps), PollConfig{MaxAttempts: 3, Delay: time.Millisecond})
+			result, err := handler(ctx, &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var response ForkResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+			if tc.expected.Ready {
+				assert.Equal(t, 1, branchCalls)
+			} else {
+				assert.Equal(t, 3, branchCalls, "polling should stop after MaxAttempts")
+			}
+		})
+	}
+}
+
+func Test_SyncFork(t *testing.T) {
+	serverTool := SyncFork(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "sync_fork", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "branch"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expected       SyncForkResponse
+	}{
+		{
+			name: "fast-forwarded",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposMergeUpstreamByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"branch": "main",
+				}).andThen(mockResponse(t, http.StatusOK, &github.RepoMergeUpstreamResult{
+					Message:    github.Ptr("Successfully fetched and fast-forwarded from upstream upstream:main."),
+					MergeType:  github.Ptr("fast-forward"),
+					BaseBranch: github.Ptr("upstream:main"),
+				})),
+			}),
+			expected: SyncForkResponse{
+				Branch:     "main",
+				Status:     "fast_forwarded",
+				BaseBranch: "upstream:main",
+				Message:    "Successfully fetched and fast-forwarded from upstream upstream:main.",
+			},
+		},
+		{
+			name: "already up to date",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposMergeUpstreamByOwnerByRepo: mockResponse(t, http.StatusOK, &github.RepoMergeUpstreamResult{
+					Message:    github.Ptr("This branch is not behind the upstream upstream:main."),
+					MergeType:  github.Ptr("none"),
+					BaseBranch: github.Ptr("upstream:main"),
+				}),
+			}),
+			expected: SyncForkResponse{
+				Branch:     "main",
+				Status:     "up_to_date",
+				BaseBranch: "upstream:main",
+				Message:    "This branch is not behind the upstream upstream:main.",
+			},
+		},
+		{
+			name: "conflict",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposMergeUpstreamByOwnerByRepo: mockResponse(t, http.StatusConflict, map[string]string{
+					"message": "There are merge conflicts",
+				}),
+			}),
+			expected: SyncForkResponse{
+				Branch:  "main",
+				Status:  "conflict",
+				Message: "main cannot be synced with upstream because of conflicts; the branch was not changed",
+			},
+		},
+		{
+			name: "not a fork",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposMergeUpstreamByOwnerByRepo: mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+					"message": "This repository is not a fork",
+				}),
+			}),
+			expectError:    true,
+			expectedErrMsg: "failed to sync branch main of owner/repo with upstream",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response SyncForkResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
 func Test_CreateBranch(t *testing.T) {
 	// Verify tool definition once
 	serverTool := CreateBranch(translations.NullTranslationHelper)
@@ -1373,27 +1970,138 @@ func Test_GetCommit_Detail(t *testing.T) {
 			}
 			require.False(t, result.IsError)
 
-			var returned MinimalCommit
-			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			var returned MinimalCommit
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+
+			if tc.expectStats {
+				require.NotNil(t, returned.Stats)
+				assert.Equal(t, 12, returned.Stats.Total)
+			} else {
+				assert.Nil(t, returned.Stats)
+			}
+
+			if tc.expectFiles {
+				require.Len(t, returned.Files, 1)
+				assert.Equal(t, "file1.go", returned.Files[0].Filename)
+				if tc.expectPatch {
+					assert.Equal(t, "@@ -1,2 +1,10 @@\n+new line", returned.Files[0].Patch)
+				} else {
+					assert.Empty(t, returned.Files[0].Patch)
+				}
+			} else {
+				assert.Empty(t, returned.Files)
+			}
+		})
+	}
+}
+
+func Test_GetCombinedStatus(t *testing.T) {
+	serverTool := GetCombinedStatus(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_combined_status", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "ref")
+	assert.Contains(t, schema.Properties, "page")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "ref"})
+
+	mockStatus := &github.CombinedStatus{
+		State:      github.Ptr("failure"),
+		SHA:        github.Ptr("abc123"),
+		TotalCount: github.Ptr(2),
+		Statuses: []*github.RepoStatus{
+			{
+				Context:     github.Ptr("ci/jenkins"),
+				State:       github.Ptr("success"),
+				Description: github.Ptr("Build passed"),
+				TargetURL:   github.Ptr("https://ci.example.com/builds/1"),
+			},
+			{
+				Context:     github.Ptr("security/scan"),
+				State:       github.Ptr("failure"),
+				Description: github.Ptr("2 vulnerabilities found"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       MinimalCombinedStatus
+	}{
+		{
+			name: "returns overall state and each context",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsStatusByOwnerByRepoByRef: mockResponse(t, http.StatusOK, mockStatus),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
+			},
+			expected: MinimalCombinedStatus{
+				State:      "failure",
+				SHA:        "abc123",
+				TotalCount: 2,
+				Statuses: []MinimalCommitStatus{
+					{
+						Context:     "ci/jenkins",
+						State:       "success",
+						Description: "Build passed",
+						TargetURL:   "https://ci.example.com/builds/1",
+					},
+					{
+						Context:     "security/scan",
+						State:       "failure",
+						Description: "2 vulnerabilities found",
+					},
+				},
+			},
+		},
+		{
+			name: "ref not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsStatusByOwnerByRepoByRef: mockResponse(t, http.StatusNotFound, `{"message": "No commit found for SHA: missing"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get combined status for missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
 
-			if tc.expectStats {
-				require.NotNil(t, returned.Stats)
-				assert.Equal(t, 12, returned.Stats.Total)
-			} else {
-				assert.Nil(t, returned.Stats)
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
 			}
 
-			if tc.expectFiles {
-				require.Len(t, returned.Files, 1)
-				assert.Equal(t, "file1.go", returned.Files[0].Filename)
-				if tc.expectPatch {
-					assert.Equal(t, "@@ -1,2 +1,10 @@\n+new line", returned.Files[0].Patch)
-				} else {
-					assert.Empty(t, returned.Files[0].Patch)
-				}
-			} else {
-				assert.Empty(t, returned.Files)
-			}
+			require.False(t, result.IsError)
+			var response MinimalCombinedStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
 		})
 	}
 }
@@ -3282,14 +3990,13 @@ func Test_DeleteFile(t *testing.T) {
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 			// Verify results
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
@@ -3726,13 +4433,13 @@ func Test_ListReleases(t *testing.T) {
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
 			textContent := getTextResult(t, result)
 			var returnedReleases []MinimalRelease
 			err = json.Unmarshal([]byte(textContent.Text), &returnedReleases)
@@ -3818,13 +4525,13 @@ func Test_GetLatestRelease(t *testing.T) {
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
 			textContent := getTextResult(t, result)
 			var returnedRelease github.RepositoryRelease
 			err = json.Unmarshal([]byte(textContent.Text), &returnedRelease)
@@ -4871,6 +5578,133 @@ func Test_UnstarRepository(t *testing.T) {
 		})
 	}
 }
+func Test_ListStargazers(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListStargazers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_stargazers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	starredAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockStargazers := []*github.Stargazer{
+		{
+			StarredAt: &github.Timestamp{Time: starredAt},
+			User: &github.User{
+				Login: github.Ptr("user1"),
+				ID:    github.Ptr(int64(101)),
+			},
+		},
+		{
+			StarredAt: &github.Timestamp{Time: starredAt.Add(24 * time.Hour)},
+			User: &github.User{
+				Login: github.Ptr("user2"),
+				ID:    github.Ptr(int64(102)),
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		mockResponses []MockBackendOption
+		errContains   string
+	}{
+		{
+			name: "success",
+			args: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			mockResponses: []MockBackendOption{
+				WithRequestMatchHandler(
+					GetReposStargazersByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						// Timestamps are only returned with the star media type.
+						if !strings.Contains(r.Header.Get("Accept"), "application/vnd.github.star+json") {
+							w.WriteHeader(http.StatusBadRequest)
+							return
+						}
+						if r.URL.Query().Get("page") != "2" || r.URL.Query().Get("per_page") != "10" {
+							w.WriteHeader(http.StatusBadRequest)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(mockStargazers)
+					}),
+				),
+			},
+		},
+		{
+			name: "missing repo",
+			args: map[string]any{
+				"owner": "owner",
+			},
+			mockResponses: []MockBackendOption{},
+			errContains:   "missing required parameter: repo",
+		},
+		{
+			name: "repository not found",
+			args: map[string]any{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			mockResponses: []MockBackendOption{
+				WithRequestMatchHandler(
+					GetReposStargazersByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			},
+			errContains: "failed to list stargazers for owner/missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mustNewGHClient(t, NewMockedHTTPClient(tt.mockResponses...))
+			deps := BaseDeps{
+				Client: mockClient,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tt.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			if tt.errContains != "" {
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tt.errContains)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				Items []MinimalStargazer `json:"items"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			require.Len(t, response.Items, 2)
+			assert.Equal(t, MinimalStargazer{Login: "user1", ID: 101, StarredAt: "2024-03-01T12:00:00Z"}, response.Items[0])
+			assert.Equal(t, MinimalStargazer{Login: "user2", ID: 102, StarredAt: "2024-03-02T12:00:00Z"}, response.Items[1])
+		})
+	}
+}
+
 func Test_GetFileBlame(t *testing.T) {
 	// Verify tool definition once
 	serverTool := GetFileBlame(translations.NullTranslationHelper)
@@ -5680,3 +6514,178 @@ func Test_ListRepositoryCollaborators(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetFileHistory(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetFileHistory(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_file_history", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "max_commits")
+	assert.Contains(t, schema.Properties, "max_patch_bytes")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "path"})
+
+	commitDate := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockCommits := []*github.RepositoryCommit{
+		{
+			SHA: github.Ptr("sha-new"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Update main.go"),
+				Author: &github.CommitAuthor{
+					Name: github.Ptr("Test User"),
+					Date: &github.Timestamp{Time: commitDate},
+				},
+			},
+			Author: &github.User{Login: github.Ptr("testuser")},
+		},
+		{
+			SHA: github.Ptr("sha-old"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Add main.go"),
+				Author: &github.CommitAuthor{
+					Name: github.Ptr("No Login"),
+					Date: &github.Timestamp{Time: commitDate.Add(-24 * time.Hour)},
+				},
+			},
+		},
+	}
+	commitDetails := map[string]*github.RepositoryCommit{
+		"sha-new": {
+			SHA: github.Ptr("sha-new"),
+			Files: []*github.CommitFile{
+				{Filename: github.Ptr("README.md"), Status: github.Ptr("modified"), Patch: github.Ptr("@@ readme @@")},
+				{Filename: github.Ptr("src/main.go"), Status: github.Ptr("modified"), Patch: github.Ptr("@@ -1,2 +1,3 @@ long patch")},
+			},
+		},
+		"sha-old": {
+			SHA: github.Ptr("sha-old"),
+			Files: []*github.CommitFile{
+				{Filename: github.Ptr("src/main.go"), Status: github.Ptr("added"), Patch: github.Ptr("@@ -0,0 +1 @@")},
+			},
+		},
+	}
+	commitDetailHandler := func(w http.ResponseWriter, r *http.Request) {
+		sha := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		mockResponse(t, http.StatusOK, commitDetails[sha])(w, r)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       []FileHistoryEntry
+	}{
+		{
+			name: "returns commits with the file's patch",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"path":     "src/main.go",
+					"per_page": "10",
+				}).andThen(mockResponse(t, http.StatusOK, mockCommits)),
+				GetReposCommitsByOwnerByRepoByRef: commitDetailHandler,
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "src/main.go",
+			},
+			expected: []FileHistoryEntry{
+				{SHA: "sha-new", Author: "testuser", Date: "2024-05-01T12:00:00Z", Message: "Update main.go", Status: "modified", Patch: "@@ -1,2 +1,3 @@ long patch"},
+				{SHA: "sha-old", Author: "No Login", Date: "2024-04-30T12:00:00Z", Message: "Add main.go", Status: "added", Patch: "@@ -0,0 +1 @@"},
+			},
+		},
+		{
+			name: "truncates patches to max_patch_bytes",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo:      mockResponse(t, http.StatusOK, mockCommits[:1]),
+				GetReposCommitsByOwnerByRepoByRef: commitDetailHandler,
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"path":            "src/main.go",
+				"max_patch_bytes": float64(10),
+			},
+			expected: []FileHistoryEntry{
+				{SHA: "sha-new", Author: "testuser", Date: "2024-05-01T12:00:00Z", Message: "Update main.go", Status: "modified", Patch: "@@ -1,2 +1", PatchTruncated: true},
+			},
+		},
+		{
+			name: "zero max_patch_bytes skips commit detail requests",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: mockResponse(t, http.StatusOK, mockCommits[:1]),
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"path":            "src/main.go",
+				"max_patch_bytes": float64(0),
+			},
+			expected: []FileHistoryEntry{
+				{SHA: "sha-new", Author: "testuser", Date: "2024-05-01T12:00:00Z", Message: "Update main.go"},
+			},
+		},
+		{
+			name:         "max_commits above cap",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"path":        "src/main.go",
+				"max_commits": float64(31),
+			},
+			expectError:    true,
+			expectedErrMsg: "max_commits must be between 1 and 30",
+		},
+		{
+			name: "list commits fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "src/main.go",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commits for path: src/main.go",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned FileHistoryResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, "owner/repo", returned.Repository)
+			assert.Equal(t, "src/main.go", returned.Path)
+			assert.Equal(t, tc.expected, returned.Commits)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MinimalRuleset is the trimmed output type for a repository ruleset.
+type MinimalRuleset struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	// Target is "branch", "tag" or "push".
+	Target string `json:"target,omitempty"`
+	// Enforcement is "active", "evaluate" or "disabled".
+	Enforcement string `json:"enforcement"`
+	// SourceType and Source say where the ruleset is defined: the repository,
+	// or the organization or enterprise it belongs to.
+	SourceType           string `json:"source_type,omitempty"`
+	Source               string `json:"source"`
+	CurrentUserCanBypass string `json:"current_user_can_bypass,omitempty"`
+	HTMLURL              string `json:"html_url,omitempty"`
+	UpdatedAt            string `json:"updated_at,omitempty"`
+}
+
+// RulesetRule is one rule of a ruleset. Parameters are the rule's settings as
+// returned by the API, e.g. the required checks of a required_status_checks rule.
+type RulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// RulesetBypassActor is an actor allowed to bypass a ruleset.
+type RulesetBypassActor struct {
+	ActorID    int64  `json:"actor_id,omitempty"`
+	ActorType  string `json:"actor_type"`
+	BypassMode string `json:"bypass_mode,omitempty"`
+}
+
+// RulesetDetails is a ruleset with the refs it applies to, its rules and who may bypass it.
+type RulesetDetails struct {
+	MinimalRuleset
+	Conditions   *github.RepositoryRulesetConditions `json:"conditions,omitempty"`
+	Rules        []RulesetRule                       `json:"rules"`
+	BypassActors []RulesetBypassActor                `json:"bypass_actors,omitempty"`
+}
+
+func convertToMinimalRuleset(ruleset *github.RepositoryRuleset) MinimalRuleset {
+	minimal := MinimalRuleset{
+		ID:          ruleset.GetID(),
+		Name:        ruleset.Name,
+		Enforcement: string(ruleset.Enforcement),
+		Source:      ruleset.Source,
+	}
+	if ruleset.Target != nil {
+		minimal.Target = string(*ruleset.Target)
+	}
+	if ruleset.SourceType != nil {
+		minimal.SourceType = string(*ruleset.SourceType)
+	}
+	if ruleset.CurrentUserCanBypass != nil {
+		minimal.CurrentUserCanBypass = string(*ruleset.CurrentUserCanBypass)
+	}
+	if ruleset.Links != nil && ruleset.Links.HTML != nil {
+		minimal.HTMLURL = ruleset.Links.HTML.GetHRef()
+	}
+	if ruleset.UpdatedAt != nil {
+		minimal.UpdatedAt = ruleset.UpdatedAt.Format(time.RFC3339)
+	}
+	return minimal
+}
+
+func convertToRulesetDetails(ruleset *github.RepositoryRuleset) (RulesetDetails, error) {
+	details := RulesetDetails{
+		MinimalRuleset: convertToMinimalRuleset(ruleset),
+		Conditions:     ruleset.Conditions,
+		Rules:          []RulesetRule{},
+	}
+	if ruleset.Rules != nil {
+		// RepositoryRulesetRules marshals to the API's list of typed rules,
+		// which covers every rule type without mapping each one here.
+		raw, err := json.Marshal(ruleset.Rules)
+		if err != nil {
+			return details, fmt.Errorf("failed to marshal ruleset rules: %w", err)
+		}
+		if err := json.Unmarshal(raw, &details.Rules); err != nil {
+			return details, fmt.Errorf("failed to unmarshal ruleset rules: %w", err)
+		}
+	}
+	for _, actor := range ruleset.BypassActors {
+		bypass := RulesetBypassActor{ActorID: actor.GetActorID()}
+		if actor.ActorType != nil {
+			bypass.ActorType = string(*actor.ActorType)
+		}
+		if actor.BypassMode != nil {
+			bypass.BypassMode = string(*actor.BypassMode)
+		}
+		details.BypassActors = append(details.BypassActors, bypass)
+	}
+	return details, nil
+}
+
+// ListRepositoryRulesets creates a tool to list the rulesets that apply to a repository.
+func ListRepositoryRulesets(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "list_repository_rulesets",
+			Description: t("TOOL_LIST_REPOSITORY_RULESETS_DESCRIPTION",
+				"List the rulesets that apply to a repository, with their target and enforcement level. "+
+					"Use get_repository_ruleset to read a ruleset's rules."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_REPOSITORY_RULESETS_USER_TITLE", "List repository rulesets"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"includes_parents": {
+						Type:        "boolean",
+						Description: "Include rulesets defined by the organization or enterprise that apply to the repository",
+						Default:     json.RawMessage(`true`),
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includesParents, err := OptionalBoolParamWithDefault(args, "includes_parents", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, &github.RepositoryListRulesetsOptions{
+				IncludesParents: github.Ptr(includesParents),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository rulesets", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			minimalRulesets := make([]MinimalRuleset, 0, len(rulesets))
+			for _, ruleset := range rulesets {
+				minimalRulesets = append(minimalRulesets, convertToMinimalRuleset(ruleset))
+			}
+
+			result := MarshalledTextResult(minimalRulesets)
+			// Rulesets are repository configuration that only admins can change.
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
+
+// GetRepositoryRuleset creates a tool to get a repository ruleset and its rules.
+func GetRepositoryRuleset(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "get_repository_ruleset",
+			Description: t("TOOL_GET_REPOSITORY_RULESET_DESCRIPTION",
+				"Get a repository ruleset: its target and enforcement level, the refs it applies to, who may bypass it, "+
+					"and its rules, such as required status checks and pull request requirements."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_REPOSITORY_RULESET_USER_TITLE", "Get repository ruleset"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ruleset_id": {
+						Type:        "number",
+						Description: "Ruleset ID, as returned by list_repository_rulesets",
+					},
+				},
+				Required: []string{"owner", "repo", "ruleset_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			rulesetID, err := RequiredBigInt(args, "ruleset_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			// Ask for parent rulesets too, since list_repository_rulesets includes them by default.
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, rulesetID, true)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository ruleset", resp, err), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			details, err := convertToRulesetDetails(ruleset)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to convert ruleset", err), nil, nil
+			}
+
+			result := MarshalledTextResult(details)
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelRepoMetadata)
+			return result, nil, nil
+		},
+	)
+}
@@ -0,0 +1,271 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryRulesets(t *testing.T) {
+	serverTool := ListRepositoryRulesets(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repository_rulesets", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "includes_parents")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	rulesets := `[
+		{
+			"id": 42,
+			"name": "Protect main",
+			"target": "branch",
+			"source_type": "Repository",
+			"source": "owner/repo",
+			"enforcement": "active",
+			"current_user_can_bypass": "never",
+			"_links": {"html": {"href": "https://github.com/owner/repo/rules/42"}},
+			"updated_at": "2024-05-01T10:00:00Z"
+		},
+		{
+			"id": 7,
+			"name": "Org tag policy",
+			"target": "tag",
+			"source_type": "Organization",
+			"source": "owner",
+			"enforcement": "evaluate"
+		}
+	]`
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		requestArgs     map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expectedResult  []MinimalRuleset
+	}{
+		{
+			name: "lists rulesets including parents",
+			handlers: map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"includes_parents": "true",
+					"page":             "1",
+					"per_page":         "30",
+				}).andThen(mockResponse(t, http.StatusOK, rulesets)),
+			},
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: []MinimalRuleset{
+				{
+					ID:                   42,
+					Name:                 "Protect main",
+					Target:               "branch",
+					Enforcement:          "active",
+					SourceType:           "Repository",
+					Source:               "owner/repo",
+					CurrentUserCanBypass: "never",
+					HTMLURL:              "https://github.com/owner/repo/rules/42",
+					UpdatedAt:            "2024-05-01T10:00:00Z",
+				},
+				{
+					ID:          7,
+					Name:        "Org tag policy",
+					Target:      "tag",
+					Enforcement: "evaluate",
+					SourceType:  "Organization",
+					Source:      "owner",
+				},
+			},
+		},
+		{
+			name: "lists only the repository's own rulesets",
+			handlers: map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"includes_parents": "false",
+					"page":             "1",
+					"per_page":         "30",
+				}).andThen(mockResponse(t, http.StatusOK, `[]`)),
+			},
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"includes_parents": false,
+			},
+			expectedResult: []MinimalRuleset{},
+		},
+		{
+			name: "API error",
+			handlers: map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to list repository rulesets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response []MinimalRuleset
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedResult, response)
+		})
+	}
+}
+
+func Test_GetRepositoryRuleset(t *testing.T) {
+	serverTool := GetRepositoryRuleset(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_ruleset", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "ruleset_id"})
+
+	ruleset := `{
+		"id": 42,
+		"name": "Protect main",
+		"target": "branch",
+		"source_type": "Repository",
+		"source": "owner/repo",
+		"enforcement": "active",
+		"bypass_actors": [
+			{"actor_id": 5, "actor_type": "Team", "bypass_mode": "pull_request"}
+		],
+		"conditions": {
+			"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}
+		},
+		"rules": [
+			{"type": "deletion"},
+			{
+				"type": "pull_request",
+				"parameters": {
+					"dismiss_stale_reviews_on_push": true,
+					"require_code_owner_review": true,
+					"require_last_push_approval": false,
+					"required_approving_review_count": 2,
+					"required_review_thread_resolution": true
+				}
+			},
+			{
+				"type": "required_status_checks",
+				"parameters": {
+					"required_status_checks": [{"context": "build", "integration_id": 15368}],
+					"strict_required_status_checks_policy": true
+				}
+			}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		expectToolError bool
+		expectedErrMsg  string
+		validateResult  func(t *testing.T, result RulesetDetails)
+	}{
+		{
+			name: "gets a ruleset with its rules",
+			handlers: map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepoByRulesetID: expectQueryParams(t, map[string]string{
+					"includes_parents": "true",
+				}).andThen(mockResponse(t, http.StatusOK, ruleset)),
+			},
+			validateResult: func(t *testing.T, result RulesetDetails) {
+				assert.Equal(t, int64(42), result.ID)
+				assert.Equal(t, "branch", result.Target)
+				assert.Equal(t, "active", result.Enforcement)
+				require.NotNil(t, result.Conditions)
+				require.NotNil(t, result.Conditions.RefName)
+				assert.Equal(t, []string{"~DEFAULT_BRANCH"}, result.Conditions.RefName.Include)
+				assert.Equal(t, []RulesetBypassActor{{ActorID: 5, ActorType: "Team", BypassMode: "pull_request"}}, result.BypassActors)
+
+				require.Len(t, result.Rules, 3)
+				types := make([]string, 0, len(result.Rules))
+				parameters := map[string]map[string]any{}
+				for _, rule := range result.Rules {
+					types = append(types, rule.Type)
+					if len(rule.Parameters) > 0 {
+						var p map[string]any
+						require.NoError(t, json.Unmarshal(rule.Parameters, &p))
+						parameters[rule.Type] = p
+					}
+				}
+				assert.ElementsMatch(t, []string{"deletion", "pull_request", "required_status_checks"}, types)
+				assert.Equal(t, float64(2), parameters["pull_request"]["required_approving_review_count"])
+				assert.Equal(t, true, parameters["required_status_checks"]["strict_required_status_checks_policy"])
+			},
+		},
+		{
+			name: "ruleset not found",
+			handlers: map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepoByRulesetID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			expectToolError: true,
+			expectedErrMsg:  "failed to get repository ruleset",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"ruleset_id": float64(42),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response RulesetDetails
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			tc.validateResult(t, response)
+		})
+	}
+}
@@ -0,0 +1,409 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// bytesPerToken is the rough ratio used to turn a byte count into a
+	// token count.
+	bytesPerToken = 4
+	// estimatedDirectoryEntryBytes is the rough size of one entry in a
+	// get_file_contents directory listing.
+	estimatedDirectoryEntryBytes = 500
+	// estimatedTreeEntryBytes is the rough size of one entry in a
+	// get_repository_tree response.
+	estimatedTreeEntryBytes = 250
+)
+
+// ResponseSizeEstimate is the output of estimate_response_size.
+type ResponseSizeEstimate struct {
+	Tool string `json:"tool"`
+	// ContentBytes is the size of the content the tool would read.
+	ContentBytes int64 `json:"content_bytes"`
+	// MaxResponseBytes is the most content the tool returns with the given
+	// arguments, when it caps it.
+	MaxResponseBytes int64 `json:"max_response_bytes,omitempty"`
+	EstimatedTokens  int64 `json:"estimated_tokens"`
+	// Basis says what ContentBytes was measured from.
+	Basis string `json:"basis"`
+}
+
+// responseSizeEstimator measures the content a tool would read from the
+// tool's arguments, using only cheap metadata calls.
+type responseSizeEstimator func(ctx context.Context, deps ToolDependencies, args map[string]any) (ResponseSizeEstimate, *mcp.CallToolResult)
+
+var responseSizeEstimators = map[string]responseSizeEstimator{
+	"get_file_contents":     estimateFileContentsSize,
+	"get_blob":              estimateBlobSize,
+	"get_job_logs":          estimateJobLogsSize,
+	"get_artifact_contents": estimateArtifactContentsSize,
+	"get_repository_tree":   estimateRepositoryTreeSize,
+}
+
+// EstimateResponseSize creates a tool that estimates how large another tool's
+// response would be without fetching the content.
+func EstimateResponseSize(t translations.TranslationHelperFunc) inventory.ServerTool {
+	supported := slices.Sorted(maps.Keys(responseSizeEstimators))
+
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "estimate_response_size",
+			Description: t("TOOL_ESTIMATE_RESPONSE_SIZE_DESCRIPTION",
+				"Estimate the size in bytes and tokens of another tool's response without fetching its content, using only cheap metadata lookups. "+
+					"Use this before reading a file, blob, tree, job log or artifact that may be too large for the context window. "+
+					"Supported tools: "+strings.Join(supported, ", ")+" (with the server's tool name prefix, if any)."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_ESTIMATE_RESPONSE_SIZE_USER_TITLE", "Estimate response size"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"tool": {
+						Type:        "string",
+						Description: "Name of the tool whose response to estimate, as listed by the server",
+					},
+					"arguments": {
+						Type:        "object",
+						Description: "The arguments the tool would be called with",
+					},
+				},
+				Required: []string{"tool", "arguments"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			toolName, err := RequiredParam[string](args, "tool")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			toolArgs, err := OptionalParam[map[string]any](args, "arguments")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			estimator, ok := lookupResponseSizeEstimator(toolName)
+			if !ok {
+				return utils.NewToolResultError(fmt.Sprintf("cannot estimate the response size of %q; supported tools: %s", toolName, strings.Join(supported, ", "))), nil, nil
+			}
+
+			owner, repo, errResult := requiredOwnerRepo(toolArgs)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			estimate, errResult := estimator(ctx, deps, toolArgs)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+			estimate.Tool = toolName
+			returned := estimate.ContentBytes
+			if estimate.MaxResponseBytes > 0 {
+				returned = min(returned, estimate.MaxResponseBytes)
+			}
+			estimate.EstimatedTokens = (returned + bytesPerToken - 1) / bytesPerToken
+
+			// Sizes are derived from the repository's content, so the
+			// estimate is as confidential as the repository itself.
+			result := MarshalledTextResult(estimate)
+			return attachRepoVisibilityIFCLabelLazy(ctx, deps, owner, repo, result, ifc.LabelRepoMetadata), nil, nil
+		},
+	)
+}
+
+// lookupResponseSizeEstimator finds the estimator for a tool name as the
+// client sees it. The server may register tools under a --tool-name-prefix,
+// which this tool cannot know when it is built, so any prefix made of the
+// characters a prefix allows is accepted in front of a supported name.
+func lookupResponseSizeEstimator(toolName string) (responseSizeEstimator, bool) {
+	if estimator, ok := responseSizeEstimators[toolName]; ok {
+		return estimator, true
+	}
+	for name, estimator := range responseSizeEstimators {
+		prefix, ok := strings.CutSuffix(toolName, name)
+		if ok && inventory.IsValidToolNamePrefix(prefix) {
+			return estimator, true
+		}
+	}
+	return nil, false
+}
+
+// gitObjectSizeQuery reads the size of the git object a revision expression,
+// such as "main:README.md" or a blob SHA, resolves to.
+type gitObjectSizeQuery struct {
+	Repository struct {
+		Object struct {
+			Typename githubv4.String `graphql:"__typename"`
+			Blob     struct {
+				ByteSize githubv4.Int
+				IsBinary *githubv4.Boolean
+			} `graphql:"... on Blob"`
+			Tree struct {
+				Entries []struct {
+					Name githubv4.String
+				}
+			} `graphql:"... on Tree"`
+		} `graphql:"object(expression: $expression)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+func queryGitObjectSize(ctx context.Context, deps ToolDependencies, owner, repo, expression string) (*gitObjectSizeQuery, *mcp.CallToolResult) {
+	client, err := deps.GetGQLClient(ctx)
+	if err != nil {
+		return nil, utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err)
+	}
+	var query gitObjectSizeQuery
+	vars := map[string]any{
+		"owner":      githubv4.String(owner),
+		"repo":       githubv4.String(repo),
+		"expression": githubv4.String(expression),
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return nil, ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to look up %q", expression), err)
+	}
+	if query.Repository.Object.Typename == "" {
+		return nil, utils.NewToolResultError(fmt.Sprintf("%q was not found in %s/%s", expression, owner, repo))
+	}
+	return &query, nil
+}
+
+func estimateFileContentsSize(ctx context.Context, deps ToolDependencies, args map[string]any) (ResponseSizeEstimate, *mcp.CallToolResult) {
+	owner, repo, errResult := requiredOwnerRepo(args)
+	if errResult != nil {
+		return ResponseSizeEstimate{}, errResult
+	}
+	path, err := OptionalParam[string](args, "path")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	ref, err := OptionalParam[string](args, "ref")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	sha, err := OptionalParam[string](args, "sha")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	revision := "HEAD"
+	switch {
+	case sha != "":
+		revision = sha
+	case ref != "":
+		revision = ref
+	}
+
+	query, errResult := queryGitObjectSize(ctx, deps, owner, repo, revision+":"+strings.Trim(path, "/"))
+	if errResult != nil {
+		return ResponseSizeEstimate{}, errResult
+	}
+	object := query.Repository.Object
+	switch object.Typename {
+	case "Blob":
+		size := int64(object.Blob.ByteSize)
+		if object.Blob.IsBinary != nil && bool(*object.Blob.IsBinary) {
+			// Binary files are returned base64 encoded.
+			return ResponseSizeEstimate{
+				ContentBytes: (size + 2) / 3 * 4,
+				Basis:        "file size, base64 encoded because the file is binary",
+			}, nil
+		}
+		return ResponseSizeEstimate{ContentBytes: size, Basis: "file size"}, nil
+	case "Tree":
+		entries := int64(len(object.Tree.Entries))
+		return ResponseSizeEstimate{
+			ContentBytes: entries * estimatedDirectoryEntryBytes,
+			Basis:        fmt.Sprintf("directory listing of %d entries", entries),
+		}, nil
+	default:
+		return ResponseSizeEstimate{}, utils.NewToolResultError(fmt.Sprintf("path resolved to a %s, not a file or directory", object.Typename))
+	}
+}
+
+func estimateBlobSize(ctx context.Context, deps ToolDependencies, args map[string]any) (ResponseSizeEstimate, *mcp.CallToolResult) {
+	owner, repo, errResult := requiredOwnerRepo(args)
+	if errResult != nil {
+		return ResponseSizeEstimate{}, errResult
+	}
+	sha, err := RequiredParam[string](args, "sha")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	maxBytes, err := OptionalIntParamWithDefault(args, "max_bytes", defaultBlobMaxBytes)
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+
+	query, errResult := queryGitObjectSize(ctx, deps, owner, repo, sha)
+	if errResult != nil {
+		return ResponseSizeEstimate{}, errResult
+	}
+	if query.Repository.Object.Typename != "Blob" {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(fmt.Sprintf("%s is a %s, not a blob", sha, query.Repository.Object.Typename))
+	}
+	return ResponseSizeEstimate{
+		ContentBytes:     int64(query.Repository.Object.Blob.ByteSize),
+		MaxResponseBytes: int64(maxBytes),
+		Basis:            "blob size",
+	}, nil
+}
+
+func estimateJobLogsSize(ctx context.Context, deps ToolDependencies, args map[string]any) (ResponseSizeEstimate, *mcp.CallToolResult) {
+	owner, repo, errResult := requiredOwnerRepo(args)
+	if errResult != nil {
+		return ResponseSizeEstimate{}, errResult
+	}
+	jobID, err := OptionalIntParam(args, "job_id")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	if jobID == 0 {
+		return ResponseSizeEstimate{}, utils.NewToolResultError("job_id is required; failed_only estimates are not supported")
+	}
+
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultErrorFromErr("failed to get GitHub client", err)
+	}
+	logURL, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, int64(jobID), 1)
+	if err != nil {
+		return ResponseSizeEstimate{}, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get job logs URL", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	headReq, err := http.NewRequestWithContext(ctx, http.MethodHead, logURL.String(), nil)
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultErrorFromErr("failed to create log size request", err)
+	}
+	// The log URL is signed and points outside the API, so it is sent
+	// without the user's token.
+	headResp, err := signedURLClient.Do(headReq) //nolint:gosec // the URL comes from the GitHub API
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultErrorFromErr("failed to read log size", err)
+	}
+	_ = headResp.Body.Close()
+	if headResp.StatusCode != http.StatusOK || headResp.ContentLength < 0 {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(fmt.Sprintf("log size is unavailable (HTTP %d)", headResp.StatusCode))
+	}
+
+	return ResponseSizeEstimate{
+		ContentBytes: headResp.ContentLength,
+		Basis:        "full log size; with return_content only the last tail_lines lines are returned, otherwise just a download URL",
+	}, nil
+}
+
+func estimateArtifactContentsSize(ctx context.Context, deps ToolDependencies, args map[string]any) (ResponseSizeEstimate, *mcp.CallToolResult) {
+	owner, repo, errResult := requiredOwnerRepo(args)
+	if errResult != nil {
+		return ResponseSizeEstimate{}, errResult
+	}
+	artifactID, err := RequiredBigInt(args, "artifact_id")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	path, err := OptionalParam[string](args, "path")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	maxBytes, err := OptionalIntParamWithDefault(args, "max_bytes", defaultArtifactEntryMaxBytes)
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultErrorFromErr("failed to get GitHub client", err)
+	}
+	artifact, resp, err := client.Actions.GetArtifact(ctx, owner, repo, artifactID)
+	if err != nil {
+		return ResponseSizeEstimate{}, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	estimate := ResponseSizeEstimate{
+		ContentBytes: artifact.GetSizeInBytes(),
+		Basis:        "compressed artifact size; the response lists the artifact's files",
+	}
+	if path != "" {
+		estimate.MaxResponseBytes = int64(maxBytes)
+		estimate.Basis = "compressed artifact size; the response lists the artifact's files and at most max_bytes of the file at path"
+	}
+	return estimate, nil
+}
+
+func estimateRepositoryTreeSize(ctx context.Context, deps ToolDependencies, args map[string]any) (ResponseSizeEstimate, *mcp.CallToolResult) {
+	owner, repo, errResult := requiredOwnerRepo(args)
+	if errResult != nil {
+		return ResponseSizeEstimate{}, errResult
+	}
+	treeSHA, err := OptionalParam[string](args, "tree_sha")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	recursive, err := OptionalBoolParamWithDefault(args, "recursive", false)
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	pathFilter, err := OptionalParam[string](args, "path_filter")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	maxEntries, err := OptionalIntParam(args, "max_entries")
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultError(err.Error())
+	}
+	if treeSHA == "" {
+		treeSHA = "HEAD"
+	}
+
+	client, err := deps.GetClient(ctx)
+	if err != nil {
+		return ResponseSizeEstimate{}, utils.NewToolResultErrorFromErr("failed to get GitHub client", err)
+	}
+	tree, resp, err := client.Git.GetTree(ctx, owner, repo, treeSHA, recursive)
+	if err != nil {
+		return ResponseSizeEstimate{}, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository tree", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	var entries int64
+	for _, entry := range tree.Entries {
+		if pathFilter == "" || strings.HasPrefix(entry.GetPath(), pathFilter) {
+			entries++
+		}
+	}
+	estimate := ResponseSizeEstimate{
+		ContentBytes: entries * estimatedTreeEntryBytes,
+		Basis:        fmt.Sprintf("tree listing of %d entries", entries),
+	}
+	if maxEntries > 0 {
+		estimate.MaxResponseBytes = int64(maxEntries) * estimatedTreeEntryBytes
+	}
+	return estimate, nil
+}
+
+func requiredOwnerRepo(args map[string]any) (string, string, *mcp.CallToolResult) {
+	owner, err := RequiredParam[string](args, "owner")
+	if err != nil {
+		return "", "", utils.NewToolResultError(err.Error())
+	}
+	repo, err := RequiredParam[string](args, "repo")
+	if err != nil {
+		return "", "", utils.NewToolResultError(err.Error())
+	}
+	return owner, repo, nil
+}
@@ -0,0 +1,232 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EstimateResponseSize(t *testing.T) {
+	serverTool := EstimateResponseSize(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "estimate_response_size", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"tool", "arguments"})
+
+	const objectQuery = "query($expression:String!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){object(expression: $expression){__typename,... on Blob{byteSize,isBinary},... on Tree{entries{name}}}}}"
+	gqlClient := func(expression string, object map[string]any) *githubv4.Client {
+		matcher := githubv4mock.NewQueryMatcher(objectQuery, map[string]any{
+			"owner":      "owner",
+			"repo":       "repo",
+			"expression": expression,
+		}, githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{"object": object},
+		}))
+		return githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+	}
+
+	// The signed log URL must be sized without the user's token.
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		assert.Empty(t, r.Header.Get("Authorization"), "log size request sent credentials")
+		w.Header().Set("Content-Length", "12345")
+	}))
+	defer logServer.Close()
+
+	restClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposActionsArtifactsByOwnerByRepoByArtifactID: mockResponse(t, http.StatusOK, &github.Artifact{
+			ID:          github.Ptr(int64(7)),
+			SizeInBytes: github.Ptr(int64(2_000_000)),
+		}),
+		GetReposActionsJobsLogsByOwnerByRepoByJobID: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Location", logServer.URL+"/jobs/42.txt")
+			w.WriteHeader(http.StatusFound)
+		},
+		GetReposGitTreesByOwnerByRepoByTree: mockResponse(t, http.StatusOK, &github.Tree{
+			SHA: github.Ptr("abc123"),
+			Entries: []*github.TreeEntry{
+				{Path: github.Ptr("README.md"), Type: github.Ptr("blob")},
+				{Path: github.Ptr("src/main.go"), Type: github.Ptr("blob")},
+				{Path: github.Ptr("src/util.go"), Type: github.Ptr("blob")},
+			},
+		}),
+	})
+
+	tests := []struct {
+		name            string
+		gqlClient       *githubv4.Client
+		args            map[string]any
+		expectToolError bool
+		expectedErrMsg  string
+		expected        ResponseSizeEstimate
+	}{
+		{
+			name: "text file at a ref",
+			gqlClient: gqlClient("main:docs/README.md", map[string]any{
+				"__typename": "Blob", "byteSize": 4001, "isBinary": false,
+			}),
+			args: map[string]any{
+				"tool":      "get_file_contents",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "path": "/docs/README.md", "ref": "main"},
+			},
+			expected: ResponseSizeEstimate{Tool: "get_file_contents", ContentBytes: 4001, EstimatedTokens: 1001, Basis: "file size"},
+		},
+		{
+			name: "binary file grows when base64 encoded",
+			gqlClient: gqlClient("HEAD:logo.png", map[string]any{
+				"__typename": "Blob", "byteSize": 300, "isBinary": true,
+			}),
+			args: map[string]any{
+				"tool":      "get_file_contents",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "path": "logo.png"},
+			},
+			expected: ResponseSizeEstimate{
+				Tool: "get_file_contents", ContentBytes: 400, EstimatedTokens: 100,
+				Basis: "file size, base64 encoded because the file is binary",
+			},
+		},
+		{
+			name: "directory listing",
+			gqlClient: gqlClient("HEAD:", map[string]any{
+				"__typename": "Tree", "entries": []any{map[string]any{"name": "a"}, map[string]any{"name": "b"}},
+			}),
+			args: map[string]any{
+				"tool":      "get_file_contents",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "path": "/"},
+			},
+			expected: ResponseSizeEstimate{
+				Tool: "get_file_contents", ContentBytes: 1000, EstimatedTokens: 250,
+				Basis: "directory listing of 2 entries",
+			},
+		},
+		{
+			name:      "missing path",
+			gqlClient: gqlClient("HEAD:missing", nil),
+			args: map[string]any{
+				"tool":      "get_file_contents",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "path": "missing"},
+			},
+			expectToolError: true,
+			expectedErrMsg:  `"HEAD:missing" was not found in owner/repo`,
+		},
+		{
+			name: "blob capped at max_bytes",
+			gqlClient: gqlClient("abc123", map[string]any{
+				"__typename": "Blob", "byteSize": 50000, "isBinary": false,
+			}),
+			args: map[string]any{
+				"tool":      "get_blob",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "sha": "abc123", "max_bytes": float64(1000)},
+			},
+			expected: ResponseSizeEstimate{
+				Tool: "get_blob", ContentBytes: 50000, MaxResponseBytes: 1000, EstimatedTokens: 250, Basis: "blob size",
+			},
+		},
+		{
+			name: "job log size from a HEAD request",
+			args: map[string]any{
+				"tool":      "get_job_logs",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "job_id": float64(42)},
+			},
+			expected: ResponseSizeEstimate{
+				Tool: "get_job_logs", ContentBytes: 12345, EstimatedTokens: 3087,
+				Basis: "full log size; with return_content only the last tail_lines lines are returned, otherwise just a download URL",
+			},
+		},
+		{
+			name: "tool name with a prefix",
+			args: map[string]any{
+				"tool":      "github_get_job_logs",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "job_id": float64(42)},
+			},
+			expected: ResponseSizeEstimate{
+				Tool: "github_get_job_logs", ContentBytes: 12345, EstimatedTokens: 3087,
+				Basis: "full log size; with return_content only the last tail_lines lines are returned, otherwise just a download URL",
+			},
+		},
+		{
+			name: "tree entries under a path",
+			args: map[string]any{
+				"tool":      "get_repository_tree",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "recursive": true, "path_filter": "src/", "max_entries": float64(1)},
+			},
+			expected: ResponseSizeEstimate{
+				Tool: "get_repository_tree", ContentBytes: 500, MaxResponseBytes: 250, EstimatedTokens: 63,
+				Basis: "tree listing of 2 entries",
+			},
+		},
+		{
+			name: "job logs without job_id",
+			args: map[string]any{
+				"tool":      "get_job_logs",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "run_id": float64(1), "failed_only": true},
+			},
+			expectToolError: true,
+			expectedErrMsg:  "job_id is required",
+		},
+		{
+			name: "artifact entry capped at max_bytes",
+			args: map[string]any{
+				"tool":      "get_artifact_contents",
+				"arguments": map[string]any{"owner": "owner", "repo": "repo", "artifact_id": float64(7), "path": "out.log"},
+			},
+			expected: ResponseSizeEstimate{
+				Tool: "get_artifact_contents", ContentBytes: 2_000_000, MaxResponseBytes: defaultArtifactEntryMaxBytes,
+				EstimatedTokens: defaultArtifactEntryMaxBytes / 4,
+				Basis:           "compressed artifact size; the response lists the artifact's files and at most max_bytes of the file at path",
+			},
+		},
+		{
+			name:            "unsupported tool",
+			args:            map[string]any{"tool": "list_issues", "arguments": map[string]any{}},
+			expectToolError: true,
+			expectedErrMsg:  `cannot estimate the response size of "list_issues"; supported tools: get_artifact_contents, get_blob, get_file_contents, get_job_logs, get_repository_tree`,
+		},
+		{
+			name:            "missing owner",
+			args:            map[string]any{"tool": "get_blob", "arguments": map[string]any{"repo": "repo", "sha": "abc"}},
+			expectToolError: true,
+			expectedErrMsg:  "missing required parameter: owner",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    mustNewAuthenticatedGHClient(t, restClient),
+				GQLClient: tc.gqlClient,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var estimate ResponseSizeEstimate
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &estimate))
+			assert.Equal(t, tc.expected, estimate)
+		})
+	}
+}
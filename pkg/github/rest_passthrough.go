@@ -0,0 +1,182 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GitHubAPIGetToolName is the name of the REST passthrough tool. The tool is
+// not part of AllTools: the server only offers it when started with
+// --enable-rest-passthrough.
+const GitHubAPIGetToolName = "github_api_get"
+
+// maxAPIGetResponseBytes caps how much of a response body github_api_get
+// returns.
+const maxAPIGetResponseBytes = 1024 * 1024
+
+// APIGetResponse is the output of github_api_get.
+type APIGetResponse struct {
+	// Body is the response body: the JSON itself when it was read in full,
+	// otherwise the text that was read, as a JSON string.
+	Body json.RawMessage `json:"body"`
+	// Truncated reports that the body was longer than the tool returns.
+	Truncated bool `json:"truncated"`
+}
+
+// validateAPIPath checks that path is an API path on the configured host and
+// returns it relative to the client's base URL, so it also resolves under the
+// /api/v3/ prefix of GitHub Enterprise Server.
+func validateAPIPath(path string) (string, error) {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return "", fmt.Errorf("path must be an API path starting with a single /, e.g. /repos/owner/repo/community/profile")
+	}
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid path: %w", err)
+	}
+	if u.Scheme != "" || u.Host != "" || u.User != nil {
+		return "", fmt.Errorf("path must not include a scheme or host")
+	}
+	if u.Fragment != "" {
+		return "", fmt.Errorf("path must not include a fragment")
+	}
+	// u.Path is decoded, so this also catches encoded dot segments and slashes.
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == "." || segment == ".." {
+			return "", fmt.Errorf("path must not contain . or .. segments")
+		}
+	}
+	if strings.Contains(u.RawPath, "%2F") || strings.Contains(u.RawPath, "%2f") || strings.Contains(u.Path, "\\") {
+		return "", fmt.Errorf("path must not contain encoded slashes or backslashes")
+	}
+	return strings.TrimPrefix(path, "/"), nil
+}
+
+// GitHubAPIGet creates a tool that sends an authenticated GET request to a
+// GitHub REST API path and returns the response body unchanged.
+func GitHubAPIGet(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		// Registration bypasses toolset selection; see GitHubAPIGetToolName.
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: GitHubAPIGetToolName,
+			Description: t("TOOL_GITHUB_API_GET_DESCRIPTION",
+				"Send a GET request to a GitHub REST API path, such as /repos/owner/repo/community/profile, and return the JSON response. "+
+					"Use it for endpoints no other tool covers. Only GET requests to the configured GitHub host are allowed. "+
+					"Bodies over 1 MiB are cut short and reported with 'truncated: true'; binary content and downloads that redirect to another host, such as archives, are refused."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:         t("TOOL_GITHUB_API_GET_USER_TITLE", "Get GitHub API path"),
+				ReadOnlyHint:  true,
+				OpenWorldHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": {
+						Type:        "string",
+						Description: "API path starting with /, optionally with a query string, e.g. /repos/owner/repo/traffic/views?per=week",
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			path, err := RequiredParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			relativePath, err := validateAPIPath(path)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			req, err := client.NewRequest(ctx, http.MethodGet, relativePath, nil)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to create request", err), nil, nil
+			}
+
+			// Follow redirects within the API host, such as for renamed
+			// repositories, but stop at ones to other hosts: those are signed
+			// downloads of archives and other binary content.
+			httpClient := client.Client()
+			httpClient.CheckRedirect = func(redirect *http.Request, via []*http.Request) error {
+				if redirect.URL.Host != via[0].URL.Host || len(via) >= 10 {
+					return http.ErrUseLastResponse
+				}
+				return nil
+			}
+			httpResp, err := httpClient.Do(req)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr(fmt.Sprintf("failed to get %s", path), err), nil, nil
+			}
+			defer func() { _ = httpResp.Body.Close() }()
+
+			resp := &github.Response{Response: httpResp}
+			if httpResp.StatusCode >= http.StatusMultipleChoices && httpResp.StatusCode < http.StatusBadRequest {
+				return utils.NewToolResultError(fmt.Sprintf("%s redirects to a download on another host, which github_api_get does not follow; use a dedicated tool for archives and other binary content", path)), nil, nil
+			}
+			if err := github.CheckResponse(httpResp); err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get %s", path), resp, err), nil, nil
+			}
+			if !isTextContentType(httpResp.Header.Get("Content-Type")) {
+				return utils.NewToolResultError(fmt.Sprintf("%s returned %s content; github_api_get only returns JSON and text", path, httpResp.Header.Get("Content-Type"))), nil, nil
+			}
+
+			body, err := io.ReadAll(io.LimitReader(httpResp.Body, maxAPIGetResponseBytes+1))
+			if err != nil {
+				return utils.NewToolResultErrorFromErr(fmt.Sprintf("failed to read %s", path), err), nil, nil
+			}
+			response := APIGetResponse{Truncated: len(body) > maxAPIGetResponseBytes}
+			if response.Truncated {
+				body = body[:maxAPIGetResponseBytes]
+			}
+			if !response.Truncated && json.Valid(body) {
+				response.Body = body
+			} else {
+				// strings.ToValidUTF8 drops a character cut at the limit.
+				response.Body, _ = json.Marshal(strings.ToValidUTF8(string(body), ""))
+			}
+
+			result := MarshalledTextResult(response)
+			// The path can reach anything the token can read, so label the result
+			// with the most restrictive label.
+			result = attachStaticIFCLabel(ctx, deps, result, ifc.PrivateUntrusted())
+			return result, nil, nil
+		},
+	)
+}
+
+// isTextContentType reports whether a response Content-Type is JSON or text.
+// Responses without a Content-Type are let through rather than guessed at.
+func isTextContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
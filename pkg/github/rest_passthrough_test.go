@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateAPIPath(t *testing.T) {
+	tests := []struct {
+		path        string
+		expected    string
+		expectedErr string
+	}{
+		{path: "/repos/owner/repo/community/profile", expected: "repos/owner/repo/community/profile"},
+		{path: "/repos/owner/repo/traffic/views?per=week", expected: "repos/owner/repo/traffic/views?per=week"},
+		{path: "repos/owner/repo", expectedErr: "must be an API path"},
+		{path: "https://evil.example.com/repos", expectedErr: "must be an API path"},
+		{path: "//evil.example.com/repos", expectedErr: "must be an API path"},
+		{path: "/repos/owner/repo/../../../user", expectedErr: "must not contain . or .. segments"},
+		{path: "/repos/%2e%2e/user", expectedErr: "must not contain . or .. segments"},
+		{path: "/repos/owner%2F..%2Fuser", expectedErr: "must not contain . or .. segments"},
+		{path: "/repos/owner%2Frepo", expectedErr: "must not contain encoded slashes"},
+		{path: "/repos/owner/repo#readme", expectedErr: "must not include a fragment"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			relativePath, err := validateAPIPath(tc.path)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, relativePath)
+		})
+	}
+}
+
+func Test_GitHubAPIGet(t *testing.T) {
+	serverTool := GitHubAPIGet(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "github_api_get", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.ElementsMatch(t, schema.Required, []string{"path"})
+
+	profile := `{"health_percentage": 85, "files": {"readme": {"url": "https://api.github.com/repos/owner/repo/readme"}}}`
+
+	tests := []struct {
+		name            string
+		handlers        map[string]http.HandlerFunc
+		path            string
+		expectToolError bool
+		expectedErrMsg  string
+		expectedResult  string
+		expectTruncated bool
+	}{
+		{
+			name: "returns the response body",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/community/profile": mockResponse(t, http.StatusOK, profile),
+			},
+			path:           "/repos/owner/repo/community/profile",
+			expectedResult: profile,
+		},
+		{
+			name: "passes the query string",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/traffic/views": expectQueryParams(t, map[string]string{
+					"per": "week",
+				}).andThen(mockResponse(t, http.StatusOK, `{"count": 3, "views": []}`)),
+			},
+			path:           "/repos/owner/repo/traffic/views?per=week",
+			expectedResult: `{"count": 3, "views": []}`,
+		},
+		{
+			name: "follows redirects on the API host",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/old-name/community/profile": func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Location", "https://api.github.com/repositories/42/community/profile")
+					w.WriteHeader(http.StatusMovedPermanently)
+				},
+				"GET /repositories/42/community/profile": mockResponse(t, http.StatusOK, profile),
+			},
+			path:           "/repos/owner/old-name/community/profile",
+			expectedResult: profile,
+		},
+		{
+			name: "truncates long bodies",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/contributors": func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Content-Type", "application/json; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`[` + strings.Repeat(`{"login":"octocat"},`, maxAPIGetResponseBytes/10) + `{}]`))
+				},
+			},
+			path:            "/repos/owner/repo/contributors",
+			expectTruncated: true,
+		},
+		{
+			name: "refuses redirects to another host",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/zipball/main": func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Location", "https://codeload.github.com/owner/repo/legacy.zip/main?token=secret")
+					w.WriteHeader(http.StatusFound)
+				},
+				"GET /owner/repo/legacy.zip/main": func(_ http.ResponseWriter, _ *http.Request) {
+					t.Error("followed a redirect to another host")
+				},
+			},
+			path:            "/repos/owner/repo/zipball/main",
+			expectToolError: true,
+			expectedErrMsg:  "/repos/owner/repo/zipball/main redirects to a download on another host",
+		},
+		{
+			name: "refuses binary content",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/releases/assets/1": func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Content-Type", "application/octet-stream")
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte{0x7f, 'E', 'L', 'F'})
+				},
+			},
+			path:            "/repos/owner/repo/releases/assets/1",
+			expectToolError: true,
+			expectedErrMsg:  "returned application/octet-stream content",
+		},
+		{
+			name:            "rejects a path outside the API host",
+			path:            "https://evil.example.com/repos/owner/repo",
+			expectToolError: true,
+			expectedErrMsg:  "path must be an API path",
+		},
+		{
+			name: "reports API errors",
+			handlers: map[string]http.HandlerFunc{
+				"GET /repos/owner/repo/community/profile": mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			},
+			path:            "/repos/owner/repo/community/profile",
+			expectToolError: true,
+			expectedErrMsg:  "failed to get /repos/owner/repo/community/profile",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(tc.handlers)),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{"path": tc.path})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectToolError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response APIGetResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectTruncated, response.Truncated)
+			if tc.expectTruncated {
+				var partial string
+				require.NoError(t, json.Unmarshal(response.Body, &partial))
+				assert.Len(t, partial, maxAPIGetResponseBytes)
+				return
+			}
+			assert.JSONEq(t, tc.expectedResult, string(response.Body))
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/ifc"
@@ -45,6 +48,7 @@ func SearchRepositories(t translations.TranslationHelperFunc) inventory.ServerTo
 		},
 		Required: []string{"query"},
 	}
+	schema.Properties["count_only"] = countOnlySchemaProperty()
 	WithPagination(schema)
 
 	return NewTool(
@@ -72,7 +76,11 @@ func SearchRepositories(t translations.TranslationHelperFunc) inventory.ServerTo
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			countOnly, err := OptionalParam[bool](args, "count_only")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -93,6 +101,9 @@ func SearchRepositories(t translations.TranslationHelperFunc) inventory.ServerTo
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
+			if countOnly {
+				opts.ListOptions = countOnlyListOptions
+			}
 			result, resp, err := client.Search.Repositories(ctx, query, opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -111,6 +122,11 @@ func SearchRepositories(t translations.TranslationHelperFunc) inventory.ServerTo
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to search repositories", resp, body), nil, nil
 			}
 
+			if countOnly {
+				// Repository counts can include private repositories.
+				return searchCountResult(ctx, deps, result.Total, result.IncompleteResults, ifc.PrivateTrusted()), nil, nil
+			}
+
 			// Return either minimal or full response based on parameter
 			var r []byte
 			if minimalOutput {
@@ -244,6 +260,7 @@ func searchCodeTool(t translations.TranslationHelperFunc, includeFields bool) in
 			codeSearchItemFieldEnum,
 		)
 	}
+	schema.Properties["count_only"] = countOnlySchemaProperty()
 	WithPagination(schema)
 
 	return NewTool(
@@ -278,7 +295,11 @@ func searchCodeTool(t translations.TranslationHelperFunc, includeFields bool) in
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			countOnly, err := OptionalParam[bool](args, "count_only")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -298,6 +319,9 @@ func searchCodeTool(t translations.TranslationHelperFunc, includeFields bool) in
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
+			if countOnly {
+				opts.ListOptions = countOnlyListOptions
+			}
 			result, resp, err := client.Search.Code(ctx, query, opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -316,6 +340,11 @@ func searchCodeTool(t translations.TranslationHelperFunc, includeFields bool) in
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to search code", resp, body), nil, nil
 			}
 
+			if countOnly {
+				// Code counts can include matches in private repositories.
+				return searchCountResult(ctx, deps, result.Total, result.IncompleteResults, ifc.PrivateTrusted()), nil, nil
+			}
+
 			minimalItems := make([]MinimalCodeResult, 0, len(result.CodeResults))
 			for _, code := range result.CodeResults {
 				item := MinimalCodeResult{
@@ -395,7 +424,11 @@ func userOrOrgHandler(ctx context.Context, accountType string, deps ToolDependen
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
-	pagination, err := OptionalPaginationParams(args)
+	pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	countOnly, err := OptionalParam[bool](args, "count_only")
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil, nil
 	}
@@ -418,6 +451,9 @@ func userOrOrgHandler(ctx context.Context, accountType string, deps ToolDependen
 	if !hasTypeFilter(query) {
 		searchQuery = "type:" + accountType + " " + query
 	}
+	if countOnly {
+		opts.ListOptions = countOnlyListOptions
+	}
 	result, resp, err := client.Search.Users(ctx, searchQuery, opts)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -436,6 +472,11 @@ func userOrOrgHandler(ctx context.Context, accountType string, deps ToolDependen
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to search %ss", accountType), resp, body), nil, nil
 	}
 
+	if countOnly {
+		// User and organization accounts are public, so their count is too.
+		return searchCountResult(ctx, deps, result.Total, result.IncompleteResults, ifc.PublicTrusted()), nil, nil
+	}
+
 	minimalUsers := make([]MinimalUser, 0, len(result.Users))
 
 	for _, user := range result.Users {
@@ -494,6 +535,7 @@ func SearchUsers(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 		Required: []string{"query"},
 	}
+	schema.Properties["count_only"] = countOnlySchemaProperty()
 	WithPagination(schema)
 
 	return NewTool(
@@ -536,6 +578,7 @@ func SearchOrgs(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 		Required: []string{"query"},
 	}
+	schema.Properties["count_only"] = countOnlySchemaProperty()
 	WithPagination(schema)
 
 	return NewTool(
@@ -578,6 +621,7 @@ func SearchCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 		Required: []string{"query"},
 	}
+	schema.Properties["count_only"] = countOnlySchemaProperty()
 	WithPagination(schema)
 
 	return NewTool(
@@ -605,7 +649,11 @@ func SearchCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
-			pagination, err := OptionalPaginationParams(args)
+			pagination, err := OptionalPaginationParams(args, deps.GetDefaultPerPage())
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			countOnly, err := OptionalParam[bool](args, "count_only")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
@@ -623,6 +671,9 @@ func SearchCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
+			if countOnly {
+				opts.ListOptions = countOnlyListOptions
+			}
 			result, resp, err := client.Search.Commits(ctx, query, opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -641,6 +692,11 @@ func SearchCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to search commits", resp, body), nil, nil
 			}
 
+			if countOnly {
+				// Commit counts can include private repositories' commits.
+				return searchCountResult(ctx, deps, result.Total, result.IncompleteResults, ifc.PrivateTrusted()), nil, nil
+			}
+
 			minimalCommits := make([]MinimalCommitSearchItem, 0, len(result.Commits))
 			for _, commit := range result.Commits {
 				minimalCommits = append(minimalCommits, convertCommitResultToMinimalCommit(commit))
@@ -672,3 +728,278 @@ func SearchCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 	)
 }
+
+const (
+	// defaultGrepMaxFiles is the default number of files grep_repository reads.
+	defaultGrepMaxFiles = 100
+	// defaultGrepMaxMatches is the default number of matching lines grep_repository returns.
+	defaultGrepMaxMatches = 100
+	// maxGrepFileSize is the largest blob grep_repository will read; bigger
+	// files are almost always generated or binary and are skipped.
+	maxGrepFileSize = 1024 * 1024
+)
+
+// GrepMatch is a single line matched by grep_repository.
+type GrepMatch struct {
+	Path   string   `json:"path"`
+	Line   int      `json:"line"`
+	Text   string   `json:"text"`
+	Before []string `json:"before,omitempty"`
+	After  []string `json:"after,omitempty"`
+}
+
+// GrepResponse is the response payload returned by the grep_repository tool.
+type GrepResponse struct {
+	Ref           string      `json:"ref"`
+	Matches       []GrepMatch `json:"matches"`
+	FilesSearched int         `json:"files_searched"`
+	FilesSkipped  int         `json:"files_skipped,omitempty"`
+	// MaxFilesReached reports that more files matched path_glob than
+	// max_files allowed, so the remaining files were not searched.
+	MaxFilesReached bool `json:"max_files_reached,omitempty"`
+	// MaxMatchesReached reports that the search stopped after max_matches lines.
+	MaxMatchesReached bool `json:"max_matches_reached,omitempty"`
+	// Truncated reports that the GitHub API cut the repository tree short,
+	// so some files could not be searched at all.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// grepBlob appends the lines of content matching re to matches, stopping once
+// matches holds limit entries. It reports whether the limit was hit.
+func grepBlob(path string, content []byte, re *regexp.Regexp, contextLines, limit int, matches []GrepMatch) ([]GrepMatch, bool) {
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	for i, line := range lines {
+		if !re.MatchString(line) {
+			continue
+		}
+		if len(matches) == limit {
+			return matches, true
+		}
+		m := GrepMatch{
+			Path: path,
+			Line: i + 1,
+			Text: line,
+		}
+		if contextLines > 0 {
+			m.Before = lines[max(0, i-contextLines):i]
+			m.After = lines[i+1 : min(len(lines), i+1+contextLines)]
+		}
+		matches = append(matches, m)
+	}
+	return matches, false
+}
+
+// GrepRepository creates a tool that searches file contents of a single
+// repository with a regular expression, without going through the search API.
+func GrepRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "grep_repository",
+			Description: t("TOOL_GREP_REPOSITORY_DESCRIPTION",
+				"Search the contents of files in one repository at a given ref with a regular expression, returning matching lines with their file path, line number and surrounding context. "+
+					"Unlike search_code this reads the files directly, so it works on any branch or commit, is not affected by search indexing or rate limits, and supports full regular expressions. "+
+					"Narrow the search with 'path_glob'; at most 'max_files' files are read, and binary files and files over 1 MB are skipped."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GREP_REPOSITORY_USER_TITLE", "Search file contents in a repository"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: DescriptionRepositoryOwner,
+					},
+					"repo": {
+						Type:        "string",
+						Description: DescriptionRepositoryName,
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag or commit SHA to search. Defaults to the repository's default branch",
+					},
+					"pattern": {
+						Type:        "string",
+						Description: "Regular expression (RE2 syntax) matched against each line",
+					},
+					"path_glob": {
+						Type:        "string",
+						Description: "Only search files whose path matches this glob. '*' and '?' match within a path segment and '**' matches any number of directories (e.g., '**/*.go' or 'docs/**')",
+					},
+					"ignore_case": {
+						Type:        "boolean",
+						Description: "Match the pattern case-insensitively",
+						Default:     json.RawMessage(`false`),
+					},
+					"context_lines": {
+						Type:        "number",
+						Description: "Number of lines to include before and after each match",
+						Default:     json.RawMessage(`0`),
+						Minimum:     jsonschema.Ptr(0.0),
+						Maximum:     jsonschema.Ptr(10.0),
+					},
+					"max_files": {
+						Type:        "number",
+						Description: "Maximum number of files to read",
+						Default:     json.RawMessage(fmt.Sprintf("%d", defaultGrepMaxFiles)),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(500.0),
+					},
+					"max_matches": {
+						Type:        "number",
+						Description: "Maximum number of matching lines to return",
+						Default:     json.RawMessage(fmt.Sprintf("%d", defaultGrepMaxMatches)),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(1000.0),
+					},
+				},
+				Required: []string{"owner", "repo", "pattern"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pattern, err := RequiredParam[string](args, "pattern")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pathGlob, err := OptionalParam[string](args, "path_glob")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ignoreCase, err := OptionalBoolParamWithDefault(args, "ignore_case", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			contextLines, err := OptionalIntParamWithDefault(args, "context_lines", 0)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if contextLines < 0 || contextLines > 10 {
+				return utils.NewToolResultError("context_lines must be between 0 and 10"), nil, nil
+			}
+			maxFiles, err := OptionalIntParamWithDefault(args, "max_files", defaultGrepMaxFiles)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxFiles < 1 || maxFiles > 500 {
+				return utils.NewToolResultError("max_files must be between 1 and 500"), nil, nil
+			}
+			maxMatches, err := OptionalIntParamWithDefault(args, "max_matches", defaultGrepMaxMatches)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxMatches < 1 || maxMatches > 1000 {
+				return utils.NewToolResultError("max_matches must be between 1 and 1000"), nil, nil
+			}
+
+			if ignoreCase {
+				pattern = "(?i)" + pattern
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("invalid pattern: %s", err)), nil, nil
+			}
+			var globRe *regexp.Regexp
+			if pathGlob != "" {
+				globRe, err = globToRegexp(pathGlob)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("invalid path_glob: %s", err)), nil, nil
+				}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			if ref == "" {
+				repoInfo, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get repository info",
+						resp,
+						err,
+					), nil, nil
+				}
+				_ = resp.Body.Close()
+				ref = repoInfo.GetDefaultBranch()
+			}
+
+			tree, resp, err := client.Git.GetTree(ctx, owner, repo, ref, true)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get repository tree for %s", ref),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			response := GrepResponse{
+				Ref:       ref,
+				Matches:   []GrepMatch{},
+				Truncated: tree.GetTruncated(),
+			}
+			filesRead := 0
+			for _, entry := range tree.Entries {
+				if entry.GetType() != "blob" {
+					continue
+				}
+				if globRe != nil && !globRe.MatchString(entry.GetPath()) {
+					continue
+				}
+				if entry.GetSize() > maxGrepFileSize {
+					response.FilesSkipped++
+					continue
+				}
+				if filesRead == maxFiles {
+					response.MaxFilesReached = true
+					break
+				}
+
+				content, resp, err := client.Git.GetBlobRaw(ctx, owner, repo, entry.GetSHA())
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get contents of %s", entry.GetPath()),
+						resp,
+						err,
+					), nil, nil
+				}
+				_ = resp.Body.Close()
+				filesRead++
+
+				if bytes.IndexByte(content, 0) >= 0 {
+					response.FilesSkipped++
+					continue
+				}
+				response.FilesSearched++
+
+				response.Matches, response.MaxMatchesReached = grepBlob(entry.GetPath(), content, re, contextLines, maxMatches, response.Matches)
+				if response.MaxMatchesReached {
+					break
+				}
+			}
+
+			result := MarshalledTextResult(response)
+			// Matching lines are committed file contents; in public repos
+			// anyone can land them via a PR (untrusted), in private repos only
+			// collaborators can (trusted). Confidentiality follows repo
+			// visibility.
+			result = attachRepoVisibilityIFCLabel(ctx, deps, client, owner, repo, result, ifc.LabelCommitContents)
+			return result, nil, nil
+		},
+	)
+}
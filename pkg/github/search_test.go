@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v89/github"
 	"github.com/google/jsonschema-go/jsonschema"
@@ -1158,3 +1160,258 @@ func Test_SearchCommits(t *testing.T) {
 		})
 	}
 }
+
+func Test_SearchCountOnly(t *testing.T) {
+	repoArgs := map[string]any{"owner": "owner", "repo": "repo"}
+
+	tests := []struct {
+		name     string
+		tool     func(translations.TranslationHelperFunc) inventory.ServerTool
+		args     map[string]any
+		endpoint string
+		query    string
+	}{
+		{name: "search_issues", tool: SearchIssues, args: repoArgs, endpoint: GetSearchIssues, query: "repo:owner/repo is:issue is:open"},
+		{name: "search_pull_requests", tool: SearchPullRequests, args: repoArgs, endpoint: GetSearchIssues, query: "repo:owner/repo is:pr is:open"},
+		{name: "search_repositories", tool: SearchRepositories, endpoint: GetSearchRepositories, query: "is:open"},
+		{name: "search_code", tool: SearchCode, endpoint: GetSearchCode, query: "is:open"},
+		{name: "search_users", tool: SearchUsers, endpoint: GetSearchUsers, query: "type:user is:open"},
+		{name: "search_orgs", tool: SearchOrgs, endpoint: GetSearchUsers, query: "type:org is:open"},
+		{name: "search_commits", tool: SearchCommits, endpoint: GetSearchCommits, query: "is:open"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			serverTool := tc.tool(translations.NullTranslationHelper)
+			schema, ok := serverTool.Tool.InputSchema.(*jsonschema.Schema)
+			require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+			assert.Contains(t, schema.Properties, "count_only")
+
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					tc.endpoint: expectQueryParams(t, map[string]string{
+						"q":        tc.query,
+						"page":     "1",
+						"per_page": "1",
+					}).andThen(
+						mockResponse(t, http.StatusOK, `{"total_count": 1234, "incomplete_results": true, "items": [{}]}`),
+					),
+				})),
+			}
+			handler := serverTool.Handler(deps)
+
+			// count_only ignores the pagination arguments and fetches a single result.
+			args := map[string]any{
+				"query":      "is:open",
+				"page":       float64(3),
+				"perPage":    float64(50),
+				"count_only": true,
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			request := createMCPRequest(args)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			assert.JSONEq(t, `{"total_count": 1234, "incomplete_results": true}`, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_GrepRepository(t *testing.T) {
+	serverTool := GrepRepository(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "grep_repository", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "path_glob")
+	assert.Contains(t, schema.Properties, "max_files")
+	assert.Contains(t, schema.Properties, "max_matches")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pattern"})
+
+	mockTree := &github.Tree{
+		SHA: github.Ptr("tree-sha"),
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("cmd"), Type: github.Ptr("tree"), SHA: github.Ptr("cmd-sha")},
+			{Path: github.Ptr("cmd/main.go"), Type: github.Ptr("blob"), SHA: github.Ptr("main-sha"), Size: github.Ptr(80)},
+			{Path: github.Ptr("pkg/util.go"), Type: github.Ptr("blob"), SHA: github.Ptr("util-sha"), Size: github.Ptr(60)},
+			{Path: github.Ptr("logo.png"), Type: github.Ptr("blob"), SHA: github.Ptr("png-sha"), Size: github.Ptr(4)},
+			{Path: github.Ptr("vendor.js"), Type: github.Ptr("blob"), SHA: github.Ptr("big-sha"), Size: github.Ptr(2 * 1024 * 1024)},
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), SHA: github.Ptr("readme-sha"), Size: github.Ptr(20)},
+		},
+	}
+	blobs := map[string]string{
+		"main-sha":   "package main\n\n// TODO: flags\nfunc main() {\n\trun()\n}\n",
+		"util-sha":   "package pkg\n\nfunc run() {\n\t// todo: errors\n}\n",
+		"png-sha":    "\x89PNG\x00",
+		"readme-sha": "# TODO list\n",
+	}
+
+	blobHandler := func(w http.ResponseWriter, r *http.Request) {
+		sha := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		content, ok := blobs[sha]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       GrepResponse
+	}{
+		{
+			name: "matches lines with context in files selected by glob",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree:    mockResponse(t, http.StatusOK, mockTree),
+				GetReposGitBlobsByOwnerByRepoByFileSHA: blobHandler,
+			}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"ref":           "main",
+				"pattern":       "todo",
+				"path_glob":     "**/*.go",
+				"ignore_case":   true,
+				"context_lines": float64(1),
+			},
+			expected: GrepResponse{
+				Ref: "main",
+				Matches: []GrepMatch{
+					{Path: "cmd/main.go", Line: 3, Text: "// TODO: flags", Before: []string{""}, After: []string{"func main() {"}},
+					{Path: "pkg/util.go", Line: 4, Text: "\t// todo: errors", Before: []string{"func run() {"}, After: []string{"}"}},
+				},
+				FilesSearched: 2,
+			},
+		},
+		{
+			name: "defaults to the default branch and skips binary and large files",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposByOwnerByRepo: mockResponse(t, http.StatusOK, &github.Repository{
+					DefaultBranch: github.Ptr("trunk"),
+				}),
+				GetReposGitTreesByOwnerByRepoByTree:    mockResponse(t, http.StatusOK, mockTree),
+				GetReposGitBlobsByOwnerByRepoByFileSHA: blobHandler,
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"pattern": "TODO",
+			},
+			expected: GrepResponse{
+				Ref: "trunk",
+				Matches: []GrepMatch{
+					{Path: "cmd/main.go", Line: 3, Text: "// TODO: flags"},
+					{Path: "README.md", Line: 1, Text: "# TODO list"},
+				},
+				FilesSearched: 3,
+				FilesSkipped:  2,
+			},
+		},
+		{
+			name: "stops at max_files",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree:    mockResponse(t, http.StatusOK, mockTree),
+				GetReposGitBlobsByOwnerByRepoByFileSHA: blobHandler,
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"ref":       "main",
+				"pattern":   "func",
+				"max_files": float64(1),
+			},
+			expected: GrepResponse{
+				Ref: "main",
+				Matches: []GrepMatch{
+					{Path: "cmd/main.go", Line: 4, Text: "func main() {"},
+				},
+				FilesSearched:   1,
+				MaxFilesReached: true,
+			},
+		},
+		{
+			name: "stops at max_matches",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree:    mockResponse(t, http.StatusOK, mockTree),
+				GetReposGitBlobsByOwnerByRepoByFileSHA: blobHandler,
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"ref":         "main",
+				"pattern":     "^package",
+				"max_matches": float64(1),
+			},
+			expected: GrepResponse{
+				Ref: "main",
+				Matches: []GrepMatch{
+					{Path: "cmd/main.go", Line: 1, Text: "package main"},
+				},
+				FilesSearched:     2,
+				MaxMatchesReached: true,
+			},
+		},
+		{
+			name:         "invalid pattern",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ref":     "main",
+				"pattern": "(unclosed",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid pattern",
+		},
+		{
+			name: "ref not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitTreesByOwnerByRepoByTree: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ref":     "missing",
+				"pattern": "TODO",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository tree for missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response GrepResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -51,6 +53,12 @@ type searchConfig struct {
 	fields     []string
 	fieldsTool string
 	fieldsDeps ToolDependencies
+	// groupByRepo replaces the flat items list with one group per repository.
+	groupByRepo bool
+	// countOnly returns only the total_count of the search. countDeps labels
+	// the count result.
+	countOnly bool
+	countDeps ToolDependencies
 }
 
 type searchOption func(*searchConfig)
@@ -74,10 +82,128 @@ func withFieldsFiltering(deps ToolDependencies, tool string, fields []string) se
 	}
 }
 
+// withGroupByRepo enables the optional `group_by_repo` output mode for a search
+// tool, which groups the result items by repository.
+func withGroupByRepo(groupByRepo bool) searchOption {
+	return func(c *searchConfig) { c.groupByRepo = groupByRepo }
+}
+
+// groupByRepoSchemaProperty is the schema for the `group_by_repo` parameter
+// shared by search_issues and search_pull_requests.
+func groupByRepoSchemaProperty() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "boolean",
+		Description: "Group the results by repository, with a count per repository, instead of returning a flat list. Duplicate results are dropped. Groups are ordered by their first result.",
+	}
+}
+
+// withCountOnly enables the optional `count_only` output mode for a search
+// tool, which returns the total number of matches without the items. deps is
+// used to label the count result.
+func withCountOnly(deps ToolDependencies, countOnly bool) searchOption {
+	return func(c *searchConfig) {
+		c.countDeps = deps
+		c.countOnly = countOnly
+	}
+}
+
+// countOnlySchemaProperty is the schema for the `count_only` parameter shared
+// by the search tools.
+func countOnlySchemaProperty() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "boolean",
+		Description: "Return only the total number of matches (total_count), without the results. Use this to answer 'how many' questions cheaply; pagination and output options are ignored.",
+	}
+}
+
+// countOnlyListOptions are the list options for a count_only search. The
+// search API has no count endpoint, but every page reports total_count, so
+// the smallest page is fetched.
+var countOnlyListOptions = github.ListOptions{Page: 1, PerPage: 1}
+
+// SearchCountResult is the output of a search tool called with count_only.
+type SearchCountResult struct {
+	TotalCount        int  `json:"total_count"`
+	IncompleteResults bool `json:"incomplete_results"`
+}
+
+// searchCountResult returns the count_only result for a search response. The
+// count says nothing about which repositories the matches came from, so
+// callers label it for the most restricted data the search could count.
+func searchCountResult(ctx context.Context, deps ToolDependencies, total *int, incompleteResults *bool, label ifc.SecurityLabel) *mcp.CallToolResult {
+	count := SearchCountResult{}
+	if total != nil {
+		count.TotalCount = *total
+	}
+	if incompleteResults != nil {
+		count.IncompleteResults = *incompleteResults
+	}
+	return attachStaticIFCLabel(ctx, deps, MarshalledTextResult(count), label)
+}
+
+// SearchRepoGroup is the set of search results from one repository in the
+// group_by_repo output mode.
+type SearchRepoGroup[T any] struct {
+	Repository string `json:"repository"`
+	Count      int    `json:"count"`
+	Items      []T    `json:"items"`
+}
+
+// groupSearchItemsByRepo groups items, which must be aligned index for index
+// with issues, by the repository of the matching issue. Groups keep the order
+// in which their repository first appears, and results repeated within the
+// page are dropped.
+func groupSearchItemsByRepo[T any](issues []*github.Issue, items []T) []SearchRepoGroup[T] {
+	groups := make([]SearchRepoGroup[T], 0)
+	groupIndex := make(map[string]int)
+	seen := make(map[int64]struct{})
+	for i, issue := range issues {
+		if i >= len(items) {
+			break
+		}
+		if id := issue.GetID(); id != 0 {
+			if _, dup := seen[id]; dup {
+				continue
+			}
+			seen[id] = struct{}{}
+		}
+		var key string
+		if owner, repo, ok := parseRepositoryURL(issue.GetRepositoryURL()); ok {
+			key = owner + "/" + repo
+		}
+		idx, ok := groupIndex[key]
+		if !ok {
+			idx = len(groups)
+			groupIndex[key] = idx
+			groups = append(groups, SearchRepoGroup[T]{Repository: key})
+		}
+		groups[idx].Items = append(groups[idx].Items, items[i])
+		groups[idx].Count++
+	}
+	return groups
+}
+
+// searchResultPayload builds the response for a search whose items have been
+// post-processed, either as the usual flat list or grouped by repository.
+func searchResultPayload[T any](total *int, incompleteResults *bool, issues []*github.Issue, items []T, groupByRepo bool) map[string]any {
+	if groupByRepo {
+		return map[string]any{
+			"total_count":        total,
+			"incomplete_results": incompleteResults,
+			"repositories":       groupSearchItemsByRepo(issues, items),
+		}
+	}
+	return map[string]any{
+		"total_count":        total,
+		"incomplete_results": incompleteResults,
+		"items":              items,
+	}
+}
+
 // prepareSearchArgs resolves the search query string and REST search options from the tool args,
 // applying the standard is:<type> / repo:<owner>/<repo> munging shared by search_issues and
-// search_pull_requests.
-func prepareSearchArgs(args map[string]any, searchType string) (string, *github.SearchOptions, error) {
+// search_pull_requests. defaultPerPage is the page size used when the call does not pass perPage.
+func prepareSearchArgs(args map[string]any, searchType string, defaultPerPage int) (string, *github.SearchOptions, error) {
 	query, err := RequiredParam[string](args, "query")
 	if err != nil {
 		return "", nil, err
@@ -109,7 +235,7 @@ func prepareSearchArgs(args map[string]any, searchType string) (string, *github.
 	if err != nil {
 		return "", nil, err
 	}
-	pagination, err := OptionalPaginationParams(args)
+	pagination, err := OptionalPaginationParams(args, defaultPerPage)
 	if err != nil {
 		return "", nil, err
 	}
@@ -135,6 +261,7 @@ func searchHandler(
 	ctx context.Context,
 	getClient GetClientFn,
 	args map[string]any,
+	defaultPerPage int,
 	searchType string,
 	errorPrefix string,
 	options ...searchOption,
@@ -143,10 +270,13 @@ func searchHandler(
 	for _, opt := range options {
 		opt(&cfg)
 	}
-	query, opts, err := prepareSearchArgs(args, searchType)
+	query, opts, err := prepareSearchArgs(args, searchType, defaultPerPage)
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil
 	}
+	if cfg.countOnly {
+		opts.ListOptions = countOnlyListOptions
+	}
 
 	client, err := getClient(ctx)
 	if err != nil {
@@ -166,19 +296,22 @@ func searchHandler(
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, errorPrefix, resp, body), nil
 	}
 
+	if cfg.countOnly {
+		return searchCountResult(ctx, cfg.countDeps, result.Total, result.IncompleteResults, ifc.PrivateTrusted()), nil
+	}
+
 	filtered := false
 	var payload any = result
-	if len(cfg.fields) > 0 {
+	switch {
+	case len(cfg.fields) > 0:
 		filteredItems, err := filterEachField(result.Issues, cfg.fields)
 		if err != nil {
 			return utils.NewToolResultErrorFromErr(errorPrefix+": failed to filter results", err), nil
 		}
-		payload = map[string]any{
-			"total_count":        result.Total,
-			"incomplete_results": result.IncompleteResults,
-			"items":              filteredItems,
-		}
+		payload = searchResultPayload(result.Total, result.IncompleteResults, result.Issues, filteredItems, cfg.groupByRepo)
 		filtered = true
+	case cfg.groupByRepo:
+		payload = searchResultPayload(result.Total, result.IncompleteResults, result.Issues, result.Issues, true)
 	}
 
 	r, err := json.Marshal(payload)
@@ -135,6 +135,8 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 	assert.Contains(t, schema.Properties, "state")
 	assert.Contains(t, schema.Properties, "secret_type")
 	assert.Contains(t, schema.Properties, "resolution")
+	assert.Contains(t, schema.Properties, "after")
+	assert.Contains(t, schema.Properties, "before")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
 
 	// Setup mock alerts for success case
@@ -154,12 +156,13 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]any
-		expectError    bool
-		expectedAlerts []*github.SecretScanningAlert
-		expectedErrMsg string
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedAlerts   []*github.SecretScanningAlert
+		expectedPageInfo pageInfo
+		expectedErrMsg   string
 	}{
 		{
 			name: "successful resolved alerts listing",
@@ -216,6 +219,45 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 			expectError:    false,
 			expectedAlerts: []*github.SecretScanningAlert{&openAlert},
 		},
+		{
+			name: "successful alerts listing surfaces the next page number",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposSecretScanningAlertsByOwnerByRepo: func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/secret-scanning/alerts?page=2&per_page=30>; rel="next"`)
+					mockResponse(t, http.StatusOK, []*github.SecretScanningAlert{&openAlert})(w, nil)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:      false,
+			expectedAlerts:   []*github.SecretScanningAlert{&openAlert},
+			expectedPageInfo: pageInfo{HasNextPage: true, NextPage: 2},
+		},
+		{
+			name: "successful alerts listing with cursor pagination",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposSecretScanningAlertsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"after":    "Y3Vyc29yOjI=",
+					"per_page": "50",
+				}).andThen(
+					func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/secret-scanning/alerts?after=nextcursor&per_page=50>; rel="next"`)
+						mockResponse(t, http.StatusOK, []*github.SecretScanningAlert{&openAlert})(w, nil)
+					},
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"after":   "Y3Vyc29yOjI=",
+				"perPage": float64(50),
+			},
+			expectError:      false,
+			expectedAlerts:   []*github.SecretScanningAlert{&openAlert},
+			expectedPageInfo: pageInfo{HasNextPage: true, NextCursor: "nextcursor"},
+		},
 		{
 			name: "alerts listing fails",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -259,11 +301,15 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlerts []*github.SecretScanningAlert
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
+			var returned struct {
+				Alerts   []*github.SecretScanningAlert `json:"alerts"`
+				PageInfo pageInfo                      `json:"pageInfo"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			assert.NoError(t, err)
-			assert.Len(t, returnedAlerts, len(tc.expectedAlerts))
-			for i, alert := range returnedAlerts {
+			assert.Equal(t, tc.expectedPageInfo, returned.PageInfo)
+			assert.Len(t, returned.Alerts, len(tc.expectedAlerts))
+			for i, alert := range returned.Alerts {
 				assert.Equal(t, *tc.expectedAlerts[i].Number, *alert.Number)
 				assert.Equal(t, *tc.expectedAlerts[i].HTMLURL, *alert.HTMLURL)
 				assert.Equal(t, *tc.expectedAlerts[i].State, *alert.State)
@@ -104,14 +104,13 @@ func Test_ListGlobalSecurityAdvisories(t *testing.T) {
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 			// Verify results
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
@@ -216,14 +215,13 @@ func Test_GetGlobalSecurityAdvisory(t *testing.T) {
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
 			// Verify results
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
@@ -347,14 +345,13 @@ func Test_ListRepositorySecurityAdvisories(t *testing.T) {
 			// Call handler
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-
 			textContent := getTextResult(t, result)
 
 			var returnedAdvisories []*github.SecurityAdvisory
@@ -603,14 +600,13 @@ func Test_ListOrgRepositorySecurityAdvisories(t *testing.T) {
 			// Call handler
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
+			require.NoError(t, err)
 			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
-
 			textContent := getTextResult(t, result)
 
 			var returnedAdvisories []*github.SecurityAdvisory
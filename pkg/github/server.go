@@ -47,12 +47,40 @@ type MCPServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultReturnContent makes content-capable tools return content rather
+	// than download URLs unless a call asks otherwise
+	DefaultReturnContent bool
+
+	// DefaultPerPage is the page size paginated tools use when a call does
+	// not pass perPage
+	DefaultPerPage int
+
+	// PrettyJSON pretty-prints JSON tool results instead of emitting them
+	// compactly
+	PrettyJSON bool
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
 	// InsidersMode expands to the curated set of feature flags enabled for insiders.
 	InsidersMode bool
 
+	// EnableGraphQLPassthrough offers the graphql_query tool, which runs the
+	// GraphQL operations named in GraphQLPassthroughOperations.
+	EnableGraphQLPassthrough bool
+
+	// GraphQLPassthroughOperations is the allow-list of operation names that
+	// graphql_query may run.
+	GraphQLPassthroughOperations []string
+
+	// EnableRESTPassthrough offers the github_api_get tool, which sends GET
+	// requests to arbitrary REST API paths on the configured host.
+	EnableRESTPassthrough bool
+
+	// ToolNamePrefix is prepended to every registered tool name, so the
+	// server's tools can sit alongside other servers' without collisions.
+	ToolNamePrefix string
+
 	// Logger is used for logging within the server
 	Logger *slog.Logger
 	// RepoAccessTTL overrides the default TTL for repository access cache entries.
@@ -109,7 +137,12 @@ func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependenci
 	}
 
 	// Register GitHub tools/resources/prompts from the inventory.
-	inv.RegisterAll(ctx, ghServer, deps, cfg.ToolHandlerMiddleware...)
+	toolHandlerMiddleware := cfg.ToolHandlerMiddleware
+	if cfg.PrettyJSON {
+		// Outermost, so it formats the result every other middleware returns.
+		toolHandlerMiddleware = append([]inventory.ToolHandlerMiddleware{prettyJSONToolHandlerMiddleware}, toolHandlerMiddleware...)
+	}
+	inv.RegisterAll(ctx, ghServer, deps, toolHandlerMiddleware...)
 
 	// Register MCP App UI resources whenever the embedded UI assets are
 	// available. The resources are static HTML and are only referenced by
@@ -196,6 +229,18 @@ func CompletionsHandler(getClient GetClientFn) func(ctx context.Context, req *mc
 	}
 }
 
+// prettyJSONToolHandlerMiddleware pretty-prints the JSON text of every tool
+// result, so the formatting applies uniformly however a tool built its result.
+func prettyJSONToolHandlerMiddleware(next mcp.ToolHandler) mcp.ToolHandler {
+	return func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, req)
+		if err != nil {
+			return result, err
+		}
+		return utils.IndentJSONResult(result), nil
+	}
+}
+
 func MarshalledTextResult(v any) *mcp.CallToolResult {
 	data, err := json.Marshal(v)
 	if err != nil {
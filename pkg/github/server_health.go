@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/buildinfo"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ServerHealthInfo is the server configuration reported by server_health.
+type ServerHealthInfo struct {
+	// Host is the configured GitHub host. Empty means github.com.
+	Host string
+	// AuthConfigured reports whether a token, GitHub App or OAuth login is
+	// configured. The credentials themselves are never reported.
+	AuthConfigured bool
+	// EnabledToolsets lists the enabled toolset IDs. The server fills it in
+	// once its inventory is built, which happens after this tool is created.
+	EnabledToolsets []string
+	// GHESVersion is the detected GitHub Enterprise Server version. Empty
+	// for other hosts or when detection failed.
+	GHESVersion string
+}
+
+// ServerHealth is the output of server_health.
+type ServerHealth struct {
+	Version         string   `json:"version"`
+	Commit          string   `json:"commit"`
+	BuildDate       string   `json:"build_date"`
+	Host            string   `json:"host"`
+	GHESVersion     string   `json:"ghes_version,omitempty"`
+	EnabledToolsets []string `json:"enabled_toolsets"`
+	AuthConfigured  bool     `json:"auth_configured"`
+	// RateLimit is the core REST API rate limit. It is omitted, and
+	// RateLimitError says why, when the rate limit cannot be read; for
+	// example when GitHub Enterprise Server has rate limiting disabled.
+	RateLimit      *ServerHealthRateLimit `json:"rate_limit,omitempty"`
+	RateLimitError string                 `json:"rate_limit_error,omitempty"`
+}
+
+// ServerHealthRateLimit is the core REST API rate limit reported by server_health.
+type ServerHealthRateLimit struct {
+	Limit     int    `json:"limit"`
+	Remaining int    `json:"remaining"`
+	Reset     string `json:"reset"`
+}
+
+// ServerHealthTool creates a tool that reports the server build, its
+// configuration and the current rate limit. It is registered by the local
+// server, which owns the configuration, rather than through AllTools.
+func ServerHealthTool(t translations.TranslationHelperFunc, info *ServerHealthInfo) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name: "server_health",
+			Description: t("TOOL_SERVER_HEALTH_DESCRIPTION",
+				"Report the GitHub MCP Server version, commit and build date, the configured GitHub host, the enabled toolsets, whether authentication is configured, and the remaining API rate limit. "+
+					"Use this to diagnose the server setup or when the user asks which server version they are running."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_SERVER_HEALTH_USER_TITLE", "Check server health"),
+				ReadOnlyHint: true,
+			},
+			// Use json.RawMessage to ensure "properties" is included even when empty.
+			// OpenAI strict mode requires the properties field to be present.
+			InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+			health := ServerHealth{
+				Version:         buildinfo.Version,
+				Commit:          buildinfo.Commit,
+				BuildDate:       buildinfo.Date,
+				Host:            info.Host,
+				GHESVersion:     info.GHESVersion,
+				EnabledToolsets: info.EnabledToolsets,
+				AuthConfigured:  info.AuthConfigured,
+			}
+			if health.Host == "" {
+				health.Host = "github.com"
+			}
+			if health.EnabledToolsets == nil {
+				health.EnabledToolsets = []string{}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+			limits, resp, err := client.RateLimit.Get(ctx)
+			switch {
+			case err != nil:
+				health.RateLimitError = err.Error()
+			case limits.GetCore() != nil:
+				core := limits.GetCore()
+				health.RateLimit = &ServerHealthRateLimit{
+					Limit:     core.Limit,
+					Remaining: core.Remaining,
+					Reset:     core.Reset.UTC().Format(time.RFC3339),
+				}
+			}
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+
+			return attachStaticIFCLabel(ctx, deps, MarshalledTextResult(health), ifc.PrivateTrusted()), nil, nil
+		},
+	)
+}
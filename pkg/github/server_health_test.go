@@ -0,0 +1,92 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ServerHealth(t *testing.T) {
+	serverTool := ServerHealthTool(translations.NullTranslationHelper, &ServerHealthInfo{})
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "server_health", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, ToolsetMetadataContext.ID, serverTool.Toolset.ID)
+
+	rateLimit := map[string]any{
+		"resources": map[string]any{
+			"core": map[string]any{"limit": 5000, "remaining": 4321, "reset": 1767225600},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		info     ServerHealthInfo
+		handler  http.HandlerFunc
+		expected ServerHealth
+	}{
+		{
+			name: "reports configuration and rate limit",
+			info: ServerHealthInfo{
+				Host:            "https://ghe.example.com",
+				AuthConfigured:  true,
+				EnabledToolsets: []string{"context", "repos"},
+			},
+			handler: mockResponse(t, http.StatusOK, rateLimit),
+			expected: ServerHealth{
+				Version:         "version",
+				Commit:          "commit",
+				BuildDate:       "date",
+				Host:            "https://ghe.example.com",
+				EnabledToolsets: []string{"context", "repos"},
+				AuthConfigured:  true,
+				RateLimit:       &ServerHealthRateLimit{Limit: 5000, Remaining: 4321, Reset: "2026-01-01T00:00:00Z"},
+			},
+		},
+		{
+			name:    "rate limit errors are reported without failing",
+			handler: mockResponse(t, http.StatusNotFound, `{"message": "Rate limiting is not enabled."}`),
+			expected: ServerHealth{
+				Version:         "version",
+				Commit:          "commit",
+				BuildDate:       "date",
+				Host:            "github.com",
+				EnabledToolsets: []string{},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			info := tc.info
+			deps := BaseDeps{
+				Client: mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					GetRateLimit: tc.handler,
+				})),
+			}
+			st := ServerHealthTool(translations.NullTranslationHelper, &info)
+			handler := st.Handler(deps)
+
+			request := createMCPRequest(map[string]any{})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response ServerHealth
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			if tc.expected.RateLimit == nil {
+				assert.Contains(t, response.RateLimitError, "Rate limiting is not enabled")
+				response.RateLimitError = ""
+			}
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
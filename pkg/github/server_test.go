@@ -34,6 +34,8 @@ type stubDeps struct {
 	t                 translations.TranslationHelperFunc
 	flags             FeatureFlags
 	contentWindowSize int
+	returnContent     bool
+	defaultPerPage    int
 	obsv              observability.Exporters
 }
 
@@ -64,6 +66,8 @@ func (s stubDeps) GetRepoAccessCache(_ context.Context) (*lockdown.RepoAccessCac
 func (s stubDeps) GetT() translations.TranslationHelperFunc          { return s.t }
 func (s stubDeps) GetFlags(_ context.Context) FeatureFlags           { return s.flags }
 func (s stubDeps) GetContentWindowSize() int                         { return s.contentWindowSize }
+func (s stubDeps) GetDefaultReturnContent() bool                     { return s.returnContent }
+func (s stubDeps) GetDefaultPerPage() int                            { return defaultPerPageOr(s.defaultPerPage) }
 func (s stubDeps) IsFeatureEnabled(_ context.Context, _ string) bool { return false }
 func (s stubDeps) Logger(_ context.Context) *slog.Logger {
 	return s.obsv.Logger()
@@ -0,0 +1,178 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TeamMembership describes a user's membership of a team after it has been changed.
+type TeamMembership struct {
+	Org      string `json:"org"`
+	TeamSlug string `json:"team_slug"`
+	Username string `json:"username"`
+	// State is "active", "pending" (the user has been invited to the
+	// organization but has not accepted yet) or "removed".
+	State string `json:"state"`
+	Role  string `json:"role,omitempty"`
+}
+
+// teamMembershipSchema returns the input schema properties shared by the team membership tools.
+func teamMembershipSchema() map[string]*jsonschema.Schema {
+	return map[string]*jsonschema.Schema{
+		"org": {
+			Type:        "string",
+			Description: "Organization login (owner) that contains the team",
+		},
+		"team_slug": {
+			Type:        "string",
+			Description: "Team slug",
+		},
+		"username": {
+			Type:        "string",
+			Description: "GitHub username of the user",
+		},
+	}
+}
+
+// AddTeamMember creates a tool to add a user to a team or change their role in it.
+func AddTeamMember(t translations.TranslationHelperFunc) inventory.ServerTool {
+	properties := teamMembershipSchema()
+	properties["role"] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "Role of the user in the team (defaults to 'member')",
+		Enum:        []any{"member", "maintainer"},
+	}
+
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name: "add_team_member",
+			Description: t("TOOL_ADD_TEAM_MEMBER_DESCRIPTION",
+				"Add a user to a team in an organization, or change the role of an existing team member. "+
+					"If the user is not yet a member of the organization they are invited, and the membership stays 'pending' until they accept."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:          t("TOOL_ADD_TEAM_MEMBER_USER_TITLE", "Add team member"),
+				ReadOnlyHint:   false,
+				IdempotentHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: properties,
+				Required:   []string{"org", "team_slug", "username"},
+			},
+		},
+		[]scopes.Scope{scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			teamSlug, err := RequiredParam[string](args, "team_slug")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			role, err := OptionalParam[string](args, "role")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if role != "" && role != "member" && role != "maintainer" {
+				return utils.NewToolResultError(fmt.Sprintf("invalid role %q: must be one of member, maintainer", role)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			membership, resp, err := client.Teams.AddTeamMembershipBySlug(ctx, org, teamSlug, username, &github.TeamAddTeamMembershipOptions{Role: role})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to add %s to team %s/%s", username, org, teamSlug),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(TeamMembership{
+				Org:      org,
+				TeamSlug: teamSlug,
+				Username: username,
+				State:    membership.GetState(),
+				Role:     membership.GetRole(),
+			}), nil, nil
+		},
+	)
+}
+
+// RemoveTeamMember creates a tool to remove a user from a team.
+func RemoveTeamMember(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name:        "remove_team_member",
+			Description: t("TOOL_REMOVE_TEAM_MEMBER_DESCRIPTION", "Remove a user from a team in an organization. The user stays a member of the organization."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_REMOVE_TEAM_MEMBER_USER_TITLE", "Remove team member"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+				IdempotentHint:  true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: teamMembershipSchema(),
+				Required:   []string{"org", "team_slug", "username"},
+			},
+		},
+		[]scopes.Scope{scopes.AdminOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			teamSlug, err := RequiredParam[string](args, "team_slug")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Teams.RemoveTeamMembershipBySlug(ctx, org, teamSlug, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to remove %s from team %s/%s", username, org, teamSlug),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(TeamMembership{
+				Org:      org,
+				TeamSlug: teamSlug,
+				Username: username,
+				State:    "removed",
+			}), nil, nil
+		},
+	)
+}
@@ -0,0 +1,212 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AddTeamMember(t *testing.T) {
+	serverTool := AddTeamMember(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "add_team_member", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "role")
+	assert.ElementsMatch(t, schema.Required, []string{"org", "team_slug", "username"})
+	assert.Equal(t, []string{"admin:org"}, serverTool.RequiredScopes)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       TeamMembership
+	}{
+		{
+			name: "adds a maintainer",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername: expectRequestBody(t, map[string]any{
+					"role": "maintainer",
+				}).andThen(mockResponse(t, http.StatusOK, &github.Membership{
+					State: github.Ptr("active"),
+					Role:  github.Ptr("maintainer"),
+				})),
+			}),
+			requestArgs: map[string]any{
+				"org":       "octo-org",
+				"team_slug": "platform",
+				"username":  "octocat",
+				"role":      "maintainer",
+			},
+			expected: TeamMembership{
+				Org:      "octo-org",
+				TeamSlug: "platform",
+				Username: "octocat",
+				State:    "active",
+				Role:     "maintainer",
+			},
+		},
+		{
+			name: "invites a user outside the organization",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername: mockResponse(t, http.StatusOK, &github.Membership{
+					State: github.Ptr("pending"),
+					Role:  github.Ptr("member"),
+				}),
+			}),
+			requestArgs: map[string]any{
+				"org":       "octo-org",
+				"team_slug": "platform",
+				"username":  "outsider",
+			},
+			expected: TeamMembership{
+				Org:      "octo-org",
+				TeamSlug: "platform",
+				Username: "outsider",
+				State:    "pending",
+				Role:     "member",
+			},
+		},
+		{
+			name:         "invalid role",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"org":       "octo-org",
+				"team_slug": "platform",
+				"username":  "octocat",
+				"role":      "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid role "owner"`,
+		},
+		{
+			name: "insufficient permissions",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername: mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+			}),
+			requestArgs: map[string]any{
+				"org":       "octo-org",
+				"team_slug": "platform",
+				"username":  "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add octocat to team octo-org/platform",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response TeamMembership
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_RemoveTeamMember(t *testing.T) {
+	serverTool := RemoveTeamMember(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "remove_team_member", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"org", "team_slug", "username"})
+	assert.Equal(t, []string{"admin:org"}, serverTool.RequiredScopes)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       TeamMembership
+	}{
+		{
+			name: "removes a member",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteOrgsTeamsMembershipsByOrgByTeamSlugByUsername: mockResponse(t, http.StatusNoContent, nil),
+			}),
+			requestArgs: map[string]any{
+				"org":       "octo-org",
+				"team_slug": "platform",
+				"username":  "octocat",
+			},
+			expected: TeamMembership{
+				Org:      "octo-org",
+				TeamSlug: "platform",
+				Username: "octocat",
+				State:    "removed",
+			},
+		},
+		{
+			name: "team not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteOrgsTeamsMembershipsByOrgByTeamSlugByUsername: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"org":       "octo-org",
+				"team_slug": "missing",
+				"username":  "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to remove octocat from team octo-org/missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response TeamMembership
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -129,6 +129,11 @@ var (
 		Description: "GitHub Stargazers related tools",
 		Icon:        "star",
 	}
+	ToolsetMetadataFollowers = inventory.ToolsetMetadata{
+		ID:          "followers",
+		Description: "GitHub user follower related tools",
+		Icon:        "people",
+	}
 	ToolsetLabels = inventory.ToolsetMetadata{
 		ID:          "labels",
 		Description: "GitHub Labels related tools",
@@ -156,6 +161,7 @@ var (
 	// When active, consolidated tools are replaced by single-purpose granular tools.
 	FeatureFlagIssuesGranular       = "issues_granular"
 	FeatureFlagPullRequestsGranular = "pull_requests_granular"
+	FeatureFlagLabelsGranular       = "labels_granular"
 )
 
 // HeaderAllowedFeatureFlags returns the feature flags that clients may enable via
@@ -188,19 +194,31 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GetMe(t),
 		GetTeams(t),
 		GetTeamMembers(t),
+		ListMyOrganizations(t),
+		RenderMarkdown(t),
+		ListEmojis(t),
+		GetAuthStatus(t),
+		EstimateResponseSize(t),
 
 		// Repository tools
 		SearchRepositories(t),
 		GetFileContents(t),
 		LegacyGetFileContents(t),
+		GetFiles(t),
 		ListCommits(t),
 		LegacyListCommits(t),
 		SearchCode(t),
 		LegacySearchCode(t),
 		SearchCommits(t),
+		GrepRepository(t),
 		GetCommit(t),
+		GetCombinedStatus(t),
 		GetFileBlame(t),
+		GetFileHistory(t),
 		ListBranches(t),
+		ListRepositoryRulesets(t),
+		GetRepositoryRuleset(t),
+		GetCodeOwners(t),
 		ListTags(t),
 		GetTag(t),
 		ListReleases(t),
@@ -209,17 +227,26 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GetReleaseByTag(t),
 		CreateOrUpdateFile(t),
 		CreateRepository(t),
+		UpdateRepository(t),
 		ForkRepository(t),
+		SyncFork(t),
+		GetRepositoryTraffic(t),
+		GetCommunityProfile(t),
 		CreateBranch(t),
 		PushFiles(t),
 		DeleteFile(t),
 		ListStarredRepositories(t),
 		StarRepository(t),
 		UnstarRepository(t),
+		ListStargazers(t),
 		ListRepositoryCollaborators(t),
 
 		// Git tools
 		GetRepositoryTree(t),
+		GetBlob(t),
+		CreateCommit(t),
+		CherryPick(t),
+		ResolveRef(t),
 
 		// Issue tools
 		IssueRead(t),
@@ -234,12 +261,32 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		SubIssueWrite(t),
 		IssueDependencyRead(t),
 		IssueDependencyWrite(t),
+		ListLinkedReferences(t),
+		GetTasklistProgress(t),
+		ListIssueLabelEvents(t),
+		ListIssueParticipants(t),
+		ListIssueComments(t),
+		UpdateIssueComment(t),
+		DeleteIssueComment(t),
+		MinimizeComment(t),
+		UnminimizeComment(t),
+		BulkAddLabels(t),
+		ListIssueTemplates(t),
 
 		// User tools
 		SearchUsers(t),
+		ListUserEvents(t),
+
+		// Follower tools
+		ListFollowers(t),
+		ListFollowing(t),
+		FollowUser(t),
+		UnfollowUser(t),
 
 		// Organization tools
 		SearchOrgs(t),
+		AddTeamMember(t),
+		RemoveTeamMember(t),
 
 		// Pull request tools
 		PullRequestRead(t),
@@ -249,6 +296,15 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		LegacySearchPullRequests(t),
 		MergePullRequest(t),
 		UpdatePullRequestBranch(t),
+		GetRequiredStatusChecks(t),
+		ListPullRequestReviewThreads(t),
+		RequestReviewers(t),
+		RemoveRequestedReviewers(t),
+		ListPullRequestCommits(t),
+		ClosePullRequest(t),
+		GetMergeQueue(t),
+		EnqueuePullRequest(t),
+		DequeuePullRequest(t),
 		CreatePullRequest(t),
 		UpdatePullRequest(t),
 		PullRequestReviewWrite(t),
@@ -297,6 +353,22 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		ActionsGet(t),
 		ActionsRunTrigger(t),
 		ActionsGetJobLogs(t),
+		WaitForWorkflowRun(t),
+		CompareWorkflowRuns(t),
+		ListWorkflowRunsForCommit(t),
+		RerunJob(t),
+		EnableWorkflow(t),
+		DisableWorkflow(t),
+		GetActionsPermissions(t),
+		GetOIDCSubjectClaim(t),
+		ListActionsCaches(t),
+		DeleteActionsCache(t),
+		GetTestReport(t),
+		GetArtifactContents(t),
+		ListDeployments(t),
+		GetDeploymentStatus(t),
+		CreateDeployment(t),
+		CreateDeploymentStatus(t),
 
 		// Security advisories tools
 		ListGlobalSecurityAdvisories(t),
@@ -353,6 +425,11 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GranularResolveReviewThread(t),
 		GranularUnresolveReviewThread(t),
 		GranularAddPullRequestReviewCommentReaction(t),
+
+		// Granular label tools (feature-flagged, replace consolidated label_write)
+		GranularCreateLabel(t),
+		GranularUpdateLabel(t),
+		GranularDeleteLabel(t),
 	})
 }
 
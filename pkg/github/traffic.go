@@ -0,0 +1,222 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/ifc"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TrafficCount is the number of events, and of unique visitors or cloners,
+// in one day or week of a traffic breakdown.
+type TrafficCount struct {
+	Timestamp string `json:"timestamp"`
+	Count     int    `json:"count"`
+	Uniques   int    `json:"uniques"`
+}
+
+// TrafficPath is one of the most visited paths of a repository.
+type TrafficPath struct {
+	Path    string `json:"path"`
+	Title   string `json:"title,omitempty"`
+	Count   int    `json:"count"`
+	Uniques int    `json:"uniques"`
+}
+
+// TrafficReferrer is one of the top sites that referred visitors to a repository.
+type TrafficReferrer struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+// RepositoryTraffic is the output of get_repository_traffic. Which fields are
+// set depends on the requested metric. Count and Uniques are always present,
+// so a repository with no views or clones reports zeros.
+type RepositoryTraffic struct {
+	Metric    string            `json:"metric"`
+	Count     int               `json:"count"`
+	Uniques   int               `json:"uniques"`
+	Per       string            `json:"per,omitempty"`
+	Breakdown []TrafficCount    `json:"breakdown,omitempty"`
+	Paths     []TrafficPath     `json:"paths,omitempty"`
+	Referrers []TrafficReferrer `json:"referrers,omitempty"`
+}
+
+// GetRepositoryTraffic creates a tool to get the traffic statistics of a repository.
+func GetRepositoryTraffic(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name: "get_repository_traffic",
+			Description: t("TOOL_GET_REPOSITORY_TRAFFIC_DESCRIPTION",
+				"Get traffic statistics for a GitHub repository over the last 14 days: page views or git clones (total and unique, with a daily or weekly breakdown), "+
+					"the most visited paths, or the top referring sites. Requires push access to the repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_REPOSITORY_TRAFFIC_USER_TITLE", "Get repository traffic"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"metric": {
+						Type:        "string",
+						Description: "Traffic metric to get",
+						Enum:        []any{"views", "clones", "popular_paths", "referrers"},
+					},
+					"per": {
+						Type:        "string",
+						Description: "Breakdown interval for views and clones (defaults to 'day'). Ignored for other metrics.",
+						Enum:        []any{"day", "week"},
+					},
+				},
+				Required: []string{"owner", "repo", "metric"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			metric, err := RequiredParam[string](args, "metric")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			per, err := OptionalParam[string](args, "per")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if per == "" {
+				per = "day"
+			}
+			if per != "day" && per != "week" {
+				return utils.NewToolResultError(fmt.Sprintf("invalid per %q: must be one of day, week", per)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			traffic := RepositoryTraffic{Metric: metric}
+			var resp *github.Response
+			switch metric {
+			case "views":
+				var views *github.TrafficViews
+				views, resp, err = client.Repositories.ListTrafficViews(ctx, owner, repo, &github.TrafficBreakdownOptions{Per: per})
+				if err == nil {
+					traffic.Count = views.GetCount()
+					traffic.Uniques = views.GetUniques()
+					traffic.Per = per
+					traffic.Breakdown = convertTrafficData(views.Views)
+				}
+			case "clones":
+				var clones *github.TrafficClones
+				clones, resp, err = client.Repositories.ListTrafficClones(ctx, owner, repo, &github.TrafficBreakdownOptions{Per: per})
+				if err == nil {
+					traffic.Count = clones.GetCount()
+					traffic.Uniques = clones.GetUniques()
+					traffic.Per = per
+					traffic.Breakdown = convertTrafficData(clones.Clones)
+				}
+			case "popular_paths":
+				var paths []*github.TrafficPath
+				paths, resp, err = client.Repositories.ListTrafficPaths(ctx, owner, repo)
+				if err == nil {
+					traffic.Paths = make([]TrafficPath, 0, len(paths))
+					for _, p := range paths {
+						traffic.Paths = append(traffic.Paths, TrafficPath{
+							Path:    p.GetPath(),
+							Title:   p.GetTitle(),
+							Count:   p.GetCount(),
+							Uniques: p.GetUniques(),
+						})
+					}
+				}
+			case "referrers":
+				var referrers []*github.TrafficReferrer
+				referrers, resp, err = client.Repositories.ListTrafficReferrers(ctx, owner, repo)
+				if err == nil {
+					traffic.Referrers = make([]TrafficReferrer, 0, len(referrers))
+					for _, r := range referrers {
+						traffic.Referrers = append(traffic.Referrers, TrafficReferrer{
+							Referrer: r.GetReferrer(),
+							Count:    r.GetCount(),
+							Uniques:  r.GetUniques(),
+						})
+					}
+				}
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid metric %q: must be one of views, clones, popular_paths, referrers", metric)), nil, nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					trafficErrMsg(fmt.Sprintf("failed to get %s traffic", metric), owner, repo, resp),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			result := MarshalledTextResult(traffic)
+			// Traffic data is only visible to users with push access, so it is
+			// always private. Counts are computed by GitHub, but path titles and
+			// referrer domains come from whoever links to or names the pages.
+			label := ifc.PrivateTrusted()
+			if metric == "popular_paths" || metric == "referrers" {
+				label = ifc.PrivateUntrusted()
+			}
+			result = attachStaticIFCLabel(ctx, deps, result, label)
+			return result, nil, nil
+		},
+	)
+}
+
+func convertTrafficData(data []*github.TrafficData) []TrafficCount {
+	counts := make([]TrafficCount, 0, len(data))
+	for _, d := range data {
+		c := TrafficCount{
+			Count:   d.GetCount(),
+			Uniques: d.GetUniques(),
+		}
+		if d.Timestamp != nil {
+			c.Timestamp = d.Timestamp.Format(time.RFC3339)
+		}
+		counts = append(counts, c)
+	}
+	return counts
+}
+
+// trafficErrMsg explains a 403 from the traffic API, which GitHub returns when
+// the user can read the repository but does not have push access to it.
+func trafficErrMsg(base, owner, repo string, resp *github.Response) string {
+	if resp != nil && resp.StatusCode == http.StatusForbidden {
+		return fmt.Sprintf("%s. Traffic data for %s/%s is only available to users with push access to the repository; "+
+			"the token needs the 'repo' scope or, for fine-grained tokens, Administration read permission for this repository.",
+			base, owner, repo)
+	}
+	return base
+}
@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v89/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositoryTraffic(t *testing.T) {
+	serverTool := GetRepositoryTraffic(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+	assert.Equal(t, "get_repository_traffic", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "per")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "metric"})
+	assert.Equal(t, []string{"repo"}, serverTool.RequiredScopes)
+
+	day := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       RepositoryTraffic
+	}{
+		{
+			name: "weekly views",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTrafficViewsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"per": "week",
+				}).andThen(mockResponse(t, http.StatusOK, &github.TrafficViews{
+					Count:   github.Ptr(120),
+					Uniques: github.Ptr(30),
+					Views: []*github.TrafficData{
+						{Timestamp: &github.Timestamp{Time: day}, Count: github.Ptr(120), Uniques: github.Ptr(30)},
+					},
+				})),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "metric": "views", "per": "week"},
+			expected: RepositoryTraffic{
+				Metric:    "views",
+				Count:     120,
+				Uniques:   30,
+				Per:       "week",
+				Breakdown: []TrafficCount{{Timestamp: "2024-06-03T00:00:00Z", Count: 120, Uniques: 30}},
+			},
+		},
+		{
+			name: "daily clones by default",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTrafficClonesByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"per": "day",
+				}).andThen(mockResponse(t, http.StatusOK, &github.TrafficClones{
+					Count:   github.Ptr(4),
+					Uniques: github.Ptr(2),
+					Clones: []*github.TrafficData{
+						{Timestamp: &github.Timestamp{Time: day}, Count: github.Ptr(4), Uniques: github.Ptr(2)},
+					},
+				})),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "metric": "clones"},
+			expected: RepositoryTraffic{
+				Metric:    "clones",
+				Count:     4,
+				Uniques:   2,
+				Per:       "day",
+				Breakdown: []TrafficCount{{Timestamp: "2024-06-03T00:00:00Z", Count: 4, Uniques: 2}},
+			},
+		},
+		{
+			name: "popular paths",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTrafficPathsByOwnerByRepo: mockResponse(t, http.StatusOK, []*github.TrafficPath{
+					{Path: github.Ptr("/owner/repo"), Title: github.Ptr("owner/repo"), Count: github.Ptr(50), Uniques: github.Ptr(10)},
+				}),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "metric": "popular_paths"},
+			expected: RepositoryTraffic{
+				Metric: "popular_paths",
+				Paths:  []TrafficPath{{Path: "/owner/repo", Title: "owner/repo", Count: 50, Uniques: 10}},
+			},
+		},
+		{
+			name: "referrers",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTrafficReferrersByOwnerByRepo: mockResponse(t, http.StatusOK, []*github.TrafficReferrer{
+					{Referrer: github.Ptr("news.ycombinator.com"), Count: github.Ptr(7), Uniques: github.Ptr(5)},
+				}),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "metric": "referrers"},
+			expected: RepositoryTraffic{
+				Metric:    "referrers",
+				Referrers: []TrafficReferrer{{Referrer: "news.ycombinator.com", Count: 7, Uniques: 5}},
+			},
+		},
+		{
+			name:           "invalid metric",
+			mockedClient:   MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo", "metric": "stars"},
+			expectError:    true,
+			expectedErrMsg: "invalid metric",
+		},
+		{
+			name: "no push access",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTrafficViewsByOwnerByRepo: mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have push access to repository"}),
+			}),
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo", "metric": "views"},
+			expectError:    true,
+			expectedErrMsg: "only available to users with push access",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := mustNewGHClient(t, tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var response RepositoryTraffic
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_GetRepositoryTraffic_ReportsZeroCounts(t *testing.T) {
+	serverTool := GetRepositoryTraffic(translations.NullTranslationHelper)
+	client := mustNewGHClient(t, MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposTrafficViewsByOwnerByRepo: mockResponse(t, http.StatusOK, &github.TrafficViews{
+			Count:   github.Ptr(0),
+			Uniques: github.Ptr(0),
+		}),
+	}))
+	deps := BaseDeps{Client: client}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{"owner": "owner", "repo": "repo", "metric": "views"})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, float64(0), response["count"])
+	assert.Equal(t, float64(0), response["uniques"])
+}
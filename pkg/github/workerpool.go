@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// runBounded calls fn for each index in [0, n) with at most limit calls in
+// flight at once, and returns the results in index order. A limit below one
+// runs the calls one at a time.
+//
+// Every call runs even if others fail, so callers that report per-item
+// outcomes get all of them; the returned error joins the errors of the failed
+// calls in index order and is nil when all succeed. Calls not yet started when
+// ctx is cancelled are skipped and report ctx.Err().
+func runBounded[T any](ctx context.Context, limit, n int, fn func(ctx context.Context, i int) (T, error)) ([]T, error) {
+	if limit < 1 {
+		limit = 1
+	}
+
+	results := make([]T, n)
+	errs := make([]error, n)
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = fn(ctx, i)
+		}()
+	}
+	wg.Wait()
+
+	return results, errors.Join(errs...)
+}
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RunBounded(t *testing.T) {
+	t.Parallel()
+
+	t.Run("never exceeds the limit and keeps results in order", func(t *testing.T) {
+		t.Parallel()
+
+		const limit = 3
+		var inFlight, peak atomic.Int32
+		results, err := runBounded(context.Background(), limit, 20, func(_ context.Context, i int) (int, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return i * i, nil
+		})
+		require.NoError(t, err)
+
+		assert.LessOrEqual(t, peak.Load(), int32(limit))
+		assert.Equal(t, int32(limit), peak.Load(), "the pool should use all of its slots")
+		for i, r := range results {
+			assert.Equal(t, i*i, r)
+		}
+	})
+
+	t.Run("runs every call and joins errors in index order", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		results, err := runBounded(context.Background(), 2, 5, func(_ context.Context, i int) (string, error) {
+			calls.Add(1)
+			if i%2 == 1 {
+				return "", fmt.Errorf("item %d failed", i)
+			}
+			return fmt.Sprintf("item %d", i), nil
+		})
+
+		assert.Equal(t, int32(5), calls.Load())
+		assert.Equal(t, []string{"item 0", "", "item 2", "", "item 4"}, results)
+		require.Error(t, err)
+		assert.Equal(t, "item 1 failed\nitem 3 failed", err.Error())
+	})
+
+	t.Run("limit below one runs calls one at a time", func(t *testing.T) {
+		t.Parallel()
+
+		var inFlight, peak atomic.Int32
+		_, err := runBounded(context.Background(), 0, 5, func(_ context.Context, _ int) (struct{}, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			if n > peak.Load() {
+				peak.Store(n)
+			}
+			time.Sleep(time.Millisecond)
+			return struct{}{}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), peak.Load())
+	})
+
+	t.Run("skips calls after cancellation", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls atomic.Int32
+		_, err := runBounded(ctx, 1, 10, func(_ context.Context, i int) (int, error) {
+			calls.Add(1)
+			if i == 0 {
+				cancel()
+			}
+			return i, nil
+		})
+
+		assert.True(t, errors.Is(err, context.Canceled))
+		// The call already waiting for a slot when ctx is cancelled may
+		// still start; none after it do.
+		assert.LessOrEqual(t, calls.Load(), int32(2))
+	})
+}
@@ -200,11 +200,14 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ghServer, err := h.githubMcpServerFactory(r, h.deps, invToUse, &github.MCPServerConfig{
-		Version:           h.config.Version,
-		Translator:        h.t,
-		ContentWindowSize: h.config.ContentWindowSize,
-		Logger:            h.logger,
-		RepoAccessTTL:     h.config.RepoAccessCacheTTL,
+		Version:              h.config.Version,
+		Translator:           h.t,
+		ContentWindowSize:    h.config.ContentWindowSize,
+		DefaultReturnContent: h.config.DefaultReturnContent,
+		DefaultPerPage:       h.config.DefaultPerPage,
+		PrettyJSON:           h.config.PrettyJSON,
+		Logger:               h.logger,
+		RepoAccessTTL:        h.config.RepoAccessCacheTTL,
 		// Explicitly set empty capabilities. inv.ForMCPRequest currently returns nothing for Initialize.
 		ServerOptions: []github.MCPServerOption{
 			func(so *mcp.ServerOptions) {
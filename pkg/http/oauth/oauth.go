@@ -31,6 +31,7 @@ var SupportedScopes = []string{
 	"read:org",
 	"read:user",
 	"user:email",
+	"user:follow",
 	"read:packages",
 	"write:packages",
 	"read:project",
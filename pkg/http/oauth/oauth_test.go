@@ -572,6 +572,7 @@ func TestSupportedScopes(t *testing.T) {
 		"read:org",
 		"read:user",
 		"user:email",
+		"user:follow",
 		"read:packages",
 		"write:packages",
 		"read:project",
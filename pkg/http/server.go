@@ -69,6 +69,18 @@ type ServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultReturnContent makes content-capable tools return content rather
+	// than download URLs unless a call asks otherwise
+	DefaultReturnContent bool
+
+	// DefaultPerPage is the page size paginated tools use when a call does
+	// not pass perPage
+	DefaultPerPage int
+
+	// PrettyJSON pretty-prints JSON tool results instead of emitting them
+	// compactly
+	PrettyJSON bool
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
@@ -151,6 +163,8 @@ func RunHTTPServer(cfg ServerConfig) error {
 		repoAccessOpts,
 		t,
 		cfg.ContentWindowSize,
+		cfg.DefaultReturnContent,
+		cfg.DefaultPerPage,
 		featureChecker,
 		obs,
 	)
@@ -0,0 +1,197 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultGraphQLFailureThreshold is the number of consecutive failed
+	// GraphQL requests after which GraphQLCircuitBreakerTransport stops
+	// sending requests.
+	DefaultGraphQLFailureThreshold = 3
+	// DefaultGraphQLCooldown is how long GraphQLCircuitBreakerTransport stops
+	// sending requests before it lets one through to probe the host again.
+	DefaultGraphQLCooldown = time.Minute
+)
+
+// ErrGraphQLUnavailable is returned for GraphQL requests while
+// GraphQLCircuitBreakerTransport is tripped.
+var ErrGraphQLUnavailable = errors.New("GraphQL API is unavailable on this host; skipping GraphQL requests until it responds again")
+
+// GraphQLCircuitBreakerTransport is an http.RoundTripper that stops sending
+// GraphQL requests after repeated consecutive failures, such as on a host
+// where the GraphQL API is disabled or unreachable. While tripped, it fails
+// requests immediately with ErrGraphQLUnavailable instead of waiting for each
+// one to time out. After the cooldown it lets a single request through: if
+// that probe succeeds the breaker closes again, otherwise it stays tripped
+// for another cooldown.
+//
+// A failure is a 404 response or a failure to connect. Server errors,
+// timeouts and requests the caller cancelled say nothing about whether the
+// API exists, so they neither count as failures nor reset the count. Any
+// other response resets the count.
+type GraphQLCircuitBreakerTransport struct {
+	// Transport is the underlying HTTP transport. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+	// FailureThreshold is the number of consecutive failures that trips the
+	// breaker. If zero, DefaultGraphQLFailureThreshold is used.
+	FailureThreshold int
+	// Cooldown is how long the breaker stays tripped before probing. If
+	// zero, DefaultGraphQLCooldown is used.
+	Cooldown time.Duration
+	// Logger, if set, is told when the breaker trips and when it recovers.
+	Logger *slog.Logger
+
+	// now returns the current time; tests replace it.
+	now func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+}
+
+// outcome classifies a GraphQL round trip for the breaker.
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	outcomeNeutral
+)
+
+// RoundTrip implements http.RoundTripper.
+func (t *GraphQLCircuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.allow() {
+		return nil, ErrGraphQLUnavailable
+	}
+
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	switch {
+	case err != nil && (req.Context().Err() != nil || isTimeout(err)):
+		// The caller gave up or the host was slow; neither says the
+		// API is missing.
+		t.record(outcomeNeutral, "")
+	case err != nil && isConnectionFailure(err):
+		t.record(outcomeFailure, err.Error())
+	case err != nil:
+		t.record(outcomeNeutral, "")
+	case resp.StatusCode == http.StatusNotFound:
+		t.record(outcomeFailure, resp.Status)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		t.record(outcomeNeutral, "")
+	default:
+		t.record(outcomeSuccess, "")
+	}
+	return resp, err
+}
+
+// allow reports whether a request may be sent. Once the cooldown has passed
+// on a tripped breaker, it allows exactly one request through as a probe.
+func (t *GraphQLCircuitBreakerTransport) allow() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.open {
+		return true
+	}
+	if t.probing || t.clock().Sub(t.openedAt) < t.cooldown() {
+		return false
+	}
+	t.probing = true
+	return true
+}
+
+func (t *GraphQLCircuitBreakerTransport) record(o outcome, reason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	wasProbe := t.probing
+	t.probing = false
+	switch o {
+	case outcomeSuccess:
+		t.failures = 0
+		if t.open {
+			t.open = false
+			if t.Logger != nil {
+				t.Logger.Info("GraphQL API responding again; resuming GraphQL requests")
+			}
+		}
+	case outcomeFailure:
+		if wasProbe {
+			// The probe failed too; wait another cooldown.
+			t.openedAt = t.clock()
+			return
+		}
+		if t.open {
+			return
+		}
+		t.failures++
+		if t.failures < t.threshold() {
+			return
+		}
+		t.open = true
+		t.openedAt = t.clock()
+		if t.Logger != nil {
+			t.Logger.Warn("GraphQL API unavailable; pausing GraphQL requests",
+				"consecutive_failures", t.failures,
+				"cooldown", t.cooldown(),
+				"last_error", reason)
+		}
+	case outcomeNeutral:
+		// A probe that was inconclusive lets the next request probe again.
+	}
+}
+
+func (t *GraphQLCircuitBreakerTransport) threshold() int {
+	if t.FailureThreshold <= 0 {
+		return DefaultGraphQLFailureThreshold
+	}
+	return t.FailureThreshold
+}
+
+func (t *GraphQLCircuitBreakerTransport) cooldown() time.Duration {
+	if t.Cooldown <= 0 {
+		return DefaultGraphQLCooldown
+	}
+	return t.Cooldown
+}
+
+func (t *GraphQLCircuitBreakerTransport) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+// isTimeout reports whether err is a deadline or timeout error.
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isConnectionFailure reports whether err means the host could not be
+// reached at all: the name did not resolve or the connection was refused.
+func isConnectionFailure(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
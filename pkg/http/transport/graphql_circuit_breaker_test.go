@@ -0,0 +1,161 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLCircuitBreakerTransport(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+		t.Helper()
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			n := int(calls.Add(1)) - 1
+			w.WriteHeader(statuses[min(n, len(statuses)-1)])
+		}))
+		t.Cleanup(server.Close)
+		return server, &calls
+	}
+
+	post := func(ctx context.Context, t *testing.T, client *http.Client, url string) error {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	t.Run("trips after consecutive failures and stops sending requests", func(t *testing.T) {
+		t.Parallel()
+		server, calls := newServer(t, http.StatusNotFound)
+		client := &http.Client{Transport: &GraphQLCircuitBreakerTransport{FailureThreshold: 2}}
+
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+
+		err := post(context.Background(), t, client, server.URL)
+		assert.ErrorIs(t, err, ErrGraphQLUnavailable)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("successful responses reset the failure count", func(t *testing.T) {
+		t.Parallel()
+		server, calls := newServer(t, http.StatusNotFound, http.StatusOK, http.StatusNotFound, http.StatusOK)
+		client := &http.Client{Transport: &GraphQLCircuitBreakerTransport{FailureThreshold: 2}}
+
+		for range 4 {
+			require.NoError(t, post(context.Background(), t, client, server.URL))
+		}
+		assert.Equal(t, int32(4), calls.Load())
+	})
+
+	t.Run("client errors do not count as failures", func(t *testing.T) {
+		t.Parallel()
+		server, calls := newServer(t, http.StatusUnauthorized)
+		client := &http.Client{Transport: &GraphQLCircuitBreakerTransport{FailureThreshold: 1}}
+
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("server errors do not count as failures", func(t *testing.T) {
+		t.Parallel()
+		server, calls := newServer(t, http.StatusBadGateway)
+		client := &http.Client{Transport: &GraphQLCircuitBreakerTransport{FailureThreshold: 1}}
+
+		for range 3 {
+			require.NoError(t, post(context.Background(), t, client, server.URL))
+		}
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("timed out requests do not count as failures", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) == 1 {
+				<-release
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		t.Cleanup(func() { close(release) })
+		client := &http.Client{Transport: &GraphQLCircuitBreakerTransport{FailureThreshold: 1}}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := post(ctx, t, client, server.URL)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("probes again after the cooldown and closes on success", func(t *testing.T) {
+		t.Parallel()
+		server, calls := newServer(t, http.StatusNotFound, http.StatusNotFound, http.StatusOK)
+		now := time.Now()
+		breaker := &GraphQLCircuitBreakerTransport{
+			FailureThreshold: 1,
+			Cooldown:         time.Minute,
+			now:              func() time.Time { return now },
+		}
+		client := &http.Client{Transport: breaker}
+
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		assert.ErrorIs(t, post(context.Background(), t, client, server.URL), ErrGraphQLUnavailable)
+
+		// The probe fails, so the breaker waits another cooldown.
+		now = now.Add(time.Minute)
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		assert.ErrorIs(t, post(context.Background(), t, client, server.URL), ErrGraphQLUnavailable)
+		assert.Equal(t, int32(2), calls.Load())
+
+		// The next probe succeeds and the breaker closes.
+		now = now.Add(time.Minute)
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		assert.Equal(t, int32(4), calls.Load())
+	})
+
+	t.Run("cancelled requests do not count as failures", func(t *testing.T) {
+		t.Parallel()
+		server, calls := newServer(t, http.StatusOK)
+		client := &http.Client{Transport: &GraphQLCircuitBreakerTransport{FailureThreshold: 1}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.Error(t, post(ctx, t, client, server.URL))
+		require.NoError(t, post(context.Background(), t, client, server.URL))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("transport errors count as failures", func(t *testing.T) {
+		t.Parallel()
+		server, _ := newServer(t, http.StatusOK)
+		url := server.URL
+		server.Close()
+		client := &http.Client{Transport: &GraphQLCircuitBreakerTransport{FailureThreshold: 1}}
+
+		err := post(context.Background(), t, client, url)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrGraphQLUnavailable)
+
+		err = post(context.Background(), t, client, url)
+		assert.ErrorIs(t, err, ErrGraphQLUnavailable)
+	})
+}
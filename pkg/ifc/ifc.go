@@ -370,6 +370,19 @@ func LabelTeam() SecurityLabel {
 	return PrivateTrusted()
 }
 
+// LabelOrgMemberships returns the IFC label for the authenticated user's
+// organization memberships (list_my_organizations).
+//
+// Integrity is trusted: memberships and roles are maintained by GitHub and
+// organization owners, not by outside contributors.
+//
+// Confidentiality is private. Private organization memberships and the
+// user's role in each organization are visible only to the user and the
+// organization's members.
+func LabelOrgMemberships() SecurityLabel {
+	return PrivateTrusted()
+}
+
 // LabelNotificationDetails returns the IFC label for the subject of a single
 // notification.
 //
@@ -358,6 +358,13 @@ func TestLabelTeam(t *testing.T) {
 	assert.Equal(t, ConfidentialityPrivate, label.Confidentiality)
 }
 
+func TestLabelOrgMemberships(t *testing.T) {
+	t.Parallel()
+	label := LabelOrgMemberships()
+	assert.Equal(t, IntegrityTrusted, label.Integrity)
+	assert.Equal(t, ConfidentialityPrivate, label.Confidentiality)
+}
+
 func TestLabelNotificationDetails(t *testing.T) {
 	t.Parallel()
 	label := LabelNotificationDetails()
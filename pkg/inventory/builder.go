@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"regexp"
 	"slices"
 	"strings"
 )
@@ -14,6 +15,15 @@ var (
 	ErrUnknownTools = errors.New("unknown tools specified in WithTools")
 )
 
+// toolNamePrefixPattern matches the prefixes WithToolNamePrefix accepts: the
+// characters MCP allows in tool names, or no prefix at all.
+var toolNamePrefixPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]*$`)
+
+// IsValidToolNamePrefix reports whether WithToolNamePrefix accepts prefix.
+func IsValidToolNamePrefix(prefix string) bool {
+	return toolNamePrefixPattern.MatchString(prefix)
+}
+
 // mcpAppsFeatureFlag is the feature flag name that controls MCP Apps UI metadata.
 // This is defined here to avoid importing pkg/github (which imports pkg/inventory).
 // The value must match github.MCPAppsFeatureFlag.
@@ -53,6 +63,7 @@ type Builder struct {
 	featureChecker       FeatureFlagChecker
 	filters              []ToolFilter // filters to apply to all tools
 	generateInstructions bool
+	toolNamePrefix       string
 }
 
 // NewBuilder creates a new Builder.
@@ -147,6 +158,17 @@ func (b *Builder) WithFeatureChecker(checker FeatureFlagChecker) *Builder {
 	return b
 }
 
+// WithToolNamePrefix prepends prefix to the name of every tool when it is
+// registered, such as "gh_" for "gh_list_issues", so the server's tools don't
+// collide with another server's in the same client. Configuration such as
+// WithTools and WithExcludeTools keeps using the unprefixed names. Build
+// returns an error if the prefix has characters not allowed in tool names.
+// Returns self for chaining.
+func (b *Builder) WithToolNamePrefix(prefix string) *Builder {
+	b.toolNamePrefix = prefix
+	return b
+}
+
 // WithFilter adds a filter function that will be applied to all tools.
 // Multiple filters can be added and are evaluated in order.
 // If any filter returns false or an error, the tool is excluded.
@@ -222,6 +244,10 @@ func (b *Builder) Build() (*Inventory, error) {
 		filters = append([]ToolFilter{createFeatureFlagFilter(b.featureChecker)}, filters...)
 	}
 
+	if !IsValidToolNamePrefix(b.toolNamePrefix) {
+		return nil, fmt.Errorf("invalid tool name prefix %q: only letters, digits, '_', '-' and '.' are allowed", b.toolNamePrefix)
+	}
+
 	r := &Inventory{
 		tools:             tools,
 		resourceTemplates: b.resourceTemplates,
@@ -230,6 +256,7 @@ func (b *Builder) Build() (*Inventory, error) {
 		readOnly:          b.readOnly,
 		featureChecker:    b.featureChecker,
 		filters:           filters,
+		toolNamePrefix:    b.toolNamePrefix,
 	}
 
 	// Process toolsets and pre-compute metadata in a single pass
@@ -6,6 +6,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strings"
 
 	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -60,6 +61,8 @@ type Inventory struct {
 	unrecognizedToolsets []string
 	// server instructions hold high-level instructions for agents to use the server effectively
 	instructions string
+	// toolNamePrefix is prepended to tool names at registration
+	toolNamePrefix string
 }
 
 // UnrecognizedToolsets returns toolset IDs that were passed to WithToolsets but don't
@@ -116,6 +119,7 @@ func (r *Inventory) ForMCPRequest(method string, itemName string) *Inventory {
 		filters:              r.filters, // shared, not modified
 		unrecognizedToolsets: r.unrecognizedToolsets,
 		instructions:         r.instructions, // server identity; preserved for all methods
+		toolNamePrefix:       r.toolNamePrefix,
 	}
 
 	// Helper to clear all item types
@@ -136,7 +140,7 @@ func (r *Inventory) ForMCPRequest(method string, itemName string) *Inventory {
 	case MCPMethodToolsCall:
 		result.resourceTemplates, result.prompts = nil, nil
 		if itemName != "" {
-			result.tools = r.filterToolsByName(itemName)
+			result.tools = r.filterToolsByName(strings.TrimPrefix(itemName, r.toolNamePrefix))
 		}
 	case MCPMethodResourcesList, MCPMethodResourcesTemplatesList:
 		result.tools, result.prompts = nil, nil
@@ -173,11 +177,16 @@ func (r *Inventory) ToolsetDescriptions() map[ToolsetID]string {
 	return r.toolsetDescriptions
 }
 
+// ToolNamePrefix returns the prefix prepended to tool names at registration.
+func (r *Inventory) ToolNamePrefix() string {
+	return r.toolNamePrefix
+}
+
 // ToolsForRegistration returns AvailableTools(ctx) post-processed exactly as
 // RegisterTools would expose them: with MCP Apps UI metadata stripped when
-// the client cannot consume it. Useful for documentation generators and
-// diagnostics that need the same view of the tool surface the server would
-// register.
+// the client cannot consume it, and with the tool name prefix applied.
+// Useful for documentation generators and diagnostics that need the same
+// view of the tool surface the server would register.
 //
 // The strip applies when EITHER of the following is true:
 //
@@ -192,6 +201,11 @@ func (r *Inventory) ToolsForRegistration(ctx context.Context) []ServerTool {
 	if shouldStripMCPAppsMetadata(ctx, r.checkFeatureFlag(ctx, mcpAppsFeatureFlag)) {
 		tools = stripMCPAppsMetadata(tools)
 	}
+	if r.toolNamePrefix != "" {
+		for i := range tools {
+			tools[i].Tool.Name = r.toolNamePrefix + tools[i].Tool.Name
+		}
+	}
 	return tools
 }
 
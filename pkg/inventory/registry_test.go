@@ -810,6 +810,59 @@ func TestForMCPRequest_ToolsCall_RespectsFilters(t *testing.T) {
 	}
 }
 
+func TestForMCPRequest_ToolsCall_ToolNamePrefix(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("list_repos", "repos", true),
+	}
+
+	reg := mustBuild(t, NewBuilder().SetTools(tools).WithToolsets([]string{"all"}).WithToolNamePrefix("gh_"))
+	filtered := reg.ForMCPRequest(MCPMethodToolsCall, "gh_get_me")
+
+	registered := filtered.ToolsForRegistration(context.Background())
+	if len(registered) != 1 {
+		t.Fatalf("Expected 1 tool for tools/call with prefixed name, got %d", len(registered))
+	}
+	if registered[0].Tool.Name != "gh_get_me" {
+		t.Errorf("Expected tool name 'gh_get_me', got %q", registered[0].Tool.Name)
+	}
+}
+
+func TestToolNamePrefix(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("list_repos", "repos", true),
+	}
+
+	reg := mustBuild(t, NewBuilder().SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithExcludeTools([]string{"list_repos"}).
+		WithToolNamePrefix("gh_"))
+
+	registered := reg.ToolsForRegistration(context.Background())
+	if len(registered) != 1 {
+		t.Fatalf("Expected excluded tool to be matched by its unprefixed name, got %d tools", len(registered))
+	}
+	if registered[0].Tool.Name != "gh_get_me" {
+		t.Errorf("Expected registered name 'gh_get_me', got %q", registered[0].Tool.Name)
+	}
+	if name := reg.AvailableTools(context.Background())[0].Tool.Name; name != "get_me" {
+		t.Errorf("Expected AvailableTools to keep the unprefixed name, got %q", name)
+	}
+	if tools[0].Tool.Name != "get_me" {
+		t.Errorf("Expected the original tool to be left unchanged, got %q", tools[0].Tool.Name)
+	}
+}
+
+func TestToolNamePrefix_Invalid(t *testing.T) {
+	_, err := NewBuilder().SetTools([]ServerTool{mockTool("get_me", "context", true)}).
+		WithToolNamePrefix("gh tools/").
+		Build()
+	if err == nil {
+		t.Fatal("Expected an error for a prefix with invalid characters")
+	}
+}
+
 func TestForMCPRequest_ResourcesList(t *testing.T) {
 	tools := []ServerTool{
 		mockTool("tool1", "repos", true),
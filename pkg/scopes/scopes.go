@@ -44,6 +44,9 @@ const (
 	// SecurityEvents grants read and write access to security events
 	SecurityEvents Scope = "security_events"
 
+	// RepoDeployment grants access to deployments and deployment statuses
+	RepoDeployment Scope = "repo_deployment"
+
 	// User grants read/write access to profile info
 	User Scope = "user"
 
@@ -53,23 +56,29 @@ const (
 	// UserEmail grants read access to user email addresses
 	UserEmail Scope = "user:email"
 
+	// UserFollow grants access to follow and unfollow other users
+	UserFollow Scope = "user:follow"
+
 	// ReadPackages grants read access to packages
 	ReadPackages Scope = "read:packages"
 
 	// WritePackages grants write access to packages
 	WritePackages Scope = "write:packages"
+
+	// Workflow grants access to update GitHub Actions workflows and re-run their jobs
+	Workflow Scope = "workflow"
 )
 
 // ScopeHierarchy defines parent-child relationships between scopes.
 // A parent scope implicitly grants access to all child scopes.
-// For example, "repo" grants access to "public_repo" and "security_events".
+// For example, "repo" grants access to "public_repo", "security_events" and "repo_deployment".
 var ScopeHierarchy = map[Scope][]Scope{
-	Repo:          {PublicRepo, SecurityEvents},
+	Repo:          {PublicRepo, SecurityEvents, RepoDeployment},
 	AdminOrg:      {WriteOrg, ReadOrg},
 	WriteOrg:      {ReadOrg},
 	Project:       {ReadProject},
 	WritePackages: {ReadPackages},
-	User:          {ReadUser, UserEmail},
+	User:          {ReadUser, UserEmail, UserFollow},
 }
 
 // ScopeSet represents a set of OAuth scopes.
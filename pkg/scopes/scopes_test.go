@@ -38,6 +38,11 @@ func TestExpandScopes(t *testing.T) {
 			required: []Scope{SecurityEvents},
 			expected: []string{"repo", "security_events"},
 		},
+		{
+			name:     "repo_deployment also accepts repo (parent)",
+			required: []Scope{RepoDeployment},
+			expected: []string{"repo", "repo_deployment"},
+		},
 		{
 			name:     "read:org also accepts write:org and admin:org (parents)",
 			required: []Scope{ReadOrg},
@@ -83,6 +88,11 @@ func TestExpandScopes(t *testing.T) {
 			required: []Scope{ReadUser},
 			expected: []string{"read:user", "user"},
 		},
+		{
+			name:     "user:follow also accepts user (parent)",
+			required: []Scope{UserFollow},
+			expected: []string{"user", "user:follow"},
+		},
 		{
 			name:     "multiple scopes combine correctly",
 			required: []Scope{PublicRepo, ReadOrg},
@@ -142,6 +152,7 @@ func TestScopeHierarchy(t *testing.T) {
 	// Verify the hierarchy is correctly defined
 	assert.Contains(t, ScopeHierarchy[Repo], PublicRepo)
 	assert.Contains(t, ScopeHierarchy[Repo], SecurityEvents)
+	assert.Contains(t, ScopeHierarchy[Repo], RepoDeployment)
 	assert.Contains(t, ScopeHierarchy[AdminOrg], WriteOrg)
 	assert.Contains(t, ScopeHierarchy[AdminOrg], ReadOrg)
 	assert.Contains(t, ScopeHierarchy[WriteOrg], ReadOrg)
@@ -149,6 +160,7 @@ func TestScopeHierarchy(t *testing.T) {
 	assert.Contains(t, ScopeHierarchy[WritePackages], ReadPackages)
 	assert.Contains(t, ScopeHierarchy[User], ReadUser)
 	assert.Contains(t, ScopeHierarchy[User], UserEmail)
+	assert.Contains(t, ScopeHierarchy[User], UserFollow)
 }
 
 func TestExpandScopeSet(t *testing.T) {
@@ -163,12 +175,13 @@ func TestExpandScopeSet(t *testing.T) {
 			expected: map[string]bool{},
 		},
 		{
-			name:   "repo expands to include public_repo and security_events",
+			name:   "repo expands to include public_repo, security_events and repo_deployment",
 			scopes: []string{"repo"},
 			expected: map[string]bool{
 				"repo":            true,
 				"public_repo":     true,
 				"security_events": true,
+				"repo_deployment": true,
 			},
 		},
 		{
@@ -189,12 +202,13 @@ func TestExpandScopeSet(t *testing.T) {
 			},
 		},
 		{
-			name:   "user expands to include read:user and user:email",
+			name:   "user expands to include read:user, user:email and user:follow",
 			scopes: []string{"user"},
 			expected: map[string]bool{
-				"user":       true,
-				"read:user":  true,
-				"user:email": true,
+				"user":        true,
+				"read:user":   true,
+				"user:email":  true,
+				"user:follow": true,
 			},
 		},
 		{
@@ -211,6 +225,7 @@ func TestExpandScopeSet(t *testing.T) {
 				"repo":            true,
 				"public_repo":     true,
 				"security_events": true,
+				"repo_deployment": true,
 				"gist":            true,
 			},
 		},
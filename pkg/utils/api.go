@@ -235,13 +235,34 @@ func parseAPIHost(s string) (APIHost, error) {
 		return APIHost{}, fmt.Errorf("host must have a scheme (http or https): %s", s)
 	}
 
-	if u.Hostname() == "github.com" || strings.HasSuffix(u.Hostname(), ".github.com") {
+	if isDotcomHostname(u.Hostname()) {
 		return newDotcomHost()
 	}
 
-	if u.Hostname() == "ghe.com" || strings.HasSuffix(u.Hostname(), ".ghe.com") {
+	if isGHECHostname(u.Hostname()) {
 		return newGHECHost(s)
 	}
 
 	return newGHESHost(s)
 }
+
+// IsGHESHost reports whether host names a GitHub Enterprise Server instance,
+// that is, a valid host that is neither github.com nor a GHE.com tenant.
+func IsGHESHost(host string) bool {
+	if host == "" {
+		return false
+	}
+	u, err := url.Parse(host)
+	if err != nil || u.Scheme == "" {
+		return false
+	}
+	return !isDotcomHostname(u.Hostname()) && !isGHECHostname(u.Hostname())
+}
+
+func isDotcomHostname(hostname string) bool {
+	return hostname == "github.com" || strings.HasSuffix(hostname, ".github.com")
+}
+
+func isGHECHostname(hostname string) bool {
+	return hostname == "ghe.com" || strings.HasSuffix(hostname, ".ghe.com")
+}
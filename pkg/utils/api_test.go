@@ -73,3 +73,24 @@ func TestParseAPIHost(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGHESHost(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{input: "", want: false},
+		{input: "https://github.com", want: false},
+		{input: "https://foo.github.com", want: false},
+		{input: "https://mycompany.ghe.com", want: false},
+		{input: "github.example.com", want: false},
+		{input: "https://github.example.com", want: true},
+		{input: "https://mycompanygithub.com", want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsGHESHost(tc.input))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package utils //nolint:revive //TODO: figure out a better name for this package
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// fieldPathNode is one level of a parsed set of dot-separated field paths. A
+// leaf node selects the whole value at that position.
+type fieldPathNode struct {
+	leaf     bool
+	children map[string]*fieldPathNode
+}
+
+// ProjectJSONFields returns a copy of the JSON document data that keeps only the
+// values at the given dot-separated paths, such as "user.login". Paths that pass
+// through an array apply to every element, so "labels.name" keeps the name of
+// each label. Paths that match nothing are skipped, and selecting both a field
+// and one of its children keeps the whole field. An empty path list returns data
+// unchanged.
+func ProjectJSONFields(data []byte, paths []string) ([]byte, error) {
+	root := parseFieldPaths(paths)
+	if root == nil {
+		return data, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // preserve integer precision for fields such as IDs
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	projected, ok := projectValue(value, root)
+	if !ok {
+		projected = map[string]any{}
+	}
+	return json.Marshal(projected)
+}
+
+func parseFieldPaths(paths []string) *fieldPathNode {
+	var root *fieldPathNode
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		if root == nil {
+			root = &fieldPathNode{children: map[string]*fieldPathNode{}}
+		}
+		node := root
+		for _, key := range strings.Split(path, ".") {
+			if node.leaf {
+				break
+			}
+			child, ok := node.children[key]
+			if !ok {
+				child = &fieldPathNode{children: map[string]*fieldPathNode{}}
+				node.children[key] = child
+			}
+			node = child
+		}
+		node.leaf = true
+		node.children = nil
+	}
+	return root
+}
+
+func projectValue(value any, node *fieldPathNode) (any, bool) {
+	if node.leaf {
+		return value, true
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		picked := make(map[string]any, len(node.children))
+		for key, child := range node.children {
+			field, ok := v[key]
+			if !ok {
+				continue
+			}
+			if projected, ok := projectValue(field, child); ok {
+				picked[key] = projected
+			}
+		}
+		return picked, true
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			if projected, ok := projectValue(item, node); ok {
+				items = append(items, projected)
+			}
+		}
+		return items, true
+	default:
+		return nil, false
+	}
+}
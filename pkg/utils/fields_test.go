@@ -0,0 +1,95 @@
+package utils //nolint:revive //TODO: figure out a better name for this package
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectJSONFields(t *testing.T) {
+	const issue = `{
+		"id": 9007199254740993,
+		"number": 42,
+		"title": "Bug",
+		"body": "Long body",
+		"user": {"login": "octocat", "id": 1, "avatar_url": "https://example.com/a.png"},
+		"labels": [{"name": "bug", "color": "f00"}, {"name": "p1", "color": "0f0"}],
+		"head": {"ref": "feature", "repo": {"full_name": "owner/repo", "private": false}}
+	}`
+
+	tests := []struct {
+		name        string
+		data        string
+		paths       []string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "no paths returns input unchanged",
+			data:     issue,
+			paths:    []string{"", " "},
+			expected: issue,
+		},
+		{
+			name:     "top-level fields",
+			data:     issue,
+			paths:    []string{"number", "title"},
+			expected: `{"number": 42, "title": "Bug"}`,
+		},
+		{
+			name:     "nested paths",
+			data:     issue,
+			paths:    []string{"user.login", "head.repo.full_name"},
+			expected: `{"user": {"login": "octocat"}, "head": {"repo": {"full_name": "owner/repo"}}}`,
+		},
+		{
+			name:     "paths through arrays apply to each element",
+			data:     issue,
+			paths:    []string{"labels.name"},
+			expected: `{"labels": [{"name": "bug"}, {"name": "p1"}]}`,
+		},
+		{
+			name:     "parent path wins over child path",
+			data:     issue,
+			paths:    []string{"user.login", "user"},
+			expected: `{"user": {"login": "octocat", "id": 1, "avatar_url": "https://example.com/a.png"}}`,
+		},
+		{
+			name:     "unknown and scalar-traversing paths are skipped",
+			data:     issue,
+			paths:    []string{"missing", "title.length", "id"},
+			expected: `{"id": 9007199254740993}`,
+		},
+		{
+			name:     "top-level arrays project each element",
+			data:     `[{"filename": "a.go", "patch": "..."}, {"filename": "b.go", "patch": "..."}]`,
+			paths:    []string{"filename"},
+			expected: `[{"filename": "a.go"}, {"filename": "b.go"}]`,
+		},
+		{
+			name:     "scalar document yields an empty object",
+			data:     `"text"`,
+			paths:    []string{"title"},
+			expected: `{}`,
+		},
+		{
+			name:        "invalid JSON",
+			data:        `not json`,
+			paths:       []string{"title"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			projected, err := ProjectJSONFields([]byte(tc.data), tc.paths)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(projected))
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package utils //nolint:revive //TODO: figure out a better name for this package
 
-import "github.com/modelcontextprotocol/go-sdk/mcp"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
 
 func NewToolResultText(message string) *mcp.CallToolResult {
 	return &mcp.CallToolResult{
@@ -83,3 +89,92 @@ func NewToolResultAwaitingFormSubmission(message string) *mcp.CallToolResult {
 		IsError: true,
 	}
 }
+
+// PartialItem is the outcome for one target of a tool that acts on several
+// targets, such as one issue of a bulk update or one file of a batch fetch.
+type PartialItem struct {
+	// Target identifies the item, e.g. an issue number or a file path.
+	Target  any    `json:"target"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+	// Result is the tool-specific output for the item.
+	Result any `json:"result,omitempty"`
+}
+
+// PartialResult is the shared output shape of tools that can succeed for
+// some of their targets and fail for others.
+type PartialResult struct {
+	// Summary states the outcome in words, e.g. "3 succeeded, 1 failed".
+	Summary   string        `json:"summary"`
+	Total     int           `json:"total"`
+	Succeeded int           `json:"succeeded"`
+	Failed    int           `json:"failed"`
+	Items     []PartialItem `json:"items"`
+	// Details carries tool-specific context about the operation as a whole.
+	Details any `json:"details,omitempty"`
+}
+
+// NewPartialResult counts the outcomes of items and summarizes them.
+func NewPartialResult(items []PartialItem, details any) PartialResult {
+	if items == nil {
+		items = []PartialItem{}
+	}
+	result := PartialResult{
+		Total:   len(items),
+		Items:   items,
+		Details: details,
+	}
+	for _, item := range items {
+		if item.Success {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	result.Summary = fmt.Sprintf("%d succeeded, %d failed", result.Succeeded, result.Failed)
+	return result
+}
+
+// NewToolResultPartial returns the JSON-encoded PartialResult of items as
+// text content. Failures of some items do not make the result an error; it
+// is marked IsError only when there were items and every one of them failed.
+func NewToolResultPartial(items []PartialItem, details any) *mcp.CallToolResult {
+	partial := NewPartialResult(items, details)
+	data, err := json.Marshal(partial)
+	if err != nil {
+		return NewToolResultErrorFromErr("failed to marshal partial result to json", err)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: string(data),
+			},
+		},
+		IsError: partial.Total > 0 && partial.Succeeded == 0,
+	}
+}
+
+// IndentJSONResult pretty-prints, in place, every text content of result that
+// holds a JSON object or array. Other text, such as CSV or plain messages, is
+// left unchanged.
+func IndentJSONResult(result *mcp.CallToolResult) *mcp.CallToolResult {
+	if result == nil {
+		return nil
+	}
+	for _, content := range result.Content {
+		text, ok := content.(*mcp.TextContent)
+		if !ok {
+			continue
+		}
+		trimmed := bytes.TrimSpace([]byte(text.Text))
+		if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, trimmed, "", "  "); err != nil {
+			continue
+		}
+		text.Text = buf.String()
+	}
+	return result
+}
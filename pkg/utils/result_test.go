@@ -0,0 +1,80 @@
+package utils //nolint:revive //TODO: figure out a better name for this package
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewToolResultPartial(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       []PartialItem
+		wantSummary string
+		wantIsError bool
+	}{
+		{
+			name: "mixed outcomes are not an error",
+			items: []PartialItem{
+				{Target: 1, Success: true},
+				{Target: 2, Success: true},
+				{Target: 3, Success: true},
+				{Target: 4, Error: "not found"},
+			},
+			wantSummary: "3 succeeded, 1 failed",
+		},
+		{
+			name: "every item failing is an error",
+			items: []PartialItem{
+				{Target: "a.go", Error: "not found"},
+			},
+			wantSummary: "0 succeeded, 1 failed",
+			wantIsError: true,
+		},
+		{
+			name:        "no items",
+			wantSummary: "0 succeeded, 0 failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := NewToolResultPartial(tc.items, map[string]any{"labels": []string{"bug"}})
+			assert.Equal(t, tc.wantIsError, result.IsError)
+
+			require.Len(t, result.Content, 1)
+			text, ok := result.Content[0].(*mcp.TextContent)
+			require.True(t, ok)
+
+			var partial PartialResult
+			require.NoError(t, json.Unmarshal([]byte(text.Text), &partial))
+			assert.Equal(t, tc.wantSummary, partial.Summary)
+			assert.Equal(t, len(tc.items), partial.Total)
+			assert.Equal(t, partial.Total, partial.Succeeded+partial.Failed)
+			assert.NotNil(t, partial.Items)
+			assert.Equal(t, map[string]any{"labels": []any{"bug"}}, partial.Details)
+		})
+	}
+}
+
+func TestIndentJSONResult(t *testing.T) {
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: `{"name":"repo","topics":["go"]}`},
+			&mcp.TextContent{Text: "number,title\n1,bug\n"},
+			&mcp.TextContent{Text: `{"truncated":`},
+			&mcp.ImageContent{MIMEType: "image/png"},
+		},
+	}
+
+	got := IndentJSONResult(result)
+
+	require.Same(t, result, got)
+	assert.Equal(t, "{\n  \"name\": \"repo\",\n  \"topics\": [\n    \"go\"\n  ]\n}", got.Content[0].(*mcp.TextContent).Text)
+	assert.Equal(t, "number,title\n1,bug\n", got.Content[1].(*mcp.TextContent).Text, "non-JSON text is unchanged")
+	assert.Equal(t, `{"truncated":`, got.Content[2].(*mcp.TextContent).Text, "invalid JSON is unchanged")
+	assert.Nil(t, IndentJSONResult(nil))
+}
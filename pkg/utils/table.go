@@ -0,0 +1,51 @@
+package utils //nolint:revive //TODO: figure out a better name for this package
+
+import (
+	"strings"
+)
+
+var markdownCellReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"\r\n", " ",
+	"\n", " ",
+	"\r", " ",
+)
+
+// MarkdownTable renders headers and rows as a GitHub-flavored markdown table.
+// Cells are escaped so that pipes and line breaks in values cannot break the
+// table layout. Rows shorter than headers are padded with empty cells and
+// extra cells are dropped. An empty header list yields an empty string.
+func MarkdownTable(headers []string, rows [][]string) string {
+	if len(headers) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	writeMarkdownRow(&b, headers, len(headers))
+
+	b.WriteString("|")
+	for range headers {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+
+	for _, row := range rows {
+		writeMarkdownRow(&b, row, len(headers))
+	}
+	return b.String()
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string, width int) {
+	b.WriteString("|")
+	for i := 0; i < width; i++ {
+		cell := ""
+		if i < len(cells) {
+			cell = markdownCellReplacer.Replace(strings.TrimSpace(cells[i]))
+		}
+		b.WriteString(" ")
+		b.WriteString(cell)
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
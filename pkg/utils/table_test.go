@@ -0,0 +1,52 @@
+package utils //nolint:revive //TODO: figure out a better name for this package
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		rows    [][]string
+		want    string
+	}{
+		{
+			name:    "no headers",
+			headers: nil,
+			rows:    [][]string{{"a"}},
+			want:    "",
+		},
+		{
+			name:    "headers only",
+			headers: []string{"#", "Title"},
+			want:    "| # | Title |\n| --- | --- |\n",
+		},
+		{
+			name:    "rows are rendered in order",
+			headers: []string{"#", "Title"},
+			rows:    [][]string{{"1", "First"}, {"2", "Second"}},
+			want:    "| # | Title |\n| --- | --- |\n| 1 | First |\n| 2 | Second |\n",
+		},
+		{
+			name:    "pipes, backslashes and newlines are escaped",
+			headers: []string{"Title"},
+			rows:    [][]string{{"a|b \\ c\r\nd\ne"}},
+			want:    "| Title |\n| --- |\n| a\\|b \\\\ c d e |\n",
+		},
+		{
+			name:    "short rows are padded and long rows truncated",
+			headers: []string{"A", "B"},
+			rows:    [][]string{{"1"}, {"1", "2", "3"}},
+			want:    "| A | B |\n| --- | --- |\n| 1 |  |\n| 1 | 2 |\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MarkdownTable(tc.headers, tc.rows))
+		})
+	}
+}